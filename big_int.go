@@ -0,0 +1,238 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"math/big"
+)
+
+// BigInt holds a nullable *big.Int for integers of arbitrary precision like in
+// NUMERIC columns. A BigInt with nil Int is handled like a NULL-value.
+type BigInt struct {
+	// Int is the actual value when Valid.
+	Int *big.Int `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewBigInt returns a valid BigInt with the given value. The value is not
+// copied.
+func NewBigInt(v *big.Int) BigInt {
+	return BigInt{
+		Int:   v,
+		Valid: true,
+	}
+}
+
+// ParseBigInt returns a valid BigInt with the given decimal integer text.
+func ParseBigInt(s string) (BigInt, error) {
+	v, err := parseBigInt(s)
+	if err != nil {
+		return BigInt{}, err
+	}
+	return NewBigInt(v), nil
+}
+
+// MustParseBigInt is like ParseBigInt but panics if the text is invalid.
+func MustParseBigInt(s string) BigInt {
+	b, err := ParseBigInt(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// BigIntFromPtr returns a BigInt that is valid if the given pointer is not nil.
+func BigIntFromPtr(v *big.Int) BigInt {
+	if v == nil {
+		return BigInt{}
+	}
+	return NewBigInt(v)
+}
+
+// Ptr returns a copy of the value if valid or nil otherwise.
+func (b BigInt) Ptr() *big.Int {
+	if !b.isValid() {
+		return nil
+	}
+	return new(big.Int).Set(b.Int)
+}
+
+// Get returns the value and whether it is valid.
+func (b BigInt) Get() (*big.Int, bool) {
+	return b.Int, b.isValid()
+}
+
+// IsNull returns true if not valid.
+func (b BigInt) IsNull() bool {
+	return !b.isValid()
+}
+
+// Set sets the given value and makes it valid. The value is not copied.
+func (b *BigInt) Set(v *big.Int) {
+	*b = NewBigInt(v)
+}
+
+// SetNull sets a NULL-value.
+func (b *BigInt) SetNull() {
+	*b = BigInt{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (b BigInt) IsZero() bool {
+	return !b.isValid()
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (b BigInt) Equal(other BigInt) bool {
+	if !b.isValid() || !other.isValid() {
+		return b.isValid() == other.isValid()
+	}
+	return b.Int.Cmp(other.Int) == 0
+}
+
+// String returns the value as decimal integer or NullToken if not valid.
+func (b BigInt) String() string {
+	if !b.isValid() {
+		return NullToken
+	}
+	return b.Int.String()
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (b BigInt) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, b, b.Int, b.isValid())
+}
+
+// GoString returns a Go expression creating the BigInt.
+func (b BigInt) GoString() string {
+	if !b.isValid() {
+		return "nulls.BigInt{}"
+	}
+	return fmt.Sprintf("nulls.MustParseBigInt(%q)", b.Int.String())
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+// The value is logged as string in order to avoid precision loss.
+func (b BigInt) LogValue() slog.Value {
+	if !b.isValid() {
+		return logNullValue()
+	}
+	return slog.StringValue(b.Int.String())
+}
+
+// MarshalJSON marshals the value as number literal. Use BigIntString for
+// marshalling as string. If not valid, a NULL-value is returned.
+func (b BigInt) MarshalJSON() ([]byte, error) {
+	if !b.isValid() {
+		return json.Marshal(nil)
+	}
+	return []byte(b.Int.String()), nil
+}
+
+// UnmarshalJSON as number literal or string holding a decimal integer or sets
+// Valid to false if null.
+func (b *BigInt) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		b.SetNull()
+		return nil
+	}
+	s, err := unmarshalJSONBig(data)
+	if err != nil {
+		return err
+	}
+	return b.parse(s)
+}
+
+// MarshalText marshals the value as decimal integer. If not valid, empty text
+// is returned.
+func (b BigInt) MarshalText() ([]byte, error) {
+	if !b.isValid() {
+		return []byte{}, nil
+	}
+	return []byte(b.Int.String()), nil
+}
+
+// UnmarshalText as decimal integer or sets Valid to false if empty.
+func (b *BigInt) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		b.SetNull()
+		return nil
+	}
+	return b.parse(string(text))
+}
+
+// MarshalYAML marshals the value as integer. If not valid, a NULL-value is
+// returned.
+func (b BigInt) MarshalYAML() (any, error) {
+	if !b.isValid() {
+		return nil, nil
+	}
+	// Leave the tag empty, so that it is resolved from the value instead of being
+	// written explicitly if not matching.
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Value: b.Int.String(),
+	}, nil
+}
+
+// UnmarshalYAML as integer or string holding a decimal integer or sets Valid
+// to false if null.
+func (b *BigInt) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		b.SetNull()
+		return nil
+	}
+	var s string
+	err := node.Decode(&s)
+	if err != nil {
+		return err
+	}
+	return b.parse(s)
+}
+
+// Scan to *big.Int value or not valid if nil. Strings are parsed as decimal
+// integers like returned by drivers for NUMERIC columns. Integers are accepted
+// as well.
+func (b *BigInt) Scan(src any) error {
+	s, valid, err := scanBigText(src)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		b.SetNull()
+		return nil
+	}
+	return b.parse(s)
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The
+// value is returned as exact decimal string.
+func (b BigInt) Value() (driver.Value, error) {
+	if !b.isValid() {
+		return nil, nil
+	}
+	return b.Int.String(), nil
+}
+
+// isValid reports whether b is valid and Int is not nil.
+func (b BigInt) isValid() bool {
+	return b.Valid && b.Int != nil
+}
+
+// parse parses the given decimal integer text into a newly allocated
+// *big.Int and sets the BigInt valid.
+func (b *BigInt) parse(s string) error {
+	v, err := parseBigInt(s)
+	if err != nil {
+		return err
+	}
+	b.Valid = true
+	b.Int = v
+	return nil
+}
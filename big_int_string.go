@@ -0,0 +1,169 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"math/big"
+)
+
+// BigIntString is like BigInt but is marshalled to JSON as quoted string
+// instead of number literal. This avoids precision loss in JSON decoders
+// parsing numbers as floating point. A BigIntString with nil Int is handled
+// like a NULL-value.
+type BigIntString struct {
+	// Int is the actual value when Valid.
+	Int *big.Int `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewBigIntString returns a valid BigIntString with the given value. The value
+// is not copied.
+func NewBigIntString(v *big.Int) BigIntString {
+	return BigIntString{
+		Int:   v,
+		Valid: true,
+	}
+}
+
+// ParseBigIntString returns a valid BigIntString with the given decimal
+// integer text.
+func ParseBigIntString(s string) (BigIntString, error) {
+	b, err := ParseBigInt(s)
+	return BigIntString(b), err
+}
+
+// MustParseBigIntString is like ParseBigIntString but panics if the text is
+// invalid.
+func MustParseBigIntString(s string) BigIntString {
+	return BigIntString(MustParseBigInt(s))
+}
+
+// BigIntStringFromPtr returns a BigIntString that is valid if the given pointer
+// is not nil.
+func BigIntStringFromPtr(v *big.Int) BigIntString {
+	return BigIntString(BigIntFromPtr(v))
+}
+
+// BigIntStringFromBigInt returns a BigIntString from the given BigInt.
+func BigIntStringFromBigInt(v BigInt) BigIntString {
+	return BigIntString(v)
+}
+
+// ToBigInt returns the BigInt representation.
+func (b BigIntString) ToBigInt() BigInt {
+	return BigInt(b)
+}
+
+// Ptr returns a copy of the value if valid or nil otherwise.
+func (b BigIntString) Ptr() *big.Int {
+	return b.ToBigInt().Ptr()
+}
+
+// Get returns the value and whether it is valid.
+func (b BigIntString) Get() (*big.Int, bool) {
+	return b.ToBigInt().Get()
+}
+
+// IsNull returns true if not valid.
+func (b BigIntString) IsNull() bool {
+	return b.ToBigInt().IsNull()
+}
+
+// Set sets the given value and makes it valid. The value is not copied.
+func (b *BigIntString) Set(v *big.Int) {
+	*b = NewBigIntString(v)
+}
+
+// SetNull sets a NULL-value.
+func (b *BigIntString) SetNull() {
+	*b = BigIntString{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (b BigIntString) IsZero() bool {
+	return b.ToBigInt().IsZero()
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (b BigIntString) Equal(other BigIntString) bool {
+	return b.ToBigInt().Equal(other.ToBigInt())
+}
+
+// String returns the value as decimal integer or NullToken if not valid.
+func (b BigIntString) String() string {
+	return b.ToBigInt().String()
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (b BigIntString) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, b, b.Int, !b.IsNull())
+}
+
+// GoString returns a Go expression creating the BigIntString.
+func (b BigIntString) GoString() string {
+	if b.IsNull() {
+		return "nulls.BigIntString{}"
+	}
+	return fmt.Sprintf("nulls.MustParseBigIntString(%q)", b.Int.String())
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+// The value is logged as string in order to avoid precision loss.
+func (b BigIntString) LogValue() slog.Value {
+	return b.ToBigInt().LogValue()
+}
+
+// MarshalJSON marshals the value as string holding a decimal integer. If not
+// valid, a NULL-value is returned.
+func (b BigIntString) MarshalJSON() ([]byte, error) {
+	if b.IsNull() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(b.Int.String())
+}
+
+// UnmarshalJSON as number literal or string holding a decimal integer or sets
+// Valid to false if null.
+func (b *BigIntString) UnmarshalJSON(data []byte) error {
+	return (*BigInt)(b).UnmarshalJSON(data)
+}
+
+// MarshalText marshals the value as decimal integer. If not valid, empty text
+// is returned.
+func (b BigIntString) MarshalText() ([]byte, error) {
+	return b.ToBigInt().MarshalText()
+}
+
+// UnmarshalText as decimal integer or sets Valid to false if empty.
+func (b *BigIntString) UnmarshalText(text []byte) error {
+	return (*BigInt)(b).UnmarshalText(text)
+}
+
+// MarshalYAML marshals the value as integer. If not valid, a NULL-value is
+// returned.
+func (b BigIntString) MarshalYAML() (any, error) {
+	return b.ToBigInt().MarshalYAML()
+}
+
+// UnmarshalYAML as integer or string holding a decimal integer or sets Valid
+// to false if null.
+func (b *BigIntString) UnmarshalYAML(node *yaml.Node) error {
+	return (*BigInt)(b).UnmarshalYAML(node)
+}
+
+// Scan to *big.Int value or not valid if nil like BigInt.Scan.
+func (b *BigIntString) Scan(src any) error {
+	return (*BigInt)(b).Scan(src)
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The
+// value is returned as exact decimal string.
+func (b BigIntString) Value() (driver.Value, error) {
+	return b.ToBigInt().Value()
+}
@@ -0,0 +1,121 @@
+package nulls
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"math/big"
+	"testing"
+)
+
+// TestNewBigIntString tests NewBigIntString.
+func TestNewBigIntString(t *testing.T) {
+	v := testBigInt()
+	b := NewBigIntString(v)
+	assert.True(t, b.Valid, "should be valid")
+	assert.Same(t, v, b.Int, "should have set correct value")
+}
+
+// TestParseBigIntString tests ParseBigIntString and MustParseBigIntString.
+func TestParseBigIntString(t *testing.T) {
+	b, err := ParseBigIntString(testBigIntText)
+	assert.NoError(t, err, "should not fail")
+	assert.Equal(t, NewBigIntString(testBigInt()), b, "should return correct value")
+	_, err = ParseBigIntString("12.5")
+	assert.Error(t, err, "should fail")
+	assert.Panics(t, func() { MustParseBigIntString("meow") }, "should panic")
+}
+
+// TestBigIntStringFromBigInt tests BigIntStringFromBigInt and
+// BigIntString.ToBigInt.
+func TestBigIntStringFromBigInt(t *testing.T) {
+	b := BigIntStringFromBigInt(NewBigInt(testBigInt()))
+	assert.Equal(t, NewBigIntString(testBigInt()), b, "should return correct value")
+	assert.Equal(t, NewBigInt(testBigInt()), b.ToBigInt(), "should return correct value")
+}
+
+// BigIntStringMarshalJSONSuite tests BigIntString.MarshalJSON.
+type BigIntStringMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *BigIntStringMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(BigIntString{Int: testBigInt()})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *BigIntStringMarshalJSONSuite) TestValid() {
+	raw, err := json.Marshal(NewBigIntString(testBigInt()))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`"`+testBigIntText+`"`, string(raw), "should return correct value")
+}
+
+func TestBigIntString_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(BigIntStringMarshalJSONSuite))
+}
+
+// BigIntStringUnmarshalJSONSuite tests BigIntString.UnmarshalJSON.
+type BigIntStringUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *BigIntStringUnmarshalJSONSuite) TestNull() {
+	b := NewBigIntString(testBigInt())
+	err := json.Unmarshal(jsonNull, &b)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(b.Valid, "should not be valid")
+}
+
+func (suite *BigIntStringUnmarshalJSONSuite) TestString() {
+	var b BigIntString
+	err := json.Unmarshal([]byte(`"`+testBigIntText+`"`), &b)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewBigIntString(testBigInt()), b, "should unmarshal correct value")
+}
+
+func (suite *BigIntStringUnmarshalJSONSuite) TestNumber() {
+	var b BigIntString
+	err := json.Unmarshal([]byte(testBigIntText), &b)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewBigIntString(testBigInt()), b, "should unmarshal correct value")
+}
+
+func TestBigIntString_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(BigIntStringUnmarshalJSONSuite))
+}
+
+// TestBigIntString_Mixed tests using BigInt and BigIntString in the same struct.
+func TestBigIntString_Mixed(t *testing.T) {
+	raw, err := json.Marshal(struct {
+		Number BigInt       `json:"number"`
+		String BigIntString `json:"string"`
+	}{
+		Number: NewBigInt(big.NewInt(1)),
+		String: NewBigIntString(big.NewInt(2)),
+	})
+	assert.NoError(t, err, "should not fail")
+	assert.Equal(t, `{"number":1,"string":"2"}`, string(raw), "should return correct value")
+}
+
+// TestBigIntString_Format tests BigIntString.Format and BigIntString.GoString.
+func TestBigIntString_Format(t *testing.T) {
+	assert.Equal(t, "0042", fmt.Sprintf("%04d", NewBigIntString(big.NewInt(42))), "should return correct value")
+	assert.Equal(t, NullToken, fmt.Sprintf("%v", BigIntString{}), "should return null token")
+	assert.Equal(t, `nulls.MustParseBigIntString("42")`, fmt.Sprintf("%#v", NewBigIntString(big.NewInt(42))),
+		"should return correct value")
+	assert.Equal(t, "nulls.BigIntString{}", fmt.Sprintf("%#v", BigIntString{}), "should return correct value")
+}
+
+// TestBigIntString_Scan tests BigIntString.Scan and BigIntString.Value.
+func TestBigIntString_Scan(t *testing.T) {
+	var b BigIntString
+	assert.NoError(t, b.Scan(testBigIntText), "should not fail")
+	assert.Equal(t, NewBigIntString(testBigInt()), b, "should scan correct value")
+	v, err := b.Value()
+	assert.NoError(t, err, "should not fail")
+	assert.Equal(t, testBigIntText, v, "should return correct value")
+	assert.NoError(t, b.Scan(nil), "should not fail")
+	assert.False(t, b.Valid, "should not be valid")
+}
@@ -0,0 +1,359 @@
+package nulls
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"math/big"
+	"testing"
+)
+
+// testBigIntText is a decimal integer exceeding int64.
+const testBigIntText = "123456789012345678901234567890"
+
+// testBigInt returns a new *big.Int for testBigIntText.
+func testBigInt() *big.Int {
+	v, _ := new(big.Int).SetString(testBigIntText, 10)
+	return v
+}
+
+// TestNewBigInt tests NewBigInt.
+func TestNewBigInt(t *testing.T) {
+	v := testBigInt()
+	b := NewBigInt(v)
+	assert.True(t, b.Valid, "should be valid")
+	assert.Same(t, v, b.Int, "should have set correct value")
+}
+
+// ParseBigIntSuite tests ParseBigInt and MustParseBigInt.
+type ParseBigIntSuite struct {
+	suite.Suite
+}
+
+func (suite *ParseBigIntSuite) TestFail() {
+	_, err := ParseBigInt("12.5")
+	suite.Error(err, "should fail")
+	suite.Panics(func() { MustParseBigInt("meow") }, "should panic")
+}
+
+func (suite *ParseBigIntSuite) TestOK() {
+	b, err := ParseBigInt(testBigIntText)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewBigInt(testBigInt()), b, "should return correct value")
+	suite.Equal(NewBigInt(testBigInt()), MustParseBigInt(testBigIntText), "should return correct value")
+}
+
+func TestParseBigInt(t *testing.T) {
+	suite.Run(t, new(ParseBigIntSuite))
+}
+
+// BigIntMarshalJSONSuite tests BigInt.MarshalJSON.
+type BigIntMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *BigIntMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(BigInt{Int: testBigInt()})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *BigIntMarshalJSONSuite) TestNil() {
+	raw, err := json.Marshal(BigInt{Valid: true})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *BigIntMarshalJSONSuite) TestNumber() {
+	raw, err := json.Marshal(NewBigInt(testBigInt()))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(testBigIntText, string(raw), "should return correct value")
+}
+
+func TestBigInt_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(BigIntMarshalJSONSuite))
+}
+
+// BigIntUnmarshalJSONSuite tests BigInt.UnmarshalJSON.
+type BigIntUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *BigIntUnmarshalJSONSuite) TestNull() {
+	b := NewBigInt(testBigInt())
+	err := json.Unmarshal(jsonNull, &b)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(b.Valid, "should not be valid")
+}
+
+func (suite *BigIntUnmarshalJSONSuite) TestUnmarshalFail() {
+	var b BigInt
+	err := json.Unmarshal([]byte(`true`), &b)
+	suite.Error(err, "should fail")
+}
+
+func (suite *BigIntUnmarshalJSONSuite) TestParseFail() {
+	for _, data := range []string{`12.5`, `1e3`, `"meow"`} {
+		var b BigInt
+		err := json.Unmarshal([]byte(data), &b)
+		suite.Errorf(err, "should fail for %s", data)
+	}
+}
+
+func (suite *BigIntUnmarshalJSONSuite) TestOK() {
+	for _, data := range []string{testBigIntText, `"` + testBigIntText + `"`} {
+		var b BigInt
+		err := json.Unmarshal([]byte(data), &b)
+		suite.Require().NoErrorf(err, "should not fail for %s", data)
+		suite.Equalf(NewBigInt(testBigInt()), b, "should unmarshal correct value for %s", data)
+	}
+}
+
+func (suite *BigIntUnmarshalJSONSuite) TestNoSharedValue() {
+	v := big.NewInt(1)
+	b := NewBigInt(v)
+	err := json.Unmarshal([]byte(`2`), &b)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(1), v.Int64(), "should not modify previous value")
+	suite.Equal(int64(2), b.Int.Int64(), "should unmarshal correct value")
+}
+
+func TestBigInt_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(BigIntUnmarshalJSONSuite))
+}
+
+// BigIntMarshalTextSuite tests BigInt.MarshalText.
+type BigIntMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *BigIntMarshalTextSuite) TestNotValid() {
+	text, err := BigInt{Int: testBigInt()}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *BigIntMarshalTextSuite) TestOK() {
+	text, err := NewBigInt(testBigInt()).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(testBigIntText, string(text), "should return correct value")
+}
+
+func TestBigInt_MarshalText(t *testing.T) {
+	suite.Run(t, new(BigIntMarshalTextSuite))
+}
+
+// BigIntUnmarshalTextSuite tests BigInt.UnmarshalText.
+type BigIntUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *BigIntUnmarshalTextSuite) TestEmpty() {
+	b := NewBigInt(testBigInt())
+	err := b.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(b.Valid, "should not be valid")
+}
+
+func (suite *BigIntUnmarshalTextSuite) TestUnmarshalFail() {
+	var b BigInt
+	err := b.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *BigIntUnmarshalTextSuite) TestOK() {
+	var b BigInt
+	err := b.UnmarshalText([]byte(testBigIntText))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewBigInt(testBigInt()), b, "should unmarshal correct value")
+}
+
+func TestBigInt_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(BigIntUnmarshalTextSuite))
+}
+
+// BigIntMarshalYAMLSuite tests BigInt.MarshalYAML.
+type BigIntMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *BigIntMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(BigInt{Int: testBigInt()})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *BigIntMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewBigInt(testBigInt()))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(testBigIntText+"\n", string(raw), "should return correct value")
+}
+
+func TestBigInt_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(BigIntMarshalYAMLSuite))
+}
+
+// BigIntUnmarshalYAMLSuite tests BigInt.UnmarshalYAML.
+type BigIntUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *BigIntUnmarshalYAMLSuite) TestNull() {
+	b := NewBigInt(testBigInt())
+	err := b.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(b.Valid, "should not be valid")
+}
+
+func (suite *BigIntUnmarshalYAMLSuite) TestUnmarshalFail() {
+	for _, src := range []string{`meow`, `[1]`, `12.5`} {
+		var b BigInt
+		err := yaml.Unmarshal([]byte(src), &b)
+		suite.Errorf(err, "should fail for %s", src)
+	}
+}
+
+func (suite *BigIntUnmarshalYAMLSuite) TestOK() {
+	for _, src := range []string{testBigIntText, `"` + testBigIntText + `"`} {
+		var b BigInt
+		err := yaml.Unmarshal([]byte(src), &b)
+		suite.Require().NoErrorf(err, "should not fail for %s", src)
+		suite.Equalf(NewBigInt(testBigInt()), b, "should unmarshal correct value for %s", src)
+	}
+}
+
+func TestBigInt_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(BigIntUnmarshalYAMLSuite))
+}
+
+// BigIntScanSuite tests BigInt.Scan.
+type BigIntScanSuite struct {
+	suite.Suite
+}
+
+func (suite *BigIntScanSuite) TestNull() {
+	b := NewBigInt(testBigInt())
+	err := b.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(b.Valid, "should not be valid")
+}
+
+func (suite *BigIntScanSuite) TestUnsupported() {
+	var b BigInt
+	err := b.Scan(true)
+	suite.Error(err, "should fail")
+}
+
+func (suite *BigIntScanSuite) TestParseFail() {
+	for _, src := range []any{"meow", "12.5", 12.5} {
+		var b BigInt
+		err := b.Scan(src)
+		suite.Errorf(err, "should fail for %v", src)
+	}
+}
+
+func (suite *BigIntScanSuite) TestOK() {
+	for _, src := range []any{testBigIntText, []byte(testBigIntText)} {
+		var b BigInt
+		err := b.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %v", src)
+		suite.Equalf(NewBigInt(testBigInt()), b, "should scan correct value for %v", src)
+	}
+}
+
+func (suite *BigIntScanSuite) TestInt64() {
+	var b BigInt
+	err := b.Scan(int64(-16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewBigInt(big.NewInt(-16)), b, "should scan correct value")
+}
+
+func TestBigInt_Scan(t *testing.T) {
+	suite.Run(t, new(BigIntScanSuite))
+}
+
+// BigIntValueSuite tests BigInt.Value.
+type BigIntValueSuite struct {
+	suite.Suite
+}
+
+func (suite *BigIntValueSuite) TestNull() {
+	raw, err := BigInt{Int: testBigInt()}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *BigIntValueSuite) TestOK() {
+	raw, err := NewBigInt(testBigInt()).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(testBigIntText, raw, "should return correct value")
+}
+
+func TestBigInt_Value(t *testing.T) {
+	suite.Run(t, new(BigIntValueSuite))
+}
+
+// TestBigInt_Get tests BigInt.Get.
+func TestBigInt_Get(t *testing.T) {
+	v, ok := NewBigInt(testBigInt()).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, testBigInt(), v, "should return correct value")
+	_, ok = BigInt{Int: testBigInt()}.Get()
+	assert.False(t, ok, "should not be valid")
+	_, ok = BigInt{Valid: true}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestBigInt_IsZero tests BigInt.IsZero.
+func TestBigInt_IsZero(t *testing.T) {
+	assert.False(t, NewBigInt(new(big.Int)).IsZero(), "should not be zero")
+	assert.True(t, BigInt{Int: testBigInt()}.IsZero(), "should be zero")
+	assert.True(t, BigInt{Valid: true}.IsZero(), "should be zero")
+}
+
+// TestBigInt_Equal tests BigInt.Equal.
+func TestBigInt_Equal(t *testing.T) {
+	b := NewBigInt(testBigInt())
+	assert.True(t, b.Equal(NewBigInt(testBigInt())), "should be equal")
+	assert.False(t, b.Equal(NewBigInt(big.NewInt(1))), "should not be equal")
+	assert.False(t, b.Equal(BigInt{Int: testBigInt()}), "should not be equal")
+	assert.True(t, BigInt{Int: testBigInt()}.Equal(BigInt{Valid: true}), "should be equal")
+}
+
+// TestBigInt_String tests BigInt.String.
+func TestBigInt_String(t *testing.T) {
+	assert.Equal(t, testBigIntText, NewBigInt(testBigInt()).String(), "should return correct value")
+	assert.Equal(t, NullToken, BigInt{}.String(), "should return null token")
+}
+
+// TestBigInt_Format tests BigInt.Format.
+func TestBigInt_Format(t *testing.T) {
+	assert.Equal(t, testBigIntText, fmt.Sprintf("%d", NewBigInt(testBigInt())), "should return correct value")
+	assert.Equal(t, "ff", fmt.Sprintf("%x", NewBigInt(big.NewInt(255))), "should return correct value")
+	assert.Equal(t, "<null>", fmt.Sprintf("%d", BigInt{}), "should return null token")
+}
+
+// TestBigInt_GoString tests BigInt.GoString.
+func TestBigInt_GoString(t *testing.T) {
+	assert.Equal(t, `nulls.MustParseBigInt("`+testBigIntText+`")`, fmt.Sprintf("%#v", NewBigInt(testBigInt())),
+		"should return correct value")
+	assert.Equal(t, "nulls.BigInt{}", BigInt{}.GoString(), "should return correct value")
+}
+
+// TestBigInt_LogValue tests BigInt.LogValue.
+func TestBigInt_LogValue(t *testing.T) {
+	assert.Equal(t, testBigIntText, NewBigInt(testBigInt()).LogValue().Any(), "should return correct value")
+	assert.Nil(t, BigInt{Int: testBigInt()}.LogValue().Any(), "should return null value")
+}
+
+// TestBigIntFromPtr tests BigIntFromPtr and BigInt.Ptr.
+func TestBigIntFromPtr(t *testing.T) {
+	assert.False(t, BigIntFromPtr(nil).Valid, "should not be valid")
+	assert.Nil(t, BigInt{Int: testBigInt()}.Ptr(), "should return nil")
+	v := testBigInt()
+	p := BigIntFromPtr(v).Ptr()
+	assert.Equal(t, v, p, "should return correct value")
+	assert.NotSame(t, v, p, "should return copy")
+}
@@ -0,0 +1,88 @@
+package nulls
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// unmarshalJSONBig returns the number text of the given JSON number literal or
+// string.
+func unmarshalJSONBig(data []byte) (string, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	var n json.Number
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err := decoder.Decode(&n)
+	if err != nil {
+		return "", err
+	}
+	return n.String(), nil
+}
+
+// parseBigInt parses the given decimal integer text.
+func parseBigInt(s string) (*big.Int, error) {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+	return v, nil
+}
+
+// parseRat parses the given decimal or fraction text like "12.34" or "1/3".
+func parseRat(s string) (*big.Rat, error) {
+	v, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	return v, nil
+}
+
+// ratDecimal returns the exact decimal representation of the given big.Rat. If
+// it has no finite decimal representation like 1/3, an error is returned.
+func ratDecimal(r *big.Rat) (string, error) {
+	prec, exact := r.FloatPrec()
+	if !exact {
+		return "", fmt.Errorf("number %s has no finite decimal representation", r.String())
+	}
+	return r.FloatString(prec), nil
+}
+
+// ratString returns the exact decimal representation of the given big.Rat or
+// the fraction if it has no finite decimal representation.
+func ratString(r *big.Rat) string {
+	s, err := ratDecimal(r)
+	if err != nil {
+		return r.String()
+	}
+	return s
+}
+
+// scanBigText returns the number text of the given source for scanning BigInt
+// and Rat. Integers are formatted as decimal and floats using the shortest
+// representation. The returned boolean is false if the source is nil.
+func scanBigText(src any) (string, bool, error) {
+	switch src := src.(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return src, true, nil
+	case []byte:
+		return string(src), true, nil
+	case int64:
+		return strconv.FormatInt(src, 10), true, nil
+	case float64:
+		return strconv.FormatFloat(src, 'g', -1, 64), true, nil
+	default:
+		return "", false, fmt.Errorf("unsupported source value type: %T", src)
+	}
+}
@@ -0,0 +1,78 @@
+package nulls
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"math/big"
+	"testing"
+)
+
+// unmarshalJSONBigSuite tests unmarshalJSONBig.
+type unmarshalJSONBigSuite struct {
+	suite.Suite
+}
+
+func (suite *unmarshalJSONBigSuite) TestNumber() {
+	s, err := unmarshalJSONBig([]byte(`123456789012345678901234567890.123`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("123456789012345678901234567890.123", s, "should return correct value")
+}
+
+func (suite *unmarshalJSONBigSuite) TestString() {
+	s, err := unmarshalJSONBig([]byte(`"12.34"`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("12.34", s, "should return correct value")
+}
+
+func (suite *unmarshalJSONBigSuite) TestFail() {
+	for _, data := range []string{`true`, `{}`, `"12`} {
+		_, err := unmarshalJSONBig([]byte(data))
+		suite.Errorf(err, "should fail for %s", data)
+	}
+}
+
+func TestUnmarshalJSONBig(t *testing.T) {
+	suite.Run(t, new(unmarshalJSONBigSuite))
+}
+
+// TestRatDecimal tests ratDecimal and ratString.
+func TestRatDecimal(t *testing.T) {
+	for fraction, expect := range map[string]string{
+		"0":         "0",
+		"5":         "5",
+		"-1234/100": "-12.34",
+		"1/8":       "0.125",
+	} {
+		r, _ := new(big.Rat).SetString(fraction)
+		s, err := ratDecimal(r)
+		assert.NoErrorf(t, err, "should not fail for %s", fraction)
+		assert.Equalf(t, expect, s, "should return correct value for %s", fraction)
+		assert.Equalf(t, expect, ratString(r), "should return correct value for %s", fraction)
+	}
+	_, err := ratDecimal(big.NewRat(1, 3))
+	assert.Error(t, err, "should fail for 1/3")
+	assert.Equal(t, "1/3", ratString(big.NewRat(1, 3)), "should return fraction")
+}
+
+// TestScanBigText tests scanBigText.
+func TestScanBigText(t *testing.T) {
+	for src, expect := range map[any]string{
+		"12.34":   "12.34",
+		int64(-5): "-5",
+		0.1:       "0.1",
+	} {
+		s, valid, err := scanBigText(src)
+		assert.NoErrorf(t, err, "should not fail for %v", src)
+		assert.Truef(t, valid, "should be valid for %v", src)
+		assert.Equalf(t, expect, s, "should return correct value for %v", src)
+	}
+	s, valid, err := scanBigText([]byte("1"))
+	assert.NoError(t, err, "should not fail")
+	assert.True(t, valid, "should be valid")
+	assert.Equal(t, "1", s, "should return correct value")
+	_, valid, err = scanBigText(nil)
+	assert.NoError(t, err, "should not fail")
+	assert.False(t, valid, "should not be valid")
+	_, _, err = scanBigText(true)
+	assert.Error(t, err, "should fail")
+}
@@ -4,6 +4,10 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"strconv"
 )
 
 // Bool holds a nullable boolean value.
@@ -22,6 +26,116 @@ func NewBool(b bool) Bool {
 	}
 }
 
+// BoolFromPtr returns a Bool that is valid if the given pointer is not nil.
+func BoolFromPtr(v *bool) Bool {
+	if v == nil {
+		return Bool{}
+	}
+	return NewBool(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (b Bool) Ptr() *bool {
+	if !b.Valid {
+		return nil
+	}
+	v := b.Bool
+	return &v
+}
+
+// BoolFromSQL returns a Bool from the given sql.NullBool.
+func BoolFromSQL(v sql.NullBool) Bool {
+	return Bool{
+		Bool:  v.Bool,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullBool representation.
+func (b Bool) ToSQL() sql.NullBool {
+	return sql.NullBool{
+		Bool:  b.Bool,
+		Valid: b.Valid,
+	}
+}
+
+// BoolFromSQLNull returns a Bool from the given sql.Null.
+func BoolFromSQLNull(v sql.Null[bool]) Bool {
+	return Bool{
+		Bool:  v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (b Bool) ToSQLNull() sql.Null[bool] {
+	return sql.Null[bool]{
+		V:     b.Bool,
+		Valid: b.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (b Bool) Get() (bool, bool) {
+	return b.Bool, b.Valid
+}
+
+// IsNull returns true if not valid.
+func (b Bool) IsNull() bool {
+	return !b.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (b *Bool) Set(v bool) {
+	*b = NewBool(v)
+}
+
+// SetNull sets a NULL-value.
+func (b *Bool) SetNull() {
+	*b = Bool{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (b Bool) IsZero() bool {
+	return !b.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (b Bool) Equal(other Bool) bool {
+	if !b.Valid || !other.Valid {
+		return b.Valid == other.Valid
+	}
+	return b.Bool == other.Bool
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (b Bool) String() string {
+	return formatString(b.Bool, b.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (b Bool) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, b, b.Bool, b.Valid)
+}
+
+// GoString returns a Go expression creating the Bool.
+func (b Bool) GoString() string {
+	if !b.Valid {
+		return "nulls.Bool{}"
+	}
+	return fmt.Sprintf("nulls.NewBool(%#v)", b.Bool)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (b Bool) LogValue() slog.Value {
+	if !b.Valid {
+		return logNullValue()
+	}
+	return slog.BoolValue(b.Bool)
+}
+
 // MarshalJSON marshals the Bool. If not valid, a NULL-value is returned.
 func (b Bool) MarshalJSON() ([]byte, error) {
 	if !b.Valid {
@@ -33,13 +147,60 @@ func (b Bool) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON as boolean or sets Valid to false if null.
 func (b *Bool) UnmarshalJSON(data []byte) error {
 	if isNull(data) {
-		b.Valid = false
+		b.SetNull()
 		return nil
 	}
 	b.Valid = true
 	return json.Unmarshal(data, &b.Bool)
 }
 
+// MarshalText marshals the Bool as "true" or "false". If not valid, empty text
+// is returned.
+func (b Bool) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatBool(b.Bool)), nil
+}
+
+// UnmarshalText as boolean or sets Valid to false if empty. Accepted values are
+// the ones from strconv.ParseBool.
+func (b *Bool) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		b.SetNull()
+		return nil
+	}
+	v, err := strconv.ParseBool(string(text))
+	if err != nil {
+		return err
+	}
+	b.Valid = true
+	b.Bool = v
+	return nil
+}
+
+// MarshalYAML marshals the boolean. If not valid, a NULL-value is returned.
+func (b Bool) MarshalYAML() (any, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Bool, nil
+}
+
+// UnmarshalYAML as boolean or sets Valid to false if null.
+func (b *Bool) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		b.SetNull()
+		return nil
+	}
+	err := node.Decode(&b.Bool)
+	if err != nil {
+		return err
+	}
+	b.Valid = true
+	return nil
+}
+
 // Scan to boolean value or not valid if nil.
 func (b *Bool) Scan(src any) error {
 	var sqlBool sql.NullBool
@@ -1,8 +1,12 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -89,6 +93,68 @@ func TestBool_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(BoolUnmarshalJSONSuite))
 }
 
+// BoolMarshalYAMLSuite tests Bool.MarshalYAML.
+type BoolMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *BoolMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Bool{Bool: true})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *BoolMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewBool(true))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("true\n", string(raw), "should return correct value")
+}
+
+func TestBool_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(BoolMarshalYAMLSuite))
+}
+
+// BoolUnmarshalYAMLSuite tests Bool.UnmarshalYAML.
+type BoolUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *BoolUnmarshalYAMLSuite) TestNull() {
+	v := NewBool(true)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *BoolUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Bool `yaml:"v"`
+		W Bool `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *BoolUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Bool
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *BoolUnmarshalYAMLSuite) TestOK() {
+	var v Bool
+	err := yaml.Unmarshal([]byte(`false`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(false, v.Bool, "should unmarshal correct value")
+}
+
+func TestBool_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(BoolUnmarshalYAMLSuite))
+}
+
 // BoolScanSuite tests Bool.Scan.
 type BoolScanSuite struct {
 	suite.Suite
@@ -150,3 +216,206 @@ func (suite *BoolValueSuite) TestOK2() {
 func TestBool_Value(t *testing.T) {
 	suite.Run(t, new(BoolValueSuite))
 }
+
+// TestBool_Get tests Bool.Get.
+func TestBool_Get(t *testing.T) {
+	v, ok := NewBool(true).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, true, v, "should return correct value")
+	_, ok = Bool{Bool: true}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestBool_IsZero tests Bool.IsZero.
+func TestBool_IsZero(t *testing.T) {
+	assert.False(t, NewBool(true).IsZero(), "should not be zero")
+	assert.True(t, Bool{Bool: true}.IsZero(), "should be zero")
+}
+
+// TestBool_Equal tests Bool.Equal.
+func TestBool_Equal(t *testing.T) {
+	assert.True(t, NewBool(true).Equal(NewBool(true)), "should be equal")
+	assert.False(t, NewBool(true).Equal(NewBool(false)), "should not be equal")
+	assert.False(t, NewBool(true).Equal(Bool{Bool: true}), "should not be equal")
+	assert.False(t, Bool{Bool: true}.Equal(NewBool(true)), "should not be equal")
+	assert.True(t, Bool{Bool: true}.Equal(Bool{}), "should be equal")
+}
+
+// TestBool_String tests Bool.String.
+func TestBool_String(t *testing.T) {
+	assert.Equal(t, "true", NewBool(true).String(), "should return correct value")
+	assert.Equal(t, NullToken, Bool{Bool: true}.String(), "should return null token")
+}
+
+// BoolFormatSuite tests Bool.Format.
+type BoolFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *BoolFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Bool{Bool: true}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Bool{Bool: true}), "should respect width")
+}
+
+func (suite *BoolFormatSuite) TestValue() {
+	suite.Equal("true", fmt.Sprintf("%v", NewBool(true)), "should return correct value")
+}
+
+func (suite *BoolFormatSuite) TestBool() {
+	suite.Equal("true", fmt.Sprintf("%t", NewBool(true)), "should return correct value")
+}
+
+func (suite *BoolFormatSuite) TestWidth() {
+	suite.Equal("  true", fmt.Sprintf("%6v", NewBool(true)), "should return correct value")
+}
+
+func (suite *BoolFormatSuite) TestGoSyntax() {
+	suite.Equal(NewBool(true).GoString(), fmt.Sprintf("%#v", NewBool(true)), "should use GoString")
+}
+
+func TestBool_Format(t *testing.T) {
+	suite.Run(t, new(BoolFormatSuite))
+}
+
+// TestBool_GoString tests Bool.GoString.
+func TestBool_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewBool(true)", NewBool(true).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Bool{}", Bool{Bool: true}.GoString(), "should return correct value")
+}
+
+// TestBool_LogValue tests Bool.LogValue.
+func TestBool_LogValue(t *testing.T) {
+	assert.Equal(t, true, NewBool(true).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Bool{Bool: true}.LogValue().Any(), "should return null value")
+}
+
+// BoolFromPtrSuite tests BoolFromPtr.
+type BoolFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *BoolFromPtrSuite) TestNil() {
+	v := BoolFromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *BoolFromPtrSuite) TestZero() {
+	x := false
+	v := BoolFromPtr(&x)
+	suite.Equal(NewBool(x), v, "should return correct value")
+}
+
+func (suite *BoolFromPtrSuite) TestOK() {
+	x := true
+	v := BoolFromPtr(&x)
+	suite.Equal(NewBool(x), v, "should return correct value")
+}
+
+func TestBoolFromPtr(t *testing.T) {
+	suite.Run(t, new(BoolFromPtrSuite))
+}
+
+// BoolPtrSuite tests Bool.Ptr.
+type BoolPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *BoolPtrSuite) TestNotValid() {
+	v := Bool{Bool: true}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *BoolPtrSuite) TestZero() {
+	p := NewBool(false).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(false, *p, "should return correct value")
+}
+
+func (suite *BoolPtrSuite) TestOK() {
+	v := NewBool(true)
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(true, *p, "should return correct value")
+	*p = false
+	suite.Equal(true, v.Bool, "should return copy")
+}
+
+func TestBool_Ptr(t *testing.T) {
+	suite.Run(t, new(BoolPtrSuite))
+}
+
+// TestBoolFromSQL tests BoolFromSQL.
+func TestBoolFromSQL(t *testing.T) {
+	assert.Equal(t, Bool{}, BoolFromSQL(sql.NullBool{}), "should return correct value")
+	assert.Equal(t, NewBool(true), BoolFromSQL(sql.NullBool{Bool: true, Valid: true}), "should return correct value")
+}
+
+// TestBool_ToSQL tests Bool.ToSQL.
+func TestBool_ToSQL(t *testing.T) {
+	assert.False(t, Bool{Bool: true}.ToSQL().Valid, "should not be valid")
+	assert.Equal(t, sql.NullBool{Bool: true, Valid: true}, NewBool(true).ToSQL(), "should return correct value")
+}
+
+// TestBoolFromSQLNull tests BoolFromSQLNull.
+func TestBoolFromSQLNull(t *testing.T) {
+	assert.Equal(t, Bool{}, BoolFromSQLNull(sql.Null[bool]{}), "should return correct value")
+	assert.Equal(t, NewBool(true), BoolFromSQLNull(sql.Null[bool]{V: true, Valid: true}), "should return correct value")
+}
+
+// TestBool_ToSQLNull tests Bool.ToSQLNull.
+func TestBool_ToSQLNull(t *testing.T) {
+	assert.False(t, Bool{Bool: true}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[bool]{V: true, Valid: true}, NewBool(true).ToSQLNull(), "should return correct value")
+}
+
+// BoolMarshalTextSuite tests Bool.MarshalText.
+type BoolMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *BoolMarshalTextSuite) TestNotValid() {
+	v := Bool{Bool: true}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *BoolMarshalTextSuite) TestOK() {
+	text, err := NewBool(true).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`true`, string(text), "should return correct value")
+}
+
+func TestBool_MarshalText(t *testing.T) {
+	suite.Run(t, new(BoolMarshalTextSuite))
+}
+
+// BoolUnmarshalTextSuite tests Bool.UnmarshalText.
+type BoolUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *BoolUnmarshalTextSuite) TestEmpty() {
+	v := NewBool(true)
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *BoolUnmarshalTextSuite) TestUnmarshalFail() {
+	var v Bool
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *BoolUnmarshalTextSuite) TestOK() {
+	var v Bool
+	err := v.UnmarshalText([]byte(`false`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(false, v.Bool, "should unmarshal correct value")
+}
+
+func TestBool_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(BoolUnmarshalTextSuite))
+}
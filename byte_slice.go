@@ -1,13 +1,19 @@
 package nulls
 
 import (
+	"bytes"
 	"database/sql"
 	"database/sql/driver"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
-// ByteSlice holds a nullable byte slice.
+// ByteSlice holds a nullable byte slice. In the database, it is stored as base64
+// encoded string. If you want to store raw bytes, for example in BYTEA or BLOB
+// columns, use Bytes instead.
 type ByteSlice struct {
 	// ByteSlice is the actual byte slice when Valid.
 	ByteSlice []byte `exhaustruct:"optional"`
@@ -23,6 +29,102 @@ func NewByteSlice(b []byte) ByteSlice {
 	}
 }
 
+// ByteSliceFromPtr returns a ByteSlice that is valid if the given pointer is
+// not nil.
+func ByteSliceFromPtr(v *[]byte) ByteSlice {
+	if v == nil {
+		return ByteSlice{}
+	}
+	return NewByteSlice(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (b ByteSlice) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	v := b.ByteSlice
+	return &v
+}
+
+// ByteSliceFromSQLNull returns a ByteSlice from the given sql.Null.
+func ByteSliceFromSQLNull(v sql.Null[[]byte]) ByteSlice {
+	return ByteSlice{
+		ByteSlice: v.V,
+		Valid:     v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (b ByteSlice) ToSQLNull() sql.Null[[]byte] {
+	return sql.Null[[]byte]{
+		V:     b.ByteSlice,
+		Valid: b.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (b ByteSlice) Get() ([]byte, bool) {
+	return b.ByteSlice, b.Valid
+}
+
+// IsNull returns true if not valid.
+func (b ByteSlice) IsNull() bool {
+	return !b.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (b *ByteSlice) Set(v []byte) {
+	*b = NewByteSlice(v)
+}
+
+// SetNull sets a NULL-value.
+func (b *ByteSlice) SetNull() {
+	*b = ByteSlice{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (b ByteSlice) IsZero() bool {
+	return !b.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (b ByteSlice) Equal(other ByteSlice) bool {
+	if !b.Valid || !other.Valid {
+		return b.Valid == other.Valid
+	}
+	return bytes.Equal(b.ByteSlice, other.ByteSlice)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (b ByteSlice) String() string {
+	return formatString(b.ByteSlice, b.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (b ByteSlice) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, b, b.ByteSlice, b.Valid)
+}
+
+// GoString returns a Go expression creating the ByteSlice.
+func (b ByteSlice) GoString() string {
+	if !b.Valid {
+		return "nulls.ByteSlice{}"
+	}
+	return fmt.Sprintf("nulls.NewByteSlice(%#v)", b.ByteSlice)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged. If
+// RedactLogBytes is set, only the length is logged.
+func (b ByteSlice) LogValue() slog.Value {
+	if !b.Valid {
+		return logNullValue()
+	}
+	return logBytesValue(b.ByteSlice)
+}
+
 // MarshalJSON marshals the ByteSlice. If not valid, a NULL-value is returned.
 func (b ByteSlice) MarshalJSON() ([]byte, error) {
 	if !b.Valid {
@@ -34,13 +136,75 @@ func (b ByteSlice) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON as byte slice or sets Valid to false if null.
 func (b *ByteSlice) UnmarshalJSON(data []byte) error {
 	if isNull(data) {
-		b.Valid = false
+		b.SetNull()
 		return nil
 	}
 	b.Valid = true
 	return json.Unmarshal(data, &b.ByteSlice)
 }
 
+// MarshalText marshals the byte slice as base64 like MarshalJSON. If not valid,
+// empty text is returned. Keep in mind, that this means that an empty byte slice
+// cannot be distinguished from a NULL-value.
+func (b ByteSlice) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	text := make([]byte, base64.StdEncoding.EncodedLen(len(b.ByteSlice)))
+	base64.StdEncoding.Encode(text, b.ByteSlice)
+	return text, nil
+}
+
+// UnmarshalText as base64 encoded byte slice or sets Valid to false if empty.
+func (b *ByteSlice) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		b.SetNull()
+		return nil
+	}
+	v := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Decode(v, text)
+	if err != nil {
+		return err
+	}
+	b.Valid = true
+	b.ByteSlice = v[:n]
+	return nil
+}
+
+// MarshalYAML marshals the byte slice as base64 like MarshalJSON. If not
+// valid, a NULL-value is returned.
+func (b ByteSlice) MarshalYAML() (any, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return base64.StdEncoding.EncodeToString(b.ByteSlice), nil
+}
+
+// UnmarshalYAML as base64 encoded byte slice or sets Valid to false if null.
+// Values tagged as !!binary are supported as well.
+func (b *ByteSlice) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		b.SetNull()
+		return nil
+	}
+	// Decoding !!binary into a string yields the already decoded bytes.
+	var s string
+	err := node.Decode(&s)
+	if err != nil {
+		return err
+	}
+	v := []byte(s)
+	if node.ShortTag() != "!!binary" {
+		v, err = base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return err
+		}
+	}
+	b.Valid = true
+	b.ByteSlice = v
+	return nil
+}
+
 // Scan to byte slice value or not valid if nil.
 func (b *ByteSlice) Scan(src any) error {
 	var sqlString sql.NullString
@@ -48,11 +212,16 @@ func (b *ByteSlice) Scan(src any) error {
 	if err != nil {
 		return err
 	}
-	b.Valid = sqlString.Valid
-	b.ByteSlice, err = base64.StdEncoding.DecodeString(sqlString.String)
+	if !sqlString.Valid {
+		b.SetNull()
+		return nil
+	}
+	v, err := base64.StdEncoding.DecodeString(sqlString.String)
 	if err != nil {
 		return err
 	}
+	b.Valid = true
+	b.ByteSlice = v
 	return nil
 }
 
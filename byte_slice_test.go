@@ -1,10 +1,13 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -65,6 +68,76 @@ func TestByteSlice_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(ByteSliceUnmarshalJSONSuite))
 }
 
+// ByteSliceMarshalYAMLSuite tests ByteSlice.MarshalYAML.
+type ByteSliceMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *ByteSliceMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(ByteSlice{ByteSlice: []byte("meow")})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *ByteSliceMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewByteSlice([]byte("meow")))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("bWVvdw==\n", string(raw), "should return correct value")
+}
+
+func TestByteSlice_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(ByteSliceMarshalYAMLSuite))
+}
+
+// ByteSliceUnmarshalYAMLSuite tests ByteSlice.UnmarshalYAML.
+type ByteSliceUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *ByteSliceUnmarshalYAMLSuite) TestNull() {
+	v := NewByteSlice([]byte("meow"))
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *ByteSliceUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V ByteSlice `yaml:"v"`
+		W ByteSlice `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *ByteSliceUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v ByteSlice
+	err := yaml.Unmarshal([]byte(`"%%%"`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *ByteSliceUnmarshalYAMLSuite) TestOK() {
+	var v ByteSlice
+	err := yaml.Unmarshal([]byte(`bWVvdw==`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal([]byte("meow"), v.ByteSlice, "should unmarshal correct value")
+}
+
+func (suite *ByteSliceUnmarshalYAMLSuite) TestBinary() {
+	var v ByteSlice
+	err := yaml.Unmarshal([]byte(`!!binary bWVvdw==`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal([]byte("meow"), v.ByteSlice, "should unmarshal correct value")
+}
+
+func TestByteSlice_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(ByteSliceUnmarshalYAMLSuite))
+}
+
 // ByteSliceScanSuite tests ByteSlice.Scan.
 type ByteSliceScanSuite struct {
 	suite.Suite
@@ -113,3 +186,194 @@ func (suite *ByteSliceValueSuite) TestOK() {
 func TestByteSlice_Value(t *testing.T) {
 	suite.Run(t, new(ByteSliceValueSuite))
 }
+
+// TestByteSlice_Get tests ByteSlice.Get.
+func TestByteSlice_Get(t *testing.T) {
+	v, ok := NewByteSlice([]byte("Hello World!")).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, []byte("Hello World!"), v, "should return correct value")
+	_, ok = ByteSlice{ByteSlice: []byte("Hello World!")}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestByteSlice_IsZero tests ByteSlice.IsZero.
+func TestByteSlice_IsZero(t *testing.T) {
+	assert.False(t, NewByteSlice([]byte("Hello World!")).IsZero(), "should not be zero")
+	assert.True(t, ByteSlice{ByteSlice: []byte("Hello World!")}.IsZero(), "should be zero")
+}
+
+// TestByteSlice_Equal tests ByteSlice.Equal.
+func TestByteSlice_Equal(t *testing.T) {
+	assert.True(t, NewByteSlice([]byte("meow")).Equal(NewByteSlice([]byte("meow"))), "should be equal")
+	assert.False(t, NewByteSlice([]byte("meow")).Equal(NewByteSlice([]byte("woof"))), "should not be equal")
+	assert.False(t, NewByteSlice([]byte("meow")).Equal(ByteSlice{ByteSlice: []byte("meow")}), "should not be equal")
+	assert.False(t, ByteSlice{ByteSlice: []byte("meow")}.Equal(NewByteSlice([]byte("meow"))), "should not be equal")
+	assert.True(t, ByteSlice{ByteSlice: []byte("meow")}.Equal(ByteSlice{}), "should be equal")
+}
+
+// TestByteSlice_String tests ByteSlice.String.
+func TestByteSlice_String(t *testing.T) {
+	assert.Equal(t, "[109 101 111 119]", NewByteSlice([]byte("meow")).String(), "should return correct value")
+	assert.Equal(t, NullToken, ByteSlice{ByteSlice: []byte("meow")}.String(), "should return null token")
+}
+
+// ByteSliceFormatSuite tests ByteSlice.Format.
+type ByteSliceFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *ByteSliceFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", ByteSlice{ByteSlice: []byte("meow")}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", ByteSlice{ByteSlice: []byte("meow")}), "should respect width")
+}
+
+func (suite *ByteSliceFormatSuite) TestValue() {
+	suite.Equal("[109 101 111 119]", fmt.Sprintf("%v", NewByteSlice([]byte("meow"))), "should return correct value")
+}
+
+func (suite *ByteSliceFormatSuite) TestString() {
+	suite.Equal("meow", fmt.Sprintf("%s", NewByteSlice([]byte("meow"))), "should return correct value")
+}
+
+func (suite *ByteSliceFormatSuite) TestHex() {
+	suite.Equal("6d656f77", fmt.Sprintf("%x", NewByteSlice([]byte("meow"))), "should return correct value")
+}
+
+func (suite *ByteSliceFormatSuite) TestGoSyntax() {
+	suite.Equal(NewByteSlice([]byte("meow")).GoString(), fmt.Sprintf("%#v", NewByteSlice([]byte("meow"))), "should use GoString")
+}
+
+func TestByteSlice_Format(t *testing.T) {
+	suite.Run(t, new(ByteSliceFormatSuite))
+}
+
+// TestByteSlice_GoString tests ByteSlice.GoString.
+func TestByteSlice_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewByteSlice([]byte{0x6d, 0x65, 0x6f, 0x77})", NewByteSlice([]byte("meow")).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.ByteSlice{}", ByteSlice{ByteSlice: []byte("meow")}.GoString(), "should return correct value")
+}
+
+// TestByteSlice_LogValue tests ByteSlice.LogValue.
+func TestByteSlice_LogValue(t *testing.T) {
+	assert.Equal(t, []byte("meow"), NewByteSlice([]byte("meow")).LogValue().Any(), "should return correct value")
+	assert.Nil(t, ByteSlice{ByteSlice: []byte("meow")}.LogValue().Any(), "should return null value")
+}
+
+// ByteSliceFromPtrSuite tests ByteSliceFromPtr.
+type ByteSliceFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *ByteSliceFromPtrSuite) TestNil() {
+	v := ByteSliceFromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *ByteSliceFromPtrSuite) TestZero() {
+	x := []byte{}
+	v := ByteSliceFromPtr(&x)
+	suite.Equal(NewByteSlice(x), v, "should return correct value")
+}
+
+func (suite *ByteSliceFromPtrSuite) TestOK() {
+	x := []byte("Hello World!")
+	v := ByteSliceFromPtr(&x)
+	suite.Equal(NewByteSlice(x), v, "should return correct value")
+}
+
+func TestByteSliceFromPtr(t *testing.T) {
+	suite.Run(t, new(ByteSliceFromPtrSuite))
+}
+
+// ByteSlicePtrSuite tests ByteSlice.Ptr.
+type ByteSlicePtrSuite struct {
+	suite.Suite
+}
+
+func (suite *ByteSlicePtrSuite) TestNotValid() {
+	v := ByteSlice{ByteSlice: []byte("Hello World!")}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *ByteSlicePtrSuite) TestZero() {
+	p := NewByteSlice([]byte{}).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal([]byte{}, *p, "should return correct value")
+}
+
+func (suite *ByteSlicePtrSuite) TestOK() {
+	v := NewByteSlice([]byte("Hello World!"))
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal([]byte("Hello World!"), *p, "should return correct value")
+	*p = []byte{}
+	suite.Equal([]byte("Hello World!"), v.ByteSlice, "should return copy")
+}
+
+func TestByteSlice_Ptr(t *testing.T) {
+	suite.Run(t, new(ByteSlicePtrSuite))
+}
+
+// TestByteSliceFromSQLNull tests ByteSliceFromSQLNull.
+func TestByteSliceFromSQLNull(t *testing.T) {
+	assert.Equal(t, ByteSlice{}, ByteSliceFromSQLNull(sql.Null[[]byte]{}), "should return correct value")
+	assert.Equal(t, NewByteSlice([]byte("Hello World!")), ByteSliceFromSQLNull(sql.Null[[]byte]{V: []byte("Hello World!"), Valid: true}), "should return correct value")
+}
+
+// TestByteSlice_ToSQLNull tests ByteSlice.ToSQLNull.
+func TestByteSlice_ToSQLNull(t *testing.T) {
+	assert.False(t, ByteSlice{ByteSlice: []byte("Hello World!")}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[[]byte]{V: []byte("Hello World!"), Valid: true}, NewByteSlice([]byte("Hello World!")).ToSQLNull(), "should return correct value")
+}
+
+// ByteSliceMarshalTextSuite tests ByteSlice.MarshalText.
+type ByteSliceMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *ByteSliceMarshalTextSuite) TestNotValid() {
+	v := ByteSlice{ByteSlice: []byte("Hello World!")}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *ByteSliceMarshalTextSuite) TestOK() {
+	text, err := NewByteSlice([]byte("Hello World!")).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`SGVsbG8gV29ybGQh`, string(text), "should return correct value")
+}
+
+func TestByteSlice_MarshalText(t *testing.T) {
+	suite.Run(t, new(ByteSliceMarshalTextSuite))
+}
+
+// ByteSliceUnmarshalTextSuite tests ByteSlice.UnmarshalText.
+type ByteSliceUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *ByteSliceUnmarshalTextSuite) TestEmpty() {
+	v := NewByteSlice([]byte("Hello World!"))
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *ByteSliceUnmarshalTextSuite) TestUnmarshalFail() {
+	var v ByteSlice
+	err := v.UnmarshalText([]byte(`!!!`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *ByteSliceUnmarshalTextSuite) TestOK() {
+	var v ByteSlice
+	err := v.UnmarshalText([]byte(`bWVvdw==`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal([]byte("meow"), v.ByteSlice, "should unmarshal correct value")
+}
+
+func TestByteSlice_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(ByteSliceUnmarshalTextSuite))
+}
@@ -0,0 +1,237 @@
+package nulls
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+)
+
+// Bytes holds a nullable byte slice. Unlike ByteSlice, it is stored as raw bytes
+// in the database, which makes it suitable for BYTEA or BLOB columns. JSON and
+// text representation are base64 like ByteSlice.
+type Bytes struct {
+	// Bytes is the actual byte slice when Valid.
+	Bytes []byte `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewBytes returns a valid Bytes with the given value.
+func NewBytes(b []byte) Bytes {
+	return Bytes{
+		Bytes: b,
+		Valid: true,
+	}
+}
+
+// BytesFromPtr returns a Bytes that is valid if the given pointer is not nil.
+func BytesFromPtr(v *[]byte) Bytes {
+	if v == nil {
+		return Bytes{}
+	}
+	return NewBytes(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	v := b.Bytes
+	return &v
+}
+
+// BytesFromSQLNull returns a Bytes from the given sql.Null.
+func BytesFromSQLNull(v sql.Null[[]byte]) Bytes {
+	return Bytes{
+		Bytes: v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (b Bytes) ToSQLNull() sql.Null[[]byte] {
+	return sql.Null[[]byte]{
+		V:     b.Bytes,
+		Valid: b.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (b Bytes) Get() ([]byte, bool) {
+	return b.Bytes, b.Valid
+}
+
+// IsNull returns true if not valid.
+func (b Bytes) IsNull() bool {
+	return !b.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (b *Bytes) Set(v []byte) {
+	*b = NewBytes(v)
+}
+
+// SetNull sets a NULL-value.
+func (b *Bytes) SetNull() {
+	*b = Bytes{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (b Bytes) IsZero() bool {
+	return !b.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (b Bytes) Equal(other Bytes) bool {
+	if !b.Valid || !other.Valid {
+		return b.Valid == other.Valid
+	}
+	return bytes.Equal(b.Bytes, other.Bytes)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (b Bytes) String() string {
+	return formatString(b.Bytes, b.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (b Bytes) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, b, b.Bytes, b.Valid)
+}
+
+// GoString returns a Go expression creating the Bytes.
+func (b Bytes) GoString() string {
+	if !b.Valid {
+		return "nulls.Bytes{}"
+	}
+	return fmt.Sprintf("nulls.NewBytes(%#v)", b.Bytes)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged. If
+// RedactLogBytes is set, only the length is logged.
+func (b Bytes) LogValue() slog.Value {
+	if !b.Valid {
+		return logNullValue()
+	}
+	return logBytesValue(b.Bytes)
+}
+
+// MarshalJSON marshals the byte slice as base64. If not valid, a NULL-value is returned.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(b.Bytes)
+}
+
+// UnmarshalJSON as base64 encoded byte slice or sets Valid to false if null.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		b.SetNull()
+		return nil
+	}
+	b.Valid = true
+	return json.Unmarshal(data, &b.Bytes)
+}
+
+// MarshalText marshals the byte slice as base64 like MarshalJSON. If not valid,
+// empty text is returned. Keep in mind, that this means that an empty byte slice
+// cannot be distinguished from a NULL-value.
+func (b Bytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	text := make([]byte, base64.StdEncoding.EncodedLen(len(b.Bytes)))
+	base64.StdEncoding.Encode(text, b.Bytes)
+	return text, nil
+}
+
+// UnmarshalText as base64 encoded byte slice or sets Valid to false if empty.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		b.SetNull()
+		return nil
+	}
+	v := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Decode(v, text)
+	if err != nil {
+		return err
+	}
+	b.Valid = true
+	b.Bytes = v[:n]
+	return nil
+}
+
+// MarshalYAML marshals the byte slice as base64 like MarshalJSON. If not
+// valid, a NULL-value is returned.
+func (b Bytes) MarshalYAML() (any, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return base64.StdEncoding.EncodeToString(b.Bytes), nil
+}
+
+// UnmarshalYAML as base64 encoded byte slice or sets Valid to false if null.
+// Values tagged as !!binary are supported as well.
+func (b *Bytes) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		b.SetNull()
+		return nil
+	}
+	// Decoding !!binary into a string yields the already decoded bytes.
+	var s string
+	err := node.Decode(&s)
+	if err != nil {
+		return err
+	}
+	v := []byte(s)
+	if node.ShortTag() != "!!binary" {
+		v, err = base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return err
+		}
+	}
+	b.Valid = true
+	b.Bytes = v
+	return nil
+}
+
+// Scan to byte slice value or not valid if nil. The bytes are copied, so they
+// stay valid after the next call to Next of sql.Rows.
+func (b *Bytes) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		b.SetNull()
+		return nil
+	case []byte:
+		b.Valid = true
+		b.Bytes = copyBytes(src)
+		return nil
+	case string:
+		b.Valid = true
+		b.Bytes = []byte(src)
+		return nil
+	default:
+		return fmt.Errorf("unsupported source value type: %T", src)
+	}
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. A valid
+// nil slice is returned as empty slice, as drivers store nil as NULL.
+func (b Bytes) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	if b.Bytes == nil {
+		return []byte{}, nil
+	}
+	return b.Bytes, nil
+}
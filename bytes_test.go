@@ -0,0 +1,402 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNewBytes tests NewBytes.
+func TestNewBytes(t *testing.T) {
+	raw := []byte("Hello World!")
+	b := NewBytes(raw)
+	assert.True(t, b.Valid, "should be valid")
+	assert.Equal(t, raw, b.Bytes, "should contain correct value")
+}
+
+// BytesMarshalJSONSuite tests Bytes.MarshalJSON.
+type BytesMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesMarshalJSONSuite) TestNotValid() {
+	b := Bytes{Bytes: []byte("meow")}
+	raw, err := json.Marshal(b)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *BytesMarshalJSONSuite) TestOK() {
+	v := []byte("Hello World!")
+	b := NewBytes(v)
+	raw, err := json.Marshal(b)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(marshalMust(v), raw, "should return correct value")
+}
+
+func TestBytes_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(BytesMarshalJSONSuite))
+}
+
+// BytesUnmarshalJSONSuite tests Bytes.UnmarshalJSON.
+type BytesUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesUnmarshalJSONSuite) TestNull() {
+	var b Bytes
+	err := json.Unmarshal(jsonNull, &b)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(b.Valid, "should not be valid")
+}
+
+func (suite *BytesUnmarshalJSONSuite) TestOK() {
+	v := []byte("Hello World!")
+	var b Bytes
+	err := json.Unmarshal(marshalMust(v), &b)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(b.Valid, "should be valid")
+	suite.Equal(v, b.Bytes, "should unmarshal correct value")
+}
+
+func TestBytes_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(BytesUnmarshalJSONSuite))
+}
+
+// BytesMarshalYAMLSuite tests Bytes.MarshalYAML.
+type BytesMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Bytes{Bytes: []byte("meow")})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *BytesMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewBytes([]byte("meow")))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("bWVvdw==\n", string(raw), "should return correct value")
+}
+
+func TestBytes_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(BytesMarshalYAMLSuite))
+}
+
+// BytesUnmarshalYAMLSuite tests Bytes.UnmarshalYAML.
+type BytesUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesUnmarshalYAMLSuite) TestNull() {
+	v := NewBytes([]byte("meow"))
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *BytesUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Bytes `yaml:"v"`
+		W Bytes `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *BytesUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Bytes
+	err := yaml.Unmarshal([]byte(`"%%%"`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *BytesUnmarshalYAMLSuite) TestOK() {
+	var v Bytes
+	err := yaml.Unmarshal([]byte(`bWVvdw==`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal([]byte("meow"), v.Bytes, "should unmarshal correct value")
+}
+
+func (suite *BytesUnmarshalYAMLSuite) TestBinary() {
+	var v Bytes
+	err := yaml.Unmarshal([]byte(`!!binary bWVvdw==`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal([]byte("meow"), v.Bytes, "should unmarshal correct value")
+}
+
+func TestBytes_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(BytesUnmarshalYAMLSuite))
+}
+
+// BytesScanSuite tests Bytes.Scan.
+type BytesScanSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesScanSuite) TestNull() {
+	b := NewBytes([]byte("meow"))
+	err := b.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(b.Valid, "should not be valid")
+	suite.Nil(b.Bytes, "should clear value")
+}
+
+func (suite *BytesScanSuite) TestUnsupported() {
+	var b Bytes
+	err := b.Scan(16)
+	suite.Error(err, "should fail")
+}
+
+func (suite *BytesScanSuite) TestBytes() {
+	src := []byte("Hello World!")
+	var b Bytes
+	err := b.Scan(src)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(b.Valid, "should be valid")
+	suite.Equal([]byte("Hello World!"), b.Bytes, "should scan correct value")
+	src[0] = 'h'
+	suite.Equal([]byte("Hello World!"), b.Bytes, "should copy bytes")
+}
+
+func (suite *BytesScanSuite) TestString() {
+	var b Bytes
+	err := b.Scan("Hello World!")
+	suite.Require().NoError(err, "should not fail")
+	suite.True(b.Valid, "should be valid")
+	suite.Equal([]byte("Hello World!"), b.Bytes, "should scan correct value")
+}
+
+func TestBytes_Scan(t *testing.T) {
+	suite.Run(t, new(BytesScanSuite))
+}
+
+// BytesValueSuite tests Bytes.Value.
+type BytesValueSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesValueSuite) TestNull() {
+	b := Bytes{Bytes: []byte("Hello World!")}
+	raw, err := b.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *BytesValueSuite) TestOK() {
+	v := []byte("Hello World")
+	b := NewBytes(v)
+	raw, err := b.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(v, raw, "should return correct value")
+}
+
+func (suite *BytesValueSuite) TestValidNil() {
+	raw, err := NewBytes(nil).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.NotNil(raw, "should not return nil")
+	suite.Equal([]byte{}, raw, "should return empty slice")
+}
+
+func TestBytes_Value(t *testing.T) {
+	suite.Run(t, new(BytesValueSuite))
+}
+
+// TestBytes_Get tests Bytes.Get.
+func TestBytes_Get(t *testing.T) {
+	v, ok := NewBytes([]byte("Hello World!")).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, []byte("Hello World!"), v, "should return correct value")
+	_, ok = Bytes{Bytes: []byte("Hello World!")}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestBytes_IsZero tests Bytes.IsZero.
+func TestBytes_IsZero(t *testing.T) {
+	assert.False(t, NewBytes([]byte("Hello World!")).IsZero(), "should not be zero")
+	assert.True(t, Bytes{Bytes: []byte("Hello World!")}.IsZero(), "should be zero")
+}
+
+// TestBytes_Equal tests Bytes.Equal.
+func TestBytes_Equal(t *testing.T) {
+	assert.True(t, NewBytes([]byte("meow")).Equal(NewBytes([]byte("meow"))), "should be equal")
+	assert.False(t, NewBytes([]byte("meow")).Equal(NewBytes([]byte("woof"))), "should not be equal")
+	assert.False(t, NewBytes([]byte("meow")).Equal(Bytes{Bytes: []byte("meow")}), "should not be equal")
+	assert.False(t, Bytes{Bytes: []byte("meow")}.Equal(NewBytes([]byte("meow"))), "should not be equal")
+	assert.True(t, Bytes{Bytes: []byte("meow")}.Equal(Bytes{}), "should be equal")
+}
+
+// TestBytes_String tests Bytes.String.
+func TestBytes_String(t *testing.T) {
+	assert.Equal(t, "[109 101 111 119]", NewBytes([]byte("meow")).String(), "should return correct value")
+	assert.Equal(t, NullToken, Bytes{Bytes: []byte("meow")}.String(), "should return null token")
+}
+
+// BytesFormatSuite tests Bytes.Format.
+type BytesFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Bytes{Bytes: []byte("meow")}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Bytes{Bytes: []byte("meow")}), "should respect width")
+}
+
+func (suite *BytesFormatSuite) TestValue() {
+	suite.Equal("[109 101 111 119]", fmt.Sprintf("%v", NewBytes([]byte("meow"))), "should return correct value")
+}
+
+func (suite *BytesFormatSuite) TestString() {
+	suite.Equal("meow", fmt.Sprintf("%s", NewBytes([]byte("meow"))), "should return correct value")
+}
+
+func (suite *BytesFormatSuite) TestHex() {
+	suite.Equal("6d656f77", fmt.Sprintf("%x", NewBytes([]byte("meow"))), "should return correct value")
+}
+
+func (suite *BytesFormatSuite) TestGoSyntax() {
+	suite.Equal(NewBytes([]byte("meow")).GoString(), fmt.Sprintf("%#v", NewBytes([]byte("meow"))), "should use GoString")
+}
+
+func TestBytes_Format(t *testing.T) {
+	suite.Run(t, new(BytesFormatSuite))
+}
+
+// TestBytes_GoString tests Bytes.GoString.
+func TestBytes_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewBytes([]byte{0x6d, 0x65, 0x6f, 0x77})", NewBytes([]byte("meow")).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Bytes{}", Bytes{Bytes: []byte("meow")}.GoString(), "should return correct value")
+}
+
+// TestBytes_LogValue tests Bytes.LogValue.
+func TestBytes_LogValue(t *testing.T) {
+	assert.Equal(t, []byte("meow"), NewBytes([]byte("meow")).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Bytes{Bytes: []byte("meow")}.LogValue().Any(), "should return null value")
+}
+
+// BytesFromPtrSuite tests BytesFromPtr.
+type BytesFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesFromPtrSuite) TestNil() {
+	v := BytesFromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *BytesFromPtrSuite) TestZero() {
+	x := []byte{}
+	v := BytesFromPtr(&x)
+	suite.Equal(NewBytes(x), v, "should return correct value")
+}
+
+func (suite *BytesFromPtrSuite) TestOK() {
+	x := []byte("Hello World!")
+	v := BytesFromPtr(&x)
+	suite.Equal(NewBytes(x), v, "should return correct value")
+}
+
+func TestBytesFromPtr(t *testing.T) {
+	suite.Run(t, new(BytesFromPtrSuite))
+}
+
+// BytesPtrSuite tests Bytes.Ptr.
+type BytesPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesPtrSuite) TestNotValid() {
+	v := Bytes{Bytes: []byte("Hello World!")}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *BytesPtrSuite) TestZero() {
+	p := NewBytes([]byte{}).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal([]byte{}, *p, "should return correct value")
+}
+
+func (suite *BytesPtrSuite) TestOK() {
+	v := NewBytes([]byte("Hello World!"))
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal([]byte("Hello World!"), *p, "should return correct value")
+	*p = []byte{}
+	suite.Equal([]byte("Hello World!"), v.Bytes, "should return copy")
+}
+
+func TestBytes_Ptr(t *testing.T) {
+	suite.Run(t, new(BytesPtrSuite))
+}
+
+// TestBytesFromSQLNull tests BytesFromSQLNull.
+func TestBytesFromSQLNull(t *testing.T) {
+	assert.Equal(t, Bytes{}, BytesFromSQLNull(sql.Null[[]byte]{}), "should return correct value")
+	assert.Equal(t, NewBytes([]byte("Hello World!")), BytesFromSQLNull(sql.Null[[]byte]{V: []byte("Hello World!"), Valid: true}), "should return correct value")
+}
+
+// TestBytes_ToSQLNull tests Bytes.ToSQLNull.
+func TestBytes_ToSQLNull(t *testing.T) {
+	assert.False(t, Bytes{Bytes: []byte("Hello World!")}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[[]byte]{V: []byte("Hello World!"), Valid: true}, NewBytes([]byte("Hello World!")).ToSQLNull(), "should return correct value")
+}
+
+// BytesMarshalTextSuite tests Bytes.MarshalText.
+type BytesMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesMarshalTextSuite) TestNotValid() {
+	v := Bytes{Bytes: []byte("Hello World!")}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *BytesMarshalTextSuite) TestOK() {
+	text, err := NewBytes([]byte("Hello World!")).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`SGVsbG8gV29ybGQh`, string(text), "should return correct value")
+}
+
+func TestBytes_MarshalText(t *testing.T) {
+	suite.Run(t, new(BytesMarshalTextSuite))
+}
+
+// BytesUnmarshalTextSuite tests Bytes.UnmarshalText.
+type BytesUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *BytesUnmarshalTextSuite) TestEmpty() {
+	v := NewBytes([]byte("Hello World!"))
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *BytesUnmarshalTextSuite) TestUnmarshalFail() {
+	var v Bytes
+	err := v.UnmarshalText([]byte(`!!!`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *BytesUnmarshalTextSuite) TestOK() {
+	var v Bytes
+	err := v.UnmarshalText([]byte(`bWVvdw==`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal([]byte("meow"), v.Bytes, "should unmarshal correct value")
+}
+
+func TestBytes_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(BytesUnmarshalTextSuite))
+}
@@ -0,0 +1,619 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// generatedHeader is the first line of all generated files.
+const generatedHeader = "// Code generated by nullsgen; DO NOT EDIT."
+
+// config holds the options for run.
+type config struct {
+	// Dir is the directory of the package holding the types.
+	Dir string
+	// TypeNames are the names of the types to generate nullable types for.
+	TypeNames []string
+	// Prefix is prepended to the type names for the names of the generated
+	// types.
+	Prefix string
+	// Tests specifies whether to generate test files.
+	Tests bool
+}
+
+// kind describes an underlying type that is supported and the nulls type used
+// for it.
+type kind struct {
+	// NullsType is the name of the nulls type like Int64.
+	NullsType string
+	// Sample is a Go expression for a non-zero value used in tests.
+	Sample string
+	// SampleJSON is the JSON representation of Sample.
+	SampleJSON string
+	// SampleText is the text representation of Sample.
+	SampleText string
+}
+
+// Underlying returns the name of the underlying type of the nulls type.
+func (k kind) Underlying() string {
+	return strings.ToLower(k.NullsType)
+}
+
+// integerKind returns the kind for the integer type with the given nulls type.
+func integerKind(nullsType string) kind {
+	return kind{NullsType: nullsType, Sample: "42", SampleJSON: "42", SampleText: "42"}
+}
+
+// floatKind returns the kind for the float type with the given nulls type.
+func floatKind(nullsType string) kind {
+	return kind{NullsType: nullsType, Sample: "1.5", SampleJSON: "1.5", SampleText: "1.5"}
+}
+
+// kinds holds the supported underlying types by their name.
+var kinds = map[string]kind{
+	"bool":    {NullsType: "Bool", Sample: "true", SampleJSON: "true", SampleText: "true"},
+	"byte":    integerKind("Uint8"),
+	"float32": floatKind("Float32"),
+	"float64": floatKind("Float64"),
+	"int":     integerKind("Int"),
+	"int8":    integerKind("Int8"),
+	"int16":   integerKind("Int16"),
+	"int32":   integerKind("Int32"),
+	"int64":   integerKind("Int64"),
+	"rune":    integerKind("Int32"),
+	"string":  {NullsType: "String", Sample: `"meow"`, SampleJSON: `"meow"`, SampleText: "meow"},
+	"uint":    integerKind("Uint"),
+	"uint8":   integerKind("Uint8"),
+	"uint16":  integerKind("Uint16"),
+	"uint32":  integerKind("Uint32"),
+	"uint64":  integerKind("Uint64"),
+}
+
+// reservedNames are the names of the fields and methods of generated types. A
+// type with one of these names would conflict with them. String is handled
+// separately by omitting the String method.
+var reservedNames = []string{
+	"Valid", "Ptr", "ToSQLNull", "Get", "IsNull", "Set", "SetNull", "IsZero",
+	"Equal", "Format", "GoString", "LogValue", "MarshalJSON", "UnmarshalJSON",
+	"MarshalText", "UnmarshalText", "MarshalYAML", "UnmarshalYAML", "Scan",
+	"Value",
+}
+
+// pkg holds the type declarations of a parsed package.
+type pkg struct {
+	// Name is the package name.
+	Name string
+	// Types holds the type expressions of all declared types by their name.
+	Types map[string]ast.Expr
+}
+
+// typeData is passed to the templates.
+type typeData struct {
+	kind
+	// Package is the package name.
+	Package string
+	// Type is the name of the named type.
+	Type string
+	// Name is the name of the generated type.
+	Name string
+	// Stringer is false if the field is named String, which conflicts with the
+	// String method.
+	Stringer bool
+}
+
+// run generates the nullable types as specified by the given config.
+func run(cfg config) error {
+	p, err := parsePackage(cfg.Dir)
+	if err != nil {
+		return fmt.Errorf("parse package: %w", err)
+	}
+	for _, typeName := range cfg.TypeNames {
+		data, err := p.typeData(strings.TrimSpace(typeName), cfg.Prefix)
+		if err != nil {
+			return err
+		}
+		base := filepath.Join(cfg.Dir, snakeCase(data.Type)+"_null")
+		err = writeTemplate(base+".go", typeTemplate, data)
+		if err != nil {
+			return fmt.Errorf("generate type for %s: %w", data.Type, err)
+		}
+		if !cfg.Tests {
+			continue
+		}
+		err = writeTemplate(base+"_test.go", testTemplate, data)
+		if err != nil {
+			return fmt.Errorf("generate tests for %s: %w", data.Type, err)
+		}
+	}
+	return nil
+}
+
+// parsePackage parses the non-test Go files in the given directory. Files
+// generated by nullsgen are skipped, so that they can be regenerated.
+func parsePackage(dir string) (*pkg, error) {
+	fileNames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	p := &pkg{
+		Types: make(map[string]ast.Expr),
+	}
+	fset := token.NewFileSet()
+	for _, fileName := range fileNames {
+		if strings.HasSuffix(fileName, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, fileName, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		if isGeneratedByNullsgen(file) {
+			continue
+		}
+		if p.Name == "" {
+			p.Name = file.Name.Name
+		} else if p.Name != file.Name.Name {
+			return nil, fmt.Errorf("found packages %s and %s in %s", p.Name, file.Name.Name, dir)
+		}
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				p.Types[typeSpec.Name.Name] = typeSpec.Type
+			}
+		}
+	}
+	if p.Name == "" {
+		return nil, fmt.Errorf("no go files found in %s", dir)
+	}
+	return p, nil
+}
+
+// isGeneratedByNullsgen returns true if the given file starts with the header
+// of files generated by nullsgen.
+func isGeneratedByNullsgen(file *ast.File) bool {
+	return len(file.Comments) > 0 && file.Comments[0].List[0].Text == generatedHeader
+}
+
+// typeData returns the template data for the type with the given name.
+func (p *pkg) typeData(typeName string, prefix string) (typeData, error) {
+	if _, ok := p.Types[typeName]; !ok {
+		return typeData{}, fmt.Errorf("type %s not found in package %s", typeName, p.Name)
+	}
+	for _, reserved := range reservedNames {
+		if typeName == reserved {
+			return typeData{}, fmt.Errorf("type name %s conflicts with generated fields and methods", typeName)
+		}
+	}
+	name := prefix + typeName
+	if _, ok := p.Types[name]; ok {
+		return typeData{}, fmt.Errorf("type %s for %s already declared in package %s", name, typeName, p.Name)
+	}
+	k, err := p.kindOf(typeName)
+	if err != nil {
+		return typeData{}, err
+	}
+	return typeData{
+		kind:     k,
+		Package:  p.Name,
+		Type:     typeName,
+		Name:     name,
+		Stringer: typeName != "String",
+	}, nil
+}
+
+// kindOf returns the kind of the type with the given name. Types declared based
+// on other types of the package are resolved.
+func (p *pkg) kindOf(typeName string) (kind, error) {
+	seen := make(map[string]struct{})
+	name := typeName
+	for {
+		expr, ok := p.Types[name]
+		if !ok {
+			k, ok := kinds[name]
+			if !ok {
+				return kind{}, fmt.Errorf("unsupported underlying type %s of %s", name, typeName)
+			}
+			return k, nil
+		}
+		if _, ok := seen[name]; ok {
+			return kind{}, fmt.Errorf("invalid recursive type %s", typeName)
+		}
+		seen[name] = struct{}{}
+		ident, ok := expr.(*ast.Ident)
+		if !ok {
+			return kind{}, fmt.Errorf("unsupported underlying type %T of %s", expr, typeName)
+		}
+		name = ident.Name
+	}
+}
+
+// snakeCase converts the given name like OrderID to snake case like order_id.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// writeTemplate executes the given template, formats the result and writes it
+// to the file with the given name.
+func writeTemplate(fileName string, tmpl *template.Template, data typeData) error {
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, data)
+	if err != nil {
+		return fmt.Errorf("execute template: %w", err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format source: %w", err)
+	}
+	return os.WriteFile(fileName, src, 0o644)
+}
+
+var typeTemplate = template.Must(template.New("type").Parse(generatedHeader + `
+
+package {{.Package}}
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"github.com/lefinal/nulls"
+	"gopkg.in/yaml.v3"
+	"io"
+	"log/slog"
+)
+
+// {{.Name}} holds a nullable {{.Type}}.
+type {{.Name}} struct {
+	// {{.Type}} is the actual value when Valid.
+	{{.Type}} {{.Type}} ` + "`exhaustruct:\"optional\"`" + `
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// New{{.Name}} returns a valid {{.Name}} with the given value.
+func New{{.Name}}(v {{.Type}}) {{.Name}} {
+	return {{.Name}}{
+		{{.Type}}: v,
+		Valid: true,
+	}
+}
+
+// {{.Name}}FromPtr returns a {{.Name}} that is valid if the given pointer is
+// not nil.
+func {{.Name}}FromPtr(v *{{.Type}}) {{.Name}} {
+	if v == nil {
+		return {{.Name}}{}
+	}
+	return New{{.Name}}(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n {{.Name}}) Ptr() *{{.Type}} {
+	if !n.Valid {
+		return nil
+	}
+	v := n.{{.Type}}
+	return &v
+}
+
+// {{.Name}}FromSQLNull returns a {{.Name}} from the given sql.Null.
+func {{.Name}}FromSQLNull(v sql.Null[{{.Type}}]) {{.Name}} {
+	return {{.Name}}{
+		{{.Type}}: v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n {{.Name}}) ToSQLNull() sql.Null[{{.Type}}] {
+	return sql.Null[{{.Type}}]{
+		V:     n.{{.Type}},
+		Valid: n.Valid,
+	}
+}
+
+// toNulls returns the nulls.{{.NullsType}} representation.
+func (n {{.Name}}) toNulls() nulls.{{.NullsType}} {
+	return nulls.{{.NullsType}}{
+		{{.NullsType}}: {{.Underlying}}(n.{{.Type}}),
+		Valid: n.Valid,
+	}
+}
+
+// fromNulls sets the value from the given nulls.{{.NullsType}}.
+func (n *{{.Name}}) fromNulls(v nulls.{{.NullsType}}) {
+	n.{{.Type}} = {{.Type}}(v.{{.NullsType}})
+	n.Valid = v.Valid
+}
+
+// Get returns the value and whether it is valid.
+func (n {{.Name}}) Get() ({{.Type}}, bool) {
+	return n.{{.Type}}, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n {{.Name}}) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *{{.Name}}) Set(v {{.Type}}) {
+	*n = New{{.Name}}(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *{{.Name}}) SetNull() {
+	*n = {{.Name}}{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n {{.Name}}) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (n {{.Name}}) Equal(other {{.Name}}) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return n.{{.Type}} == other.{{.Type}}
+}
+{{- if .Stringer}}
+
+// String returns the value formatted like nulls.{{.NullsType}}.
+func (n {{.Name}}) String() string {
+	return fmt.Sprint(n.toNulls())
+}
+{{- end}}
+
+// Format implements fmt.Formatter like nulls.{{.NullsType}}. For %#v, GoString
+// is used.
+func (n {{.Name}}) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, n.GoString())
+		return
+	}
+	n.toNulls().Format(f, verb)
+}
+
+// GoString returns a Go expression creating the {{.Name}}.
+func (n {{.Name}}) GoString() string {
+	if !n.Valid {
+		return "{{.Package}}.{{.Name}}{}"
+	}
+	return fmt.Sprintf("{{.Package}}.New{{.Name}}(%#v)", {{.Underlying}}(n.{{.Type}}))
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (n {{.Name}}) LogValue() slog.Value {
+	return n.toNulls().LogValue()
+}
+
+// MarshalJSON marshals the value like nulls.{{.NullsType}}.
+func (n {{.Name}}) MarshalJSON() ([]byte, error) {
+	return n.toNulls().MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the value like nulls.{{.NullsType}}.
+func (n *{{.Name}}) UnmarshalJSON(data []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalText marshals the value like nulls.{{.NullsType}}.
+func (n {{.Name}}) MarshalText() ([]byte, error) {
+	return n.toNulls().MarshalText()
+}
+
+// UnmarshalText unmarshals the value like nulls.{{.NullsType}}.
+func (n *{{.Name}}) UnmarshalText(text []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalYAML marshals the value like nulls.{{.NullsType}}.
+func (n {{.Name}}) MarshalYAML() (any, error) {
+	return n.toNulls().MarshalYAML()
+}
+
+// UnmarshalYAML unmarshals the value like nulls.{{.NullsType}}.
+func (n *{{.Name}}) UnmarshalYAML(node *yaml.Node) error {
+	v := n.toNulls()
+	err := v.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Scan scans the value like nulls.{{.NullsType}}.
+func (n *{{.Name}}) Scan(src any) error {
+	v := n.toNulls()
+	err := v.Scan(src)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface like
+// nulls.{{.NullsType}}.
+func (n {{.Name}}) Value() (driver.Value, error) {
+	return n.toNulls().Value()
+}
+`))
+
+var testTemplate = template.Must(template.New("test").Parse(generatedHeader + `
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// Test{{.Name}}FromPtr tests {{.Name}}FromPtr and {{.Name}}.Ptr.
+func Test{{.Name}}FromPtr(t *testing.T) {
+	if {{.Name}}FromPtr(nil).Valid {
+		t.Error("should not be valid for nil")
+	}
+	v := {{.Type}}({{.Sample}})
+	n := {{.Name}}FromPtr(&v)
+	if n != New{{.Name}}(v) {
+		t.Errorf("should return correct value but got %#v", n)
+	}
+	if p := n.Ptr(); p == nil || *p != v {
+		t.Errorf("should return pointer to correct value but got %v", p)
+	}
+	if p := ({{.Name}}{}).Ptr(); p != nil {
+		t.Errorf("should return nil if not valid but got %v", p)
+	}
+}
+
+// Test{{.Name}}_Set tests {{.Name}}.Set and {{.Name}}.SetNull.
+func Test{{.Name}}_Set(t *testing.T) {
+	var n {{.Name}}
+	n.Set({{.Sample}})
+	if n != New{{.Name}}({{.Sample}}) {
+		t.Errorf("should set correct value but got %#v", n)
+	}
+	n.SetNull()
+	if !n.IsNull() {
+		t.Error("should be null after setting null")
+	}
+}
+
+// Test{{.Name}}_Equal tests {{.Name}}.Equal.
+func Test{{.Name}}_Equal(t *testing.T) {
+	n := New{{.Name}}({{.Sample}})
+	if !n.Equal(New{{.Name}}({{.Sample}})) {
+		t.Error("should be equal to same value")
+	}
+	if n.Equal({{.Name}}{}) {
+		t.Error("should not be equal to NULL-value")
+	}
+	if !({{.Name}}{}).Equal({{.Name}}{ {{- .Type}}: {{.Sample}}}) {
+		t.Error("should be equal to other NULL-value")
+	}
+}
+
+// Test{{.Name}}_JSON tests JSON marshalling and unmarshalling.
+func Test{{.Name}}_JSON(t *testing.T) {
+	for n, expect := range map[{{.Name}}]string{
+		New{{.Name}}({{.Sample}}): ` + "`{{.SampleJSON}}`" + `,
+		{{.Name}}{}: ` + "`null`" + `,
+	} {
+		raw, err := json.Marshal(n)
+		if err != nil {
+			t.Fatalf("marshal %#v should not fail but got: %v", n, err)
+		}
+		if string(raw) != expect {
+			t.Errorf("marshal %#v should return %s but got %s", n, expect, raw)
+		}
+		var got {{.Name}}
+		err = json.Unmarshal(raw, &got)
+		if err != nil {
+			t.Fatalf("unmarshal %s should not fail but got: %v", raw, err)
+		}
+		if !got.Equal(n) {
+			t.Errorf("unmarshal %s should return %#v but got %#v", raw, n, got)
+		}
+	}
+	n := New{{.Name}}({{.Sample}})
+	err := json.Unmarshal([]byte(` + "`null`" + `), &n)
+	if err != nil {
+		t.Fatalf("unmarshal null should not fail but got: %v", err)
+	}
+	if n.Valid {
+		t.Error("unmarshal null should set not valid")
+	}
+}
+
+// Test{{.Name}}_Text tests text marshalling and unmarshalling.
+func Test{{.Name}}_Text(t *testing.T) {
+	text, err := New{{.Name}}({{.Sample}}).MarshalText()
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if string(text) != "{{.SampleText}}" {
+		t.Errorf("should return correct value but got %s", text)
+	}
+	var n {{.Name}}
+	err = n.UnmarshalText(text)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != New{{.Name}}({{.Sample}}) {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// Test{{.Name}}_YAML tests YAML marshalling and unmarshalling.
+func Test{{.Name}}_YAML(t *testing.T) {
+	raw, err := yaml.Marshal(New{{.Name}}({{.Sample}}))
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	var n {{.Name}}
+	err = yaml.Unmarshal(raw, &n)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != New{{.Name}}({{.Sample}}) {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// Test{{.Name}}_SQL tests {{.Name}}.Value and {{.Name}}.Scan.
+func Test{{.Name}}_SQL(t *testing.T) {
+	for _, n := range []{{.Name}}{New{{.Name}}({{.Sample}}), {}} {
+		v, err := n.Value()
+		if err != nil {
+			t.Fatalf("value of %#v should not fail but got: %v", n, err)
+		}
+		var got {{.Name}}
+		err = got.Scan(v)
+		if err != nil {
+			t.Fatalf("scan %#v should not fail but got: %v", v, err)
+		}
+		if got != n {
+			t.Errorf("scan %#v should return %#v but got %#v", v, n, got)
+		}
+	}
+}
+`))
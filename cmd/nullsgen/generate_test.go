@@ -0,0 +1,130 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// exampleDir holds the example package with committed generated files.
+const exampleDir = "internal/example"
+
+// exampleTypes are the types generated in exampleDir.
+var exampleTypes = []string{"OrderID", "Status", "Score", "Enabled", "Level"}
+
+// writeFile writes the given source to the file with the given name in dir.
+func writeFile(t *testing.T, dir string, name string, src string) {
+	err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// runSuite tests run.
+type runSuite struct {
+	suite.Suite
+	dir string
+}
+
+func (suite *runSuite) SetupTest() {
+	suite.dir = suite.T().TempDir()
+	src, err := os.ReadFile(filepath.Join(exampleDir, "example.go"))
+	suite.Require().NoError(err, "reading example should not fail")
+	writeFile(suite.T(), suite.dir, "example.go", string(src))
+}
+
+func (suite *runSuite) run(cfg config) error {
+	cfg.Dir = suite.dir
+	return run(cfg)
+}
+
+func (suite *runSuite) TestTypeNotFound() {
+	err := suite.run(config{TypeNames: []string{"Unknown"}, Prefix: "Null"})
+	suite.Error(err, "should fail")
+}
+
+func (suite *runSuite) TestUnsupportedUnderlyingType() {
+	writeFile(suite.T(), suite.dir, "other.go", "package example\n\ntype Point struct{ X, Y int }\n\ntype Tags []string\n")
+	for _, typeName := range []string{"Point", "Tags"} {
+		err := suite.run(config{TypeNames: []string{typeName}, Prefix: "Null"})
+		suite.Errorf(err, "should fail for %s", typeName)
+	}
+}
+
+func (suite *runSuite) TestRecursiveType() {
+	writeFile(suite.T(), suite.dir, "other.go", "package example\n\ntype A B\n\ntype B A\n")
+	err := suite.run(config{TypeNames: []string{"A"}, Prefix: "Null"})
+	suite.Error(err, "should fail")
+}
+
+func (suite *runSuite) TestReservedName() {
+	writeFile(suite.T(), suite.dir, "other.go", "package example\n\ntype Valid bool\n")
+	err := suite.run(config{TypeNames: []string{"Valid"}, Prefix: "Null"})
+	suite.Error(err, "should fail")
+}
+
+func (suite *runSuite) TestAlreadyDeclared() {
+	writeFile(suite.T(), suite.dir, "other.go", "package example\n\ntype NullStatus struct{}\n")
+	err := suite.run(config{TypeNames: []string{"Status"}, Prefix: "Null"})
+	suite.Error(err, "should fail")
+}
+
+func (suite *runSuite) TestMultiplePackages() {
+	writeFile(suite.T(), suite.dir, "other.go", "package other\n")
+	err := suite.run(config{TypeNames: []string{"Status"}, Prefix: "Null"})
+	suite.Error(err, "should fail")
+}
+
+func (suite *runSuite) TestNoTests() {
+	err := suite.run(config{TypeNames: []string{"Status"}, Prefix: "Null"})
+	suite.Require().NoError(err, "should not fail")
+	suite.FileExists(filepath.Join(suite.dir, "status_null.go"), "should generate type")
+	suite.NoFileExists(filepath.Join(suite.dir, "status_null_test.go"), "should not generate tests")
+}
+
+func (suite *runSuite) TestStringType() {
+	writeFile(suite.T(), suite.dir, "other.go", "package example\n\ntype String string\n")
+	err := suite.run(config{TypeNames: []string{"String"}, Prefix: "Null"})
+	suite.Require().NoError(err, "should not fail")
+	src, err := os.ReadFile(filepath.Join(suite.dir, "string_null.go"))
+	suite.Require().NoError(err, "should generate type")
+	suite.NotContains(string(src), "func (n NullString) String() string", "should omit String method")
+}
+
+// TestRegenerate asserts that the files in exampleDir are up to date and
+// that generated files are skipped when parsing.
+func (suite *runSuite) TestRegenerate() {
+	for i := 0; i < 2; i++ {
+		err := suite.run(config{TypeNames: exampleTypes, Prefix: "Null", Tests: true})
+		suite.Require().NoError(err, "should not fail")
+	}
+	for _, typeName := range exampleTypes {
+		for _, suffix := range []string{"_null.go", "_null_test.go"} {
+			fileName := snakeCase(typeName) + suffix
+			expect, err := os.ReadFile(filepath.Join(exampleDir, fileName))
+			suite.Require().NoErrorf(err, "reading %s should not fail", fileName)
+			got, err := os.ReadFile(filepath.Join(suite.dir, fileName))
+			suite.Require().NoErrorf(err, "reading generated %s should not fail", fileName)
+			suite.Equalf(string(expect), string(got), "%s should be up to date", fileName)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	suite.Run(t, new(runSuite))
+}
+
+// TestSnakeCase tests snakeCase.
+func TestSnakeCase(t *testing.T) {
+	for name, expect := range map[string]string{
+		"Status":     "status",
+		"OrderID":    "order_id",
+		"HTTPStatus": "http_status",
+		"userState":  "user_state",
+		"ID":         "id",
+	} {
+		assert.Equalf(t, expect, snakeCase(name), "should return correct value for %s", name)
+	}
+}
@@ -0,0 +1,204 @@
+// Code generated by nullsgen; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"github.com/lefinal/nulls"
+	"gopkg.in/yaml.v3"
+	"io"
+	"log/slog"
+)
+
+// NullEnabled holds a nullable Enabled.
+type NullEnabled struct {
+	// Enabled is the actual value when Valid.
+	Enabled Enabled `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewNullEnabled returns a valid NullEnabled with the given value.
+func NewNullEnabled(v Enabled) NullEnabled {
+	return NullEnabled{
+		Enabled: v,
+		Valid:   true,
+	}
+}
+
+// NullEnabledFromPtr returns a NullEnabled that is valid if the given pointer is
+// not nil.
+func NullEnabledFromPtr(v *Enabled) NullEnabled {
+	if v == nil {
+		return NullEnabled{}
+	}
+	return NewNullEnabled(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n NullEnabled) Ptr() *Enabled {
+	if !n.Valid {
+		return nil
+	}
+	v := n.Enabled
+	return &v
+}
+
+// NullEnabledFromSQLNull returns a NullEnabled from the given sql.Null.
+func NullEnabledFromSQLNull(v sql.Null[Enabled]) NullEnabled {
+	return NullEnabled{
+		Enabled: v.V,
+		Valid:   v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n NullEnabled) ToSQLNull() sql.Null[Enabled] {
+	return sql.Null[Enabled]{
+		V:     n.Enabled,
+		Valid: n.Valid,
+	}
+}
+
+// toNulls returns the nulls.Bool representation.
+func (n NullEnabled) toNulls() nulls.Bool {
+	return nulls.Bool{
+		Bool:  bool(n.Enabled),
+		Valid: n.Valid,
+	}
+}
+
+// fromNulls sets the value from the given nulls.Bool.
+func (n *NullEnabled) fromNulls(v nulls.Bool) {
+	n.Enabled = Enabled(v.Bool)
+	n.Valid = v.Valid
+}
+
+// Get returns the value and whether it is valid.
+func (n NullEnabled) Get() (Enabled, bool) {
+	return n.Enabled, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n NullEnabled) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *NullEnabled) Set(v Enabled) {
+	*n = NewNullEnabled(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *NullEnabled) SetNull() {
+	*n = NullEnabled{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n NullEnabled) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (n NullEnabled) Equal(other NullEnabled) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return n.Enabled == other.Enabled
+}
+
+// String returns the value formatted like nulls.Bool.
+func (n NullEnabled) String() string {
+	return fmt.Sprint(n.toNulls())
+}
+
+// Format implements fmt.Formatter like nulls.Bool. For %#v, GoString
+// is used.
+func (n NullEnabled) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, n.GoString())
+		return
+	}
+	n.toNulls().Format(f, verb)
+}
+
+// GoString returns a Go expression creating the NullEnabled.
+func (n NullEnabled) GoString() string {
+	if !n.Valid {
+		return "example.NullEnabled{}"
+	}
+	return fmt.Sprintf("example.NewNullEnabled(%#v)", bool(n.Enabled))
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (n NullEnabled) LogValue() slog.Value {
+	return n.toNulls().LogValue()
+}
+
+// MarshalJSON marshals the value like nulls.Bool.
+func (n NullEnabled) MarshalJSON() ([]byte, error) {
+	return n.toNulls().MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the value like nulls.Bool.
+func (n *NullEnabled) UnmarshalJSON(data []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalText marshals the value like nulls.Bool.
+func (n NullEnabled) MarshalText() ([]byte, error) {
+	return n.toNulls().MarshalText()
+}
+
+// UnmarshalText unmarshals the value like nulls.Bool.
+func (n *NullEnabled) UnmarshalText(text []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalYAML marshals the value like nulls.Bool.
+func (n NullEnabled) MarshalYAML() (any, error) {
+	return n.toNulls().MarshalYAML()
+}
+
+// UnmarshalYAML unmarshals the value like nulls.Bool.
+func (n *NullEnabled) UnmarshalYAML(node *yaml.Node) error {
+	v := n.toNulls()
+	err := v.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Scan scans the value like nulls.Bool.
+func (n *NullEnabled) Scan(src any) error {
+	v := n.toNulls()
+	err := v.Scan(src)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface like
+// nulls.Bool.
+func (n NullEnabled) Value() (driver.Value, error) {
+	return n.toNulls().Value()
+}
@@ -0,0 +1,139 @@
+// Code generated by nullsgen; DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNullEnabledFromPtr tests NullEnabledFromPtr and NullEnabled.Ptr.
+func TestNullEnabledFromPtr(t *testing.T) {
+	if NullEnabledFromPtr(nil).Valid {
+		t.Error("should not be valid for nil")
+	}
+	v := Enabled(true)
+	n := NullEnabledFromPtr(&v)
+	if n != NewNullEnabled(v) {
+		t.Errorf("should return correct value but got %#v", n)
+	}
+	if p := n.Ptr(); p == nil || *p != v {
+		t.Errorf("should return pointer to correct value but got %v", p)
+	}
+	if p := (NullEnabled{}).Ptr(); p != nil {
+		t.Errorf("should return nil if not valid but got %v", p)
+	}
+}
+
+// TestNullEnabled_Set tests NullEnabled.Set and NullEnabled.SetNull.
+func TestNullEnabled_Set(t *testing.T) {
+	var n NullEnabled
+	n.Set(true)
+	if n != NewNullEnabled(true) {
+		t.Errorf("should set correct value but got %#v", n)
+	}
+	n.SetNull()
+	if !n.IsNull() {
+		t.Error("should be null after setting null")
+	}
+}
+
+// TestNullEnabled_Equal tests NullEnabled.Equal.
+func TestNullEnabled_Equal(t *testing.T) {
+	n := NewNullEnabled(true)
+	if !n.Equal(NewNullEnabled(true)) {
+		t.Error("should be equal to same value")
+	}
+	if n.Equal(NullEnabled{}) {
+		t.Error("should not be equal to NULL-value")
+	}
+	if !(NullEnabled{}).Equal(NullEnabled{Enabled: true}) {
+		t.Error("should be equal to other NULL-value")
+	}
+}
+
+// TestNullEnabled_JSON tests JSON marshalling and unmarshalling.
+func TestNullEnabled_JSON(t *testing.T) {
+	for n, expect := range map[NullEnabled]string{
+		NewNullEnabled(true): `true`,
+		NullEnabled{}:        `null`,
+	} {
+		raw, err := json.Marshal(n)
+		if err != nil {
+			t.Fatalf("marshal %#v should not fail but got: %v", n, err)
+		}
+		if string(raw) != expect {
+			t.Errorf("marshal %#v should return %s but got %s", n, expect, raw)
+		}
+		var got NullEnabled
+		err = json.Unmarshal(raw, &got)
+		if err != nil {
+			t.Fatalf("unmarshal %s should not fail but got: %v", raw, err)
+		}
+		if !got.Equal(n) {
+			t.Errorf("unmarshal %s should return %#v but got %#v", raw, n, got)
+		}
+	}
+	n := NewNullEnabled(true)
+	err := json.Unmarshal([]byte(`null`), &n)
+	if err != nil {
+		t.Fatalf("unmarshal null should not fail but got: %v", err)
+	}
+	if n.Valid {
+		t.Error("unmarshal null should set not valid")
+	}
+}
+
+// TestNullEnabled_Text tests text marshalling and unmarshalling.
+func TestNullEnabled_Text(t *testing.T) {
+	text, err := NewNullEnabled(true).MarshalText()
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if string(text) != "true" {
+		t.Errorf("should return correct value but got %s", text)
+	}
+	var n NullEnabled
+	err = n.UnmarshalText(text)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != NewNullEnabled(true) {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// TestNullEnabled_YAML tests YAML marshalling and unmarshalling.
+func TestNullEnabled_YAML(t *testing.T) {
+	raw, err := yaml.Marshal(NewNullEnabled(true))
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	var n NullEnabled
+	err = yaml.Unmarshal(raw, &n)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != NewNullEnabled(true) {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// TestNullEnabled_SQL tests NullEnabled.Value and NullEnabled.Scan.
+func TestNullEnabled_SQL(t *testing.T) {
+	for _, n := range []NullEnabled{NewNullEnabled(true), {}} {
+		v, err := n.Value()
+		if err != nil {
+			t.Fatalf("value of %#v should not fail but got: %v", n, err)
+		}
+		var got NullEnabled
+		err = got.Scan(v)
+		if err != nil {
+			t.Fatalf("scan %#v should not fail but got: %v", v, err)
+		}
+		if got != n {
+			t.Errorf("scan %#v should return %#v but got %#v", v, n, got)
+		}
+	}
+}
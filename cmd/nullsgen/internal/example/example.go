@@ -0,0 +1,23 @@
+// Package example holds named types for testing the code generated by
+// nullsgen.
+package example
+
+//go:generate go run ../.. -type OrderID,Status,Score,Enabled,Level
+
+// OrderID identifies an order.
+type OrderID int64
+
+// Status is the status of an order.
+type Status string
+
+// Score is a rating.
+type Score float32
+
+// Enabled represents a feature toggle.
+type Enabled bool
+
+// Level is based on another named type.
+type Level Priority
+
+// Priority is the priority of a task.
+type Priority uint8
@@ -0,0 +1,204 @@
+// Code generated by nullsgen; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"github.com/lefinal/nulls"
+	"gopkg.in/yaml.v3"
+	"io"
+	"log/slog"
+)
+
+// NullLevel holds a nullable Level.
+type NullLevel struct {
+	// Level is the actual value when Valid.
+	Level Level `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewNullLevel returns a valid NullLevel with the given value.
+func NewNullLevel(v Level) NullLevel {
+	return NullLevel{
+		Level: v,
+		Valid: true,
+	}
+}
+
+// NullLevelFromPtr returns a NullLevel that is valid if the given pointer is
+// not nil.
+func NullLevelFromPtr(v *Level) NullLevel {
+	if v == nil {
+		return NullLevel{}
+	}
+	return NewNullLevel(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n NullLevel) Ptr() *Level {
+	if !n.Valid {
+		return nil
+	}
+	v := n.Level
+	return &v
+}
+
+// NullLevelFromSQLNull returns a NullLevel from the given sql.Null.
+func NullLevelFromSQLNull(v sql.Null[Level]) NullLevel {
+	return NullLevel{
+		Level: v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n NullLevel) ToSQLNull() sql.Null[Level] {
+	return sql.Null[Level]{
+		V:     n.Level,
+		Valid: n.Valid,
+	}
+}
+
+// toNulls returns the nulls.Uint8 representation.
+func (n NullLevel) toNulls() nulls.Uint8 {
+	return nulls.Uint8{
+		Uint8: uint8(n.Level),
+		Valid: n.Valid,
+	}
+}
+
+// fromNulls sets the value from the given nulls.Uint8.
+func (n *NullLevel) fromNulls(v nulls.Uint8) {
+	n.Level = Level(v.Uint8)
+	n.Valid = v.Valid
+}
+
+// Get returns the value and whether it is valid.
+func (n NullLevel) Get() (Level, bool) {
+	return n.Level, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n NullLevel) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *NullLevel) Set(v Level) {
+	*n = NewNullLevel(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *NullLevel) SetNull() {
+	*n = NullLevel{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n NullLevel) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (n NullLevel) Equal(other NullLevel) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return n.Level == other.Level
+}
+
+// String returns the value formatted like nulls.Uint8.
+func (n NullLevel) String() string {
+	return fmt.Sprint(n.toNulls())
+}
+
+// Format implements fmt.Formatter like nulls.Uint8. For %#v, GoString
+// is used.
+func (n NullLevel) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, n.GoString())
+		return
+	}
+	n.toNulls().Format(f, verb)
+}
+
+// GoString returns a Go expression creating the NullLevel.
+func (n NullLevel) GoString() string {
+	if !n.Valid {
+		return "example.NullLevel{}"
+	}
+	return fmt.Sprintf("example.NewNullLevel(%#v)", uint8(n.Level))
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (n NullLevel) LogValue() slog.Value {
+	return n.toNulls().LogValue()
+}
+
+// MarshalJSON marshals the value like nulls.Uint8.
+func (n NullLevel) MarshalJSON() ([]byte, error) {
+	return n.toNulls().MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the value like nulls.Uint8.
+func (n *NullLevel) UnmarshalJSON(data []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalText marshals the value like nulls.Uint8.
+func (n NullLevel) MarshalText() ([]byte, error) {
+	return n.toNulls().MarshalText()
+}
+
+// UnmarshalText unmarshals the value like nulls.Uint8.
+func (n *NullLevel) UnmarshalText(text []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalYAML marshals the value like nulls.Uint8.
+func (n NullLevel) MarshalYAML() (any, error) {
+	return n.toNulls().MarshalYAML()
+}
+
+// UnmarshalYAML unmarshals the value like nulls.Uint8.
+func (n *NullLevel) UnmarshalYAML(node *yaml.Node) error {
+	v := n.toNulls()
+	err := v.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Scan scans the value like nulls.Uint8.
+func (n *NullLevel) Scan(src any) error {
+	v := n.toNulls()
+	err := v.Scan(src)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface like
+// nulls.Uint8.
+func (n NullLevel) Value() (driver.Value, error) {
+	return n.toNulls().Value()
+}
@@ -0,0 +1,139 @@
+// Code generated by nullsgen; DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNullLevelFromPtr tests NullLevelFromPtr and NullLevel.Ptr.
+func TestNullLevelFromPtr(t *testing.T) {
+	if NullLevelFromPtr(nil).Valid {
+		t.Error("should not be valid for nil")
+	}
+	v := Level(42)
+	n := NullLevelFromPtr(&v)
+	if n != NewNullLevel(v) {
+		t.Errorf("should return correct value but got %#v", n)
+	}
+	if p := n.Ptr(); p == nil || *p != v {
+		t.Errorf("should return pointer to correct value but got %v", p)
+	}
+	if p := (NullLevel{}).Ptr(); p != nil {
+		t.Errorf("should return nil if not valid but got %v", p)
+	}
+}
+
+// TestNullLevel_Set tests NullLevel.Set and NullLevel.SetNull.
+func TestNullLevel_Set(t *testing.T) {
+	var n NullLevel
+	n.Set(42)
+	if n != NewNullLevel(42) {
+		t.Errorf("should set correct value but got %#v", n)
+	}
+	n.SetNull()
+	if !n.IsNull() {
+		t.Error("should be null after setting null")
+	}
+}
+
+// TestNullLevel_Equal tests NullLevel.Equal.
+func TestNullLevel_Equal(t *testing.T) {
+	n := NewNullLevel(42)
+	if !n.Equal(NewNullLevel(42)) {
+		t.Error("should be equal to same value")
+	}
+	if n.Equal(NullLevel{}) {
+		t.Error("should not be equal to NULL-value")
+	}
+	if !(NullLevel{}).Equal(NullLevel{Level: 42}) {
+		t.Error("should be equal to other NULL-value")
+	}
+}
+
+// TestNullLevel_JSON tests JSON marshalling and unmarshalling.
+func TestNullLevel_JSON(t *testing.T) {
+	for n, expect := range map[NullLevel]string{
+		NewNullLevel(42): `42`,
+		NullLevel{}:      `null`,
+	} {
+		raw, err := json.Marshal(n)
+		if err != nil {
+			t.Fatalf("marshal %#v should not fail but got: %v", n, err)
+		}
+		if string(raw) != expect {
+			t.Errorf("marshal %#v should return %s but got %s", n, expect, raw)
+		}
+		var got NullLevel
+		err = json.Unmarshal(raw, &got)
+		if err != nil {
+			t.Fatalf("unmarshal %s should not fail but got: %v", raw, err)
+		}
+		if !got.Equal(n) {
+			t.Errorf("unmarshal %s should return %#v but got %#v", raw, n, got)
+		}
+	}
+	n := NewNullLevel(42)
+	err := json.Unmarshal([]byte(`null`), &n)
+	if err != nil {
+		t.Fatalf("unmarshal null should not fail but got: %v", err)
+	}
+	if n.Valid {
+		t.Error("unmarshal null should set not valid")
+	}
+}
+
+// TestNullLevel_Text tests text marshalling and unmarshalling.
+func TestNullLevel_Text(t *testing.T) {
+	text, err := NewNullLevel(42).MarshalText()
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if string(text) != "42" {
+		t.Errorf("should return correct value but got %s", text)
+	}
+	var n NullLevel
+	err = n.UnmarshalText(text)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != NewNullLevel(42) {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// TestNullLevel_YAML tests YAML marshalling and unmarshalling.
+func TestNullLevel_YAML(t *testing.T) {
+	raw, err := yaml.Marshal(NewNullLevel(42))
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	var n NullLevel
+	err = yaml.Unmarshal(raw, &n)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != NewNullLevel(42) {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// TestNullLevel_SQL tests NullLevel.Value and NullLevel.Scan.
+func TestNullLevel_SQL(t *testing.T) {
+	for _, n := range []NullLevel{NewNullLevel(42), {}} {
+		v, err := n.Value()
+		if err != nil {
+			t.Fatalf("value of %#v should not fail but got: %v", n, err)
+		}
+		var got NullLevel
+		err = got.Scan(v)
+		if err != nil {
+			t.Fatalf("scan %#v should not fail but got: %v", v, err)
+		}
+		if got != n {
+			t.Errorf("scan %#v should return %#v but got %#v", v, n, got)
+		}
+	}
+}
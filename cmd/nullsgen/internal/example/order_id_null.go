@@ -0,0 +1,204 @@
+// Code generated by nullsgen; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"github.com/lefinal/nulls"
+	"gopkg.in/yaml.v3"
+	"io"
+	"log/slog"
+)
+
+// NullOrderID holds a nullable OrderID.
+type NullOrderID struct {
+	// OrderID is the actual value when Valid.
+	OrderID OrderID `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewNullOrderID returns a valid NullOrderID with the given value.
+func NewNullOrderID(v OrderID) NullOrderID {
+	return NullOrderID{
+		OrderID: v,
+		Valid:   true,
+	}
+}
+
+// NullOrderIDFromPtr returns a NullOrderID that is valid if the given pointer is
+// not nil.
+func NullOrderIDFromPtr(v *OrderID) NullOrderID {
+	if v == nil {
+		return NullOrderID{}
+	}
+	return NewNullOrderID(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n NullOrderID) Ptr() *OrderID {
+	if !n.Valid {
+		return nil
+	}
+	v := n.OrderID
+	return &v
+}
+
+// NullOrderIDFromSQLNull returns a NullOrderID from the given sql.Null.
+func NullOrderIDFromSQLNull(v sql.Null[OrderID]) NullOrderID {
+	return NullOrderID{
+		OrderID: v.V,
+		Valid:   v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n NullOrderID) ToSQLNull() sql.Null[OrderID] {
+	return sql.Null[OrderID]{
+		V:     n.OrderID,
+		Valid: n.Valid,
+	}
+}
+
+// toNulls returns the nulls.Int64 representation.
+func (n NullOrderID) toNulls() nulls.Int64 {
+	return nulls.Int64{
+		Int64: int64(n.OrderID),
+		Valid: n.Valid,
+	}
+}
+
+// fromNulls sets the value from the given nulls.Int64.
+func (n *NullOrderID) fromNulls(v nulls.Int64) {
+	n.OrderID = OrderID(v.Int64)
+	n.Valid = v.Valid
+}
+
+// Get returns the value and whether it is valid.
+func (n NullOrderID) Get() (OrderID, bool) {
+	return n.OrderID, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n NullOrderID) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *NullOrderID) Set(v OrderID) {
+	*n = NewNullOrderID(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *NullOrderID) SetNull() {
+	*n = NullOrderID{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n NullOrderID) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (n NullOrderID) Equal(other NullOrderID) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return n.OrderID == other.OrderID
+}
+
+// String returns the value formatted like nulls.Int64.
+func (n NullOrderID) String() string {
+	return fmt.Sprint(n.toNulls())
+}
+
+// Format implements fmt.Formatter like nulls.Int64. For %#v, GoString
+// is used.
+func (n NullOrderID) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, n.GoString())
+		return
+	}
+	n.toNulls().Format(f, verb)
+}
+
+// GoString returns a Go expression creating the NullOrderID.
+func (n NullOrderID) GoString() string {
+	if !n.Valid {
+		return "example.NullOrderID{}"
+	}
+	return fmt.Sprintf("example.NewNullOrderID(%#v)", int64(n.OrderID))
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (n NullOrderID) LogValue() slog.Value {
+	return n.toNulls().LogValue()
+}
+
+// MarshalJSON marshals the value like nulls.Int64.
+func (n NullOrderID) MarshalJSON() ([]byte, error) {
+	return n.toNulls().MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the value like nulls.Int64.
+func (n *NullOrderID) UnmarshalJSON(data []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalText marshals the value like nulls.Int64.
+func (n NullOrderID) MarshalText() ([]byte, error) {
+	return n.toNulls().MarshalText()
+}
+
+// UnmarshalText unmarshals the value like nulls.Int64.
+func (n *NullOrderID) UnmarshalText(text []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalYAML marshals the value like nulls.Int64.
+func (n NullOrderID) MarshalYAML() (any, error) {
+	return n.toNulls().MarshalYAML()
+}
+
+// UnmarshalYAML unmarshals the value like nulls.Int64.
+func (n *NullOrderID) UnmarshalYAML(node *yaml.Node) error {
+	v := n.toNulls()
+	err := v.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Scan scans the value like nulls.Int64.
+func (n *NullOrderID) Scan(src any) error {
+	v := n.toNulls()
+	err := v.Scan(src)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface like
+// nulls.Int64.
+func (n NullOrderID) Value() (driver.Value, error) {
+	return n.toNulls().Value()
+}
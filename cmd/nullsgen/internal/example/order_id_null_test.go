@@ -0,0 +1,139 @@
+// Code generated by nullsgen; DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNullOrderIDFromPtr tests NullOrderIDFromPtr and NullOrderID.Ptr.
+func TestNullOrderIDFromPtr(t *testing.T) {
+	if NullOrderIDFromPtr(nil).Valid {
+		t.Error("should not be valid for nil")
+	}
+	v := OrderID(42)
+	n := NullOrderIDFromPtr(&v)
+	if n != NewNullOrderID(v) {
+		t.Errorf("should return correct value but got %#v", n)
+	}
+	if p := n.Ptr(); p == nil || *p != v {
+		t.Errorf("should return pointer to correct value but got %v", p)
+	}
+	if p := (NullOrderID{}).Ptr(); p != nil {
+		t.Errorf("should return nil if not valid but got %v", p)
+	}
+}
+
+// TestNullOrderID_Set tests NullOrderID.Set and NullOrderID.SetNull.
+func TestNullOrderID_Set(t *testing.T) {
+	var n NullOrderID
+	n.Set(42)
+	if n != NewNullOrderID(42) {
+		t.Errorf("should set correct value but got %#v", n)
+	}
+	n.SetNull()
+	if !n.IsNull() {
+		t.Error("should be null after setting null")
+	}
+}
+
+// TestNullOrderID_Equal tests NullOrderID.Equal.
+func TestNullOrderID_Equal(t *testing.T) {
+	n := NewNullOrderID(42)
+	if !n.Equal(NewNullOrderID(42)) {
+		t.Error("should be equal to same value")
+	}
+	if n.Equal(NullOrderID{}) {
+		t.Error("should not be equal to NULL-value")
+	}
+	if !(NullOrderID{}).Equal(NullOrderID{OrderID: 42}) {
+		t.Error("should be equal to other NULL-value")
+	}
+}
+
+// TestNullOrderID_JSON tests JSON marshalling and unmarshalling.
+func TestNullOrderID_JSON(t *testing.T) {
+	for n, expect := range map[NullOrderID]string{
+		NewNullOrderID(42): `42`,
+		NullOrderID{}:      `null`,
+	} {
+		raw, err := json.Marshal(n)
+		if err != nil {
+			t.Fatalf("marshal %#v should not fail but got: %v", n, err)
+		}
+		if string(raw) != expect {
+			t.Errorf("marshal %#v should return %s but got %s", n, expect, raw)
+		}
+		var got NullOrderID
+		err = json.Unmarshal(raw, &got)
+		if err != nil {
+			t.Fatalf("unmarshal %s should not fail but got: %v", raw, err)
+		}
+		if !got.Equal(n) {
+			t.Errorf("unmarshal %s should return %#v but got %#v", raw, n, got)
+		}
+	}
+	n := NewNullOrderID(42)
+	err := json.Unmarshal([]byte(`null`), &n)
+	if err != nil {
+		t.Fatalf("unmarshal null should not fail but got: %v", err)
+	}
+	if n.Valid {
+		t.Error("unmarshal null should set not valid")
+	}
+}
+
+// TestNullOrderID_Text tests text marshalling and unmarshalling.
+func TestNullOrderID_Text(t *testing.T) {
+	text, err := NewNullOrderID(42).MarshalText()
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if string(text) != "42" {
+		t.Errorf("should return correct value but got %s", text)
+	}
+	var n NullOrderID
+	err = n.UnmarshalText(text)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != NewNullOrderID(42) {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// TestNullOrderID_YAML tests YAML marshalling and unmarshalling.
+func TestNullOrderID_YAML(t *testing.T) {
+	raw, err := yaml.Marshal(NewNullOrderID(42))
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	var n NullOrderID
+	err = yaml.Unmarshal(raw, &n)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != NewNullOrderID(42) {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// TestNullOrderID_SQL tests NullOrderID.Value and NullOrderID.Scan.
+func TestNullOrderID_SQL(t *testing.T) {
+	for _, n := range []NullOrderID{NewNullOrderID(42), {}} {
+		v, err := n.Value()
+		if err != nil {
+			t.Fatalf("value of %#v should not fail but got: %v", n, err)
+		}
+		var got NullOrderID
+		err = got.Scan(v)
+		if err != nil {
+			t.Fatalf("scan %#v should not fail but got: %v", v, err)
+		}
+		if got != n {
+			t.Errorf("scan %#v should return %#v but got %#v", v, n, got)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Code generated by nullsgen; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"github.com/lefinal/nulls"
+	"gopkg.in/yaml.v3"
+	"io"
+	"log/slog"
+)
+
+// NullScore holds a nullable Score.
+type NullScore struct {
+	// Score is the actual value when Valid.
+	Score Score `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewNullScore returns a valid NullScore with the given value.
+func NewNullScore(v Score) NullScore {
+	return NullScore{
+		Score: v,
+		Valid: true,
+	}
+}
+
+// NullScoreFromPtr returns a NullScore that is valid if the given pointer is
+// not nil.
+func NullScoreFromPtr(v *Score) NullScore {
+	if v == nil {
+		return NullScore{}
+	}
+	return NewNullScore(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n NullScore) Ptr() *Score {
+	if !n.Valid {
+		return nil
+	}
+	v := n.Score
+	return &v
+}
+
+// NullScoreFromSQLNull returns a NullScore from the given sql.Null.
+func NullScoreFromSQLNull(v sql.Null[Score]) NullScore {
+	return NullScore{
+		Score: v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n NullScore) ToSQLNull() sql.Null[Score] {
+	return sql.Null[Score]{
+		V:     n.Score,
+		Valid: n.Valid,
+	}
+}
+
+// toNulls returns the nulls.Float32 representation.
+func (n NullScore) toNulls() nulls.Float32 {
+	return nulls.Float32{
+		Float32: float32(n.Score),
+		Valid:   n.Valid,
+	}
+}
+
+// fromNulls sets the value from the given nulls.Float32.
+func (n *NullScore) fromNulls(v nulls.Float32) {
+	n.Score = Score(v.Float32)
+	n.Valid = v.Valid
+}
+
+// Get returns the value and whether it is valid.
+func (n NullScore) Get() (Score, bool) {
+	return n.Score, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n NullScore) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *NullScore) Set(v Score) {
+	*n = NewNullScore(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *NullScore) SetNull() {
+	*n = NullScore{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n NullScore) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (n NullScore) Equal(other NullScore) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return n.Score == other.Score
+}
+
+// String returns the value formatted like nulls.Float32.
+func (n NullScore) String() string {
+	return fmt.Sprint(n.toNulls())
+}
+
+// Format implements fmt.Formatter like nulls.Float32. For %#v, GoString
+// is used.
+func (n NullScore) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, n.GoString())
+		return
+	}
+	n.toNulls().Format(f, verb)
+}
+
+// GoString returns a Go expression creating the NullScore.
+func (n NullScore) GoString() string {
+	if !n.Valid {
+		return "example.NullScore{}"
+	}
+	return fmt.Sprintf("example.NewNullScore(%#v)", float32(n.Score))
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (n NullScore) LogValue() slog.Value {
+	return n.toNulls().LogValue()
+}
+
+// MarshalJSON marshals the value like nulls.Float32.
+func (n NullScore) MarshalJSON() ([]byte, error) {
+	return n.toNulls().MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the value like nulls.Float32.
+func (n *NullScore) UnmarshalJSON(data []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalText marshals the value like nulls.Float32.
+func (n NullScore) MarshalText() ([]byte, error) {
+	return n.toNulls().MarshalText()
+}
+
+// UnmarshalText unmarshals the value like nulls.Float32.
+func (n *NullScore) UnmarshalText(text []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalYAML marshals the value like nulls.Float32.
+func (n NullScore) MarshalYAML() (any, error) {
+	return n.toNulls().MarshalYAML()
+}
+
+// UnmarshalYAML unmarshals the value like nulls.Float32.
+func (n *NullScore) UnmarshalYAML(node *yaml.Node) error {
+	v := n.toNulls()
+	err := v.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Scan scans the value like nulls.Float32.
+func (n *NullScore) Scan(src any) error {
+	v := n.toNulls()
+	err := v.Scan(src)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface like
+// nulls.Float32.
+func (n NullScore) Value() (driver.Value, error) {
+	return n.toNulls().Value()
+}
@@ -0,0 +1,139 @@
+// Code generated by nullsgen; DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNullScoreFromPtr tests NullScoreFromPtr and NullScore.Ptr.
+func TestNullScoreFromPtr(t *testing.T) {
+	if NullScoreFromPtr(nil).Valid {
+		t.Error("should not be valid for nil")
+	}
+	v := Score(1.5)
+	n := NullScoreFromPtr(&v)
+	if n != NewNullScore(v) {
+		t.Errorf("should return correct value but got %#v", n)
+	}
+	if p := n.Ptr(); p == nil || *p != v {
+		t.Errorf("should return pointer to correct value but got %v", p)
+	}
+	if p := (NullScore{}).Ptr(); p != nil {
+		t.Errorf("should return nil if not valid but got %v", p)
+	}
+}
+
+// TestNullScore_Set tests NullScore.Set and NullScore.SetNull.
+func TestNullScore_Set(t *testing.T) {
+	var n NullScore
+	n.Set(1.5)
+	if n != NewNullScore(1.5) {
+		t.Errorf("should set correct value but got %#v", n)
+	}
+	n.SetNull()
+	if !n.IsNull() {
+		t.Error("should be null after setting null")
+	}
+}
+
+// TestNullScore_Equal tests NullScore.Equal.
+func TestNullScore_Equal(t *testing.T) {
+	n := NewNullScore(1.5)
+	if !n.Equal(NewNullScore(1.5)) {
+		t.Error("should be equal to same value")
+	}
+	if n.Equal(NullScore{}) {
+		t.Error("should not be equal to NULL-value")
+	}
+	if !(NullScore{}).Equal(NullScore{Score: 1.5}) {
+		t.Error("should be equal to other NULL-value")
+	}
+}
+
+// TestNullScore_JSON tests JSON marshalling and unmarshalling.
+func TestNullScore_JSON(t *testing.T) {
+	for n, expect := range map[NullScore]string{
+		NewNullScore(1.5): `1.5`,
+		NullScore{}:       `null`,
+	} {
+		raw, err := json.Marshal(n)
+		if err != nil {
+			t.Fatalf("marshal %#v should not fail but got: %v", n, err)
+		}
+		if string(raw) != expect {
+			t.Errorf("marshal %#v should return %s but got %s", n, expect, raw)
+		}
+		var got NullScore
+		err = json.Unmarshal(raw, &got)
+		if err != nil {
+			t.Fatalf("unmarshal %s should not fail but got: %v", raw, err)
+		}
+		if !got.Equal(n) {
+			t.Errorf("unmarshal %s should return %#v but got %#v", raw, n, got)
+		}
+	}
+	n := NewNullScore(1.5)
+	err := json.Unmarshal([]byte(`null`), &n)
+	if err != nil {
+		t.Fatalf("unmarshal null should not fail but got: %v", err)
+	}
+	if n.Valid {
+		t.Error("unmarshal null should set not valid")
+	}
+}
+
+// TestNullScore_Text tests text marshalling and unmarshalling.
+func TestNullScore_Text(t *testing.T) {
+	text, err := NewNullScore(1.5).MarshalText()
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if string(text) != "1.5" {
+		t.Errorf("should return correct value but got %s", text)
+	}
+	var n NullScore
+	err = n.UnmarshalText(text)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != NewNullScore(1.5) {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// TestNullScore_YAML tests YAML marshalling and unmarshalling.
+func TestNullScore_YAML(t *testing.T) {
+	raw, err := yaml.Marshal(NewNullScore(1.5))
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	var n NullScore
+	err = yaml.Unmarshal(raw, &n)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != NewNullScore(1.5) {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// TestNullScore_SQL tests NullScore.Value and NullScore.Scan.
+func TestNullScore_SQL(t *testing.T) {
+	for _, n := range []NullScore{NewNullScore(1.5), {}} {
+		v, err := n.Value()
+		if err != nil {
+			t.Fatalf("value of %#v should not fail but got: %v", n, err)
+		}
+		var got NullScore
+		err = got.Scan(v)
+		if err != nil {
+			t.Fatalf("scan %#v should not fail but got: %v", v, err)
+		}
+		if got != n {
+			t.Errorf("scan %#v should return %#v but got %#v", v, n, got)
+		}
+	}
+}
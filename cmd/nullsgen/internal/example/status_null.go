@@ -0,0 +1,204 @@
+// Code generated by nullsgen; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"github.com/lefinal/nulls"
+	"gopkg.in/yaml.v3"
+	"io"
+	"log/slog"
+)
+
+// NullStatus holds a nullable Status.
+type NullStatus struct {
+	// Status is the actual value when Valid.
+	Status Status `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewNullStatus returns a valid NullStatus with the given value.
+func NewNullStatus(v Status) NullStatus {
+	return NullStatus{
+		Status: v,
+		Valid:  true,
+	}
+}
+
+// NullStatusFromPtr returns a NullStatus that is valid if the given pointer is
+// not nil.
+func NullStatusFromPtr(v *Status) NullStatus {
+	if v == nil {
+		return NullStatus{}
+	}
+	return NewNullStatus(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n NullStatus) Ptr() *Status {
+	if !n.Valid {
+		return nil
+	}
+	v := n.Status
+	return &v
+}
+
+// NullStatusFromSQLNull returns a NullStatus from the given sql.Null.
+func NullStatusFromSQLNull(v sql.Null[Status]) NullStatus {
+	return NullStatus{
+		Status: v.V,
+		Valid:  v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n NullStatus) ToSQLNull() sql.Null[Status] {
+	return sql.Null[Status]{
+		V:     n.Status,
+		Valid: n.Valid,
+	}
+}
+
+// toNulls returns the nulls.String representation.
+func (n NullStatus) toNulls() nulls.String {
+	return nulls.String{
+		String: string(n.Status),
+		Valid:  n.Valid,
+	}
+}
+
+// fromNulls sets the value from the given nulls.String.
+func (n *NullStatus) fromNulls(v nulls.String) {
+	n.Status = Status(v.String)
+	n.Valid = v.Valid
+}
+
+// Get returns the value and whether it is valid.
+func (n NullStatus) Get() (Status, bool) {
+	return n.Status, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n NullStatus) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *NullStatus) Set(v Status) {
+	*n = NewNullStatus(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *NullStatus) SetNull() {
+	*n = NullStatus{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n NullStatus) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (n NullStatus) Equal(other NullStatus) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return n.Status == other.Status
+}
+
+// String returns the value formatted like nulls.String.
+func (n NullStatus) String() string {
+	return fmt.Sprint(n.toNulls())
+}
+
+// Format implements fmt.Formatter like nulls.String. For %#v, GoString
+// is used.
+func (n NullStatus) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, n.GoString())
+		return
+	}
+	n.toNulls().Format(f, verb)
+}
+
+// GoString returns a Go expression creating the NullStatus.
+func (n NullStatus) GoString() string {
+	if !n.Valid {
+		return "example.NullStatus{}"
+	}
+	return fmt.Sprintf("example.NewNullStatus(%#v)", string(n.Status))
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (n NullStatus) LogValue() slog.Value {
+	return n.toNulls().LogValue()
+}
+
+// MarshalJSON marshals the value like nulls.String.
+func (n NullStatus) MarshalJSON() ([]byte, error) {
+	return n.toNulls().MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the value like nulls.String.
+func (n *NullStatus) UnmarshalJSON(data []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalText marshals the value like nulls.String.
+func (n NullStatus) MarshalText() ([]byte, error) {
+	return n.toNulls().MarshalText()
+}
+
+// UnmarshalText unmarshals the value like nulls.String.
+func (n *NullStatus) UnmarshalText(text []byte) error {
+	v := n.toNulls()
+	err := v.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// MarshalYAML marshals the value like nulls.String.
+func (n NullStatus) MarshalYAML() (any, error) {
+	return n.toNulls().MarshalYAML()
+}
+
+// UnmarshalYAML unmarshals the value like nulls.String.
+func (n *NullStatus) UnmarshalYAML(node *yaml.Node) error {
+	v := n.toNulls()
+	err := v.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Scan scans the value like nulls.String.
+func (n *NullStatus) Scan(src any) error {
+	v := n.toNulls()
+	err := v.Scan(src)
+	if err != nil {
+		return err
+	}
+	n.fromNulls(v)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface like
+// nulls.String.
+func (n NullStatus) Value() (driver.Value, error) {
+	return n.toNulls().Value()
+}
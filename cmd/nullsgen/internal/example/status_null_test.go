@@ -0,0 +1,139 @@
+// Code generated by nullsgen; DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNullStatusFromPtr tests NullStatusFromPtr and NullStatus.Ptr.
+func TestNullStatusFromPtr(t *testing.T) {
+	if NullStatusFromPtr(nil).Valid {
+		t.Error("should not be valid for nil")
+	}
+	v := Status("meow")
+	n := NullStatusFromPtr(&v)
+	if n != NewNullStatus(v) {
+		t.Errorf("should return correct value but got %#v", n)
+	}
+	if p := n.Ptr(); p == nil || *p != v {
+		t.Errorf("should return pointer to correct value but got %v", p)
+	}
+	if p := (NullStatus{}).Ptr(); p != nil {
+		t.Errorf("should return nil if not valid but got %v", p)
+	}
+}
+
+// TestNullStatus_Set tests NullStatus.Set and NullStatus.SetNull.
+func TestNullStatus_Set(t *testing.T) {
+	var n NullStatus
+	n.Set("meow")
+	if n != NewNullStatus("meow") {
+		t.Errorf("should set correct value but got %#v", n)
+	}
+	n.SetNull()
+	if !n.IsNull() {
+		t.Error("should be null after setting null")
+	}
+}
+
+// TestNullStatus_Equal tests NullStatus.Equal.
+func TestNullStatus_Equal(t *testing.T) {
+	n := NewNullStatus("meow")
+	if !n.Equal(NewNullStatus("meow")) {
+		t.Error("should be equal to same value")
+	}
+	if n.Equal(NullStatus{}) {
+		t.Error("should not be equal to NULL-value")
+	}
+	if !(NullStatus{}).Equal(NullStatus{Status: "meow"}) {
+		t.Error("should be equal to other NULL-value")
+	}
+}
+
+// TestNullStatus_JSON tests JSON marshalling and unmarshalling.
+func TestNullStatus_JSON(t *testing.T) {
+	for n, expect := range map[NullStatus]string{
+		NewNullStatus("meow"): `"meow"`,
+		NullStatus{}:          `null`,
+	} {
+		raw, err := json.Marshal(n)
+		if err != nil {
+			t.Fatalf("marshal %#v should not fail but got: %v", n, err)
+		}
+		if string(raw) != expect {
+			t.Errorf("marshal %#v should return %s but got %s", n, expect, raw)
+		}
+		var got NullStatus
+		err = json.Unmarshal(raw, &got)
+		if err != nil {
+			t.Fatalf("unmarshal %s should not fail but got: %v", raw, err)
+		}
+		if !got.Equal(n) {
+			t.Errorf("unmarshal %s should return %#v but got %#v", raw, n, got)
+		}
+	}
+	n := NewNullStatus("meow")
+	err := json.Unmarshal([]byte(`null`), &n)
+	if err != nil {
+		t.Fatalf("unmarshal null should not fail but got: %v", err)
+	}
+	if n.Valid {
+		t.Error("unmarshal null should set not valid")
+	}
+}
+
+// TestNullStatus_Text tests text marshalling and unmarshalling.
+func TestNullStatus_Text(t *testing.T) {
+	text, err := NewNullStatus("meow").MarshalText()
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if string(text) != "meow" {
+		t.Errorf("should return correct value but got %s", text)
+	}
+	var n NullStatus
+	err = n.UnmarshalText(text)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != NewNullStatus("meow") {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// TestNullStatus_YAML tests YAML marshalling and unmarshalling.
+func TestNullStatus_YAML(t *testing.T) {
+	raw, err := yaml.Marshal(NewNullStatus("meow"))
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	var n NullStatus
+	err = yaml.Unmarshal(raw, &n)
+	if err != nil {
+		t.Fatalf("should not fail but got: %v", err)
+	}
+	if n != NewNullStatus("meow") {
+		t.Errorf("should unmarshal correct value but got %#v", n)
+	}
+}
+
+// TestNullStatus_SQL tests NullStatus.Value and NullStatus.Scan.
+func TestNullStatus_SQL(t *testing.T) {
+	for _, n := range []NullStatus{NewNullStatus("meow"), {}} {
+		v, err := n.Value()
+		if err != nil {
+			t.Fatalf("value of %#v should not fail but got: %v", n, err)
+		}
+		var got NullStatus
+		err = got.Scan(v)
+		if err != nil {
+			t.Fatalf("scan %#v should not fail but got: %v", v, err)
+		}
+		if got != n {
+			t.Errorf("scan %#v should return %#v but got %#v", v, n, got)
+		}
+	}
+}
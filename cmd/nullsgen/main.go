@@ -0,0 +1,48 @@
+// Command nullsgen generates nullable types for named types like
+// `type OrderID int64` in the style of the types of the nulls package.
+//
+// It is meant to be used with go:generate:
+//
+//	//go:generate go run github.com/lefinal/nulls/cmd/nullsgen -type OrderID,Status
+//
+// For each given type, a file like order_id_null.go is written containing the
+// type NullOrderID with a field OrderID. JSON, text, YAML and SQL support is
+// provided by the nulls type of the underlying kind, for example nulls.Int64.
+// Unless disabled using -tests=false, a matching test file is written as well.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	typeNames := flag.String("type", "", "comma-separated list of type names; required")
+	prefix := flag.String("prefix", "Null", "prefix for the names of the generated types")
+	tests := flag.Bool("tests", true, "whether to generate test files")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "Usage: nullsgen -type T[,T...] [flags] [directory]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeNames == "" || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+	err := run(config{
+		Dir:       dir,
+		TypeNames: strings.Split(*typeNames, ","),
+		Prefix:    *prefix,
+		Tests:     *tests,
+	})
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "nullsgen: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,89 @@
+package nulls
+
+// Getter provides access to a nullable value. It is implemented by all types of
+// this package and allows using the functions below with any of them. Results
+// are returned as Optional and can be converted to concrete types using their
+// constructors.
+type Getter[T any] interface {
+	// Get returns the value and whether it is valid.
+	Get() (T, bool)
+}
+
+// Map returns an Optional holding the result of f applied to the value of g. If
+// g is not valid, f is not called and an invalid Optional is returned.
+func Map[T, U any](g Getter[T], f func(T) U) Optional[U] {
+	v, ok := g.Get()
+	if !ok {
+		return Optional[U]{}
+	}
+	return NewOptional(f(v))
+}
+
+// FlatMap returns the result of f applied to the value of g. If g is not valid,
+// f is not called and an invalid Optional is returned.
+func FlatMap[T, U any](g Getter[T], f func(T) Optional[U]) Optional[U] {
+	v, ok := g.Get()
+	if !ok {
+		return Optional[U]{}
+	}
+	return f(v)
+}
+
+// Filter returns an Optional with the value of g if g is valid and f returns
+// true for the value. Otherwise, an invalid Optional is returned.
+func Filter[T any](g Getter[T], f func(T) bool) Optional[T] {
+	v, ok := g.Get()
+	if !ok || !f(v) {
+		return Optional[T]{}
+	}
+	return NewOptional(v)
+}
+
+// OrElse returns the value of g if valid. Otherwise, the given fallback is
+// returned.
+func OrElse[T any](g Getter[T], fallback T) T {
+	v, ok := g.Get()
+	if !ok {
+		return fallback
+	}
+	return v
+}
+
+// OrElseGet returns the value of g if valid. Otherwise, the result of f is
+// returned. f is only called if g is not valid.
+func OrElseGet[T any](g Getter[T], f func() T) T {
+	v, ok := g.Get()
+	if !ok {
+		return f()
+	}
+	return v
+}
+
+// Or returns an Optional with the value of the first valid one of g and the
+// given alternatives. If none is valid, an invalid Optional is returned.
+func Or[T any](g Getter[T], alternatives ...Getter[T]) Optional[T] {
+	if v, ok := g.Get(); ok {
+		return NewOptional(v)
+	}
+	for _, alternative := range alternatives {
+		if v, ok := alternative.Get(); ok {
+			return NewOptional(v)
+		}
+	}
+	return Optional[T]{}
+}
+
+// Zip returns an Optional holding the result of f applied to the values of a
+// and b. If any of them is not valid, f is not called and an invalid Optional
+// is returned.
+func Zip[T, U, R any](a Getter[T], b Getter[U], f func(T, U) R) Optional[R] {
+	aV, ok := a.Get()
+	if !ok {
+		return Optional[R]{}
+	}
+	bV, ok := b.Get()
+	if !ok {
+		return Optional[R]{}
+	}
+	return NewOptional(f(aV, bV))
+}
@@ -0,0 +1,193 @@
+package nulls
+
+import (
+	"github.com/stretchr/testify/suite"
+	"strconv"
+	"testing"
+)
+
+// MapSuite tests Map.
+type MapSuite struct {
+	suite.Suite
+}
+
+func (suite *MapSuite) TestNotValid() {
+	called := false
+	o := Map(Int{Int: 16}, func(i int) string {
+		called = true
+		return strconv.Itoa(i)
+	})
+	suite.False(o.Valid, "should not be valid")
+	suite.False(called, "should not call function")
+}
+
+func (suite *MapSuite) TestOK() {
+	o := Map(NewInt(16), strconv.Itoa)
+	suite.Equal(NewOptional("16"), o, "should return correct value")
+}
+
+func (suite *MapSuite) TestGeneric() {
+	o := Map(NewJSONNullable(16), func(i int) int { return i * 2 })
+	suite.Equal(NewOptional(32), o, "should return correct value")
+}
+
+func TestMap(t *testing.T) {
+	suite.Run(t, new(MapSuite))
+}
+
+// FlatMapSuite tests FlatMap.
+type FlatMapSuite struct {
+	suite.Suite
+}
+
+func atoiOptional(s string) Optional[int] {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return Optional[int]{}
+	}
+	return NewOptional(i)
+}
+
+func (suite *FlatMapSuite) TestNotValid() {
+	o := FlatMap(String{String: "16"}, atoiOptional)
+	suite.False(o.Valid, "should not be valid")
+}
+
+func (suite *FlatMapSuite) TestResultNotValid() {
+	o := FlatMap(NewString("meow"), atoiOptional)
+	suite.False(o.Valid, "should not be valid")
+}
+
+func (suite *FlatMapSuite) TestOK() {
+	o := FlatMap(NewString("16"), atoiOptional)
+	suite.Equal(NewOptional(16), o, "should return correct value")
+}
+
+func TestFlatMap(t *testing.T) {
+	suite.Run(t, new(FlatMapSuite))
+}
+
+// FilterSuite tests Filter.
+type FilterSuite struct {
+	suite.Suite
+}
+
+func isPositive(i int64) bool {
+	return i > 0
+}
+
+func (suite *FilterSuite) TestNotValid() {
+	o := Filter(Int64{Int64: 16}, isPositive)
+	suite.False(o.Valid, "should not be valid")
+}
+
+func (suite *FilterSuite) TestRejected() {
+	o := Filter(NewInt64(-16), isPositive)
+	suite.False(o.Valid, "should not be valid")
+}
+
+func (suite *FilterSuite) TestOK() {
+	o := Filter(NewInt64(16), isPositive)
+	suite.Equal(NewOptional[int64](16), o, "should return correct value")
+}
+
+func TestFilter(t *testing.T) {
+	suite.Run(t, new(FilterSuite))
+}
+
+// OrElseSuite tests OrElse.
+type OrElseSuite struct {
+	suite.Suite
+}
+
+func (suite *OrElseSuite) TestNotValid() {
+	suite.Equal("fallback", OrElse(String{String: "meow"}, "fallback"), "should return correct value")
+}
+
+func (suite *OrElseSuite) TestOK() {
+	suite.Equal("meow", OrElse(NewString("meow"), "fallback"), "should return correct value")
+}
+
+func TestOrElse(t *testing.T) {
+	suite.Run(t, new(OrElseSuite))
+}
+
+// OrElseGetSuite tests OrElseGet.
+type OrElseGetSuite struct {
+	suite.Suite
+}
+
+func (suite *OrElseGetSuite) TestNotValid() {
+	v := OrElseGet(Optional[int]{V: 16}, func() int { return 42 })
+	suite.Equal(42, v, "should return correct value")
+}
+
+func (suite *OrElseGetSuite) TestOK() {
+	called := false
+	v := OrElseGet(NewOptional(16), func() int {
+		called = true
+		return 42
+	})
+	suite.Equal(16, v, "should return correct value")
+	suite.False(called, "should not call function")
+}
+
+func TestOrElseGet(t *testing.T) {
+	suite.Run(t, new(OrElseGetSuite))
+}
+
+// OrSuite tests Or.
+type OrSuite struct {
+	suite.Suite
+}
+
+func (suite *OrSuite) TestFirst() {
+	o := Or[bool](NewBool(false), NewOptional(true))
+	suite.Equal(NewOptional(false), o, "should return correct value")
+}
+
+func (suite *OrSuite) TestAlternative() {
+	o := Or[bool](Bool{}, Optional[bool]{}, NewOptional(true), NewBool(false))
+	suite.Equal(NewOptional(true), o, "should return correct value")
+}
+
+func (suite *OrSuite) TestNoneValid() {
+	o := Or[bool](Bool{}, Optional[bool]{})
+	suite.False(o.Valid, "should not be valid")
+}
+
+func TestOr(t *testing.T) {
+	suite.Run(t, new(OrSuite))
+}
+
+// ZipSuite tests Zip.
+type ZipSuite struct {
+	suite.Suite
+}
+
+func repeat(s string, n int) string {
+	r := ""
+	for i := 0; i < n; i++ {
+		r += s
+	}
+	return r
+}
+
+func (suite *ZipSuite) TestFirstNotValid() {
+	o := Zip(String{String: "a"}, NewInt(2), repeat)
+	suite.False(o.Valid, "should not be valid")
+}
+
+func (suite *ZipSuite) TestSecondNotValid() {
+	o := Zip(NewString("a"), Int{Int: 2}, repeat)
+	suite.False(o.Valid, "should not be valid")
+}
+
+func (suite *ZipSuite) TestOK() {
+	o := Zip(NewString("a"), NewInt(2), repeat)
+	suite.Equal(NewOptional("aa"), o, "should return correct value")
+}
+
+func TestZip(t *testing.T) {
+	suite.Run(t, new(ZipSuite))
+}
@@ -0,0 +1,70 @@
+package nulls
+
+import (
+	"cmp"
+	"reflect"
+)
+
+// NullsOrder describes the position of NULL-values when comparing.
+type NullsOrder int
+
+const (
+	// NullsFirst orders NULL-values before all other values.
+	NullsFirst NullsOrder = iota
+	// NullsLast orders NULL-values after all other values.
+	NullsLast
+)
+
+// Equal returns true if a and b are both not valid or both valid with equal
+// values. Values are compared using their Equal method if available like for
+// time.Time or reflect.DeepEqual otherwise.
+func Equal[T any](a, b Getter[T]) bool {
+	aV, aOK := a.Get()
+	bV, bOK := b.Get()
+	if !aOK || !bOK {
+		return aOK == bOK
+	}
+	return equalValues(aV, bV)
+}
+
+// Compare returns -1 if a is less than b, 0 if they are equal and +1 if a is
+// greater than b. Values are compared using cmp.Compare and NULL-values are
+// ordered according to the given NullsOrder. Two NULL-values are equal.
+func Compare[T cmp.Ordered](a, b Getter[T], order NullsOrder) int {
+	aV, aOK := a.Get()
+	bV, bOK := b.Get()
+	switch {
+	case !aOK && !bOK:
+		return 0
+	case !aOK:
+		if order == NullsLast {
+			return 1
+		}
+		return -1
+	case !bOK:
+		if order == NullsLast {
+			return -1
+		}
+		return 1
+	}
+	return cmp.Compare(aV, bV)
+}
+
+// CompareFunc returns a function comparing values of type G using Compare with
+// the given NullsOrder. It can be used with slices.SortFunc:
+//
+//	slices.SortFunc(s, nulls.CompareFunc[nulls.Int](nulls.NullsLast))
+func CompareFunc[G Getter[T], T cmp.Ordered](order NullsOrder) func(a, b G) int {
+	return func(a, b G) int {
+		return Compare[T](a, b, order)
+	}
+}
+
+// equalValues checks if a and b are equal. If T has an Equal method, it is used.
+// Otherwise, reflect.DeepEqual is used.
+func equalValues[T any](a, b T) bool {
+	if equaler, ok := any(a).(interface{ Equal(T) bool }); ok {
+		return equaler.Equal(b)
+	}
+	return reflect.DeepEqual(a, b)
+}
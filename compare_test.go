@@ -0,0 +1,105 @@
+package nulls
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"slices"
+	"testing"
+	"time"
+)
+
+// EqualSuite tests Equal.
+type EqualSuite struct {
+	suite.Suite
+}
+
+func (suite *EqualSuite) TestBothNotValid() {
+	suite.True(Equal[int](Int{Int: 16}, Optional[int]{}), "should be equal")
+}
+
+func (suite *EqualSuite) TestOneNotValid() {
+	suite.False(Equal[int](NewInt(16), Optional[int]{V: 16}), "should not be equal")
+	suite.False(Equal[int](Optional[int]{V: 16}, NewInt(16)), "should not be equal")
+}
+
+func (suite *EqualSuite) TestValues() {
+	suite.True(Equal(NewString("meow"), NewString("meow")), "should be equal")
+	suite.True(Equal[string](NewString("meow"), NewOptional("meow")), "should be equal")
+	suite.False(Equal(NewString("meow"), NewString("woof")), "should not be equal")
+}
+
+func (suite *EqualSuite) TestTimeInDifferentLocations() {
+	now := time.Now()
+	suite.True(Equal[time.Time](NewTime(now), NewTime(now.UTC())), "should be equal")
+	suite.True(Equal[time.Time](NewOptional(now), NewOptional(now.Round(0))), "should be equal")
+	suite.True(Equal[time.Time](NewTime(now), NewOptional(now.In(time.FixedZone("CEST", 2*60*60)))), "should be equal")
+	suite.False(Equal[time.Time](NewTime(now), NewTime(now.Add(time.Second))), "should not be equal")
+}
+
+func (suite *EqualSuite) TestNonComparable() {
+	suite.True(Equal[[]int](NewOptional([]int{1, 2}), NewOptional([]int{1, 2})), "should be equal")
+	suite.False(Equal[[]int](NewOptional([]int{1, 2}), NewOptional([]int{2, 1})), "should not be equal")
+}
+
+func TestEqual(t *testing.T) {
+	suite.Run(t, new(EqualSuite))
+}
+
+// CompareSuite tests Compare.
+type CompareSuite struct {
+	suite.Suite
+}
+
+func (suite *CompareSuite) TestValues() {
+	suite.Equal(-1, Compare(NewInt(1), NewInt(2), NullsFirst), "should return correct value")
+	suite.Equal(0, Compare(NewInt(2), NewInt(2), NullsFirst), "should return correct value")
+	suite.Equal(1, Compare(NewInt(3), NewInt(2), NullsLast), "should return correct value")
+}
+
+func (suite *CompareSuite) TestBothNull() {
+	suite.Equal(0, Compare(Int{Int: 1}, Int{Int: 2}, NullsFirst), "should return correct value")
+	suite.Equal(0, Compare(Int{Int: 1}, Int{Int: 2}, NullsLast), "should return correct value")
+}
+
+func (suite *CompareSuite) TestNullsFirst() {
+	suite.Equal(-1, Compare(Int{}, NewInt(-1), NullsFirst), "should return correct value")
+	suite.Equal(1, Compare(NewInt(-1), Int{}, NullsFirst), "should return correct value")
+}
+
+func (suite *CompareSuite) TestNullsLast() {
+	suite.Equal(1, Compare(Int{}, NewInt(-1), NullsLast), "should return correct value")
+	suite.Equal(-1, Compare(NewInt(-1), Int{}, NullsLast), "should return correct value")
+}
+
+func TestCompare(t *testing.T) {
+	suite.Run(t, new(CompareSuite))
+}
+
+// CompareFuncSuite tests CompareFunc.
+type CompareFuncSuite struct {
+	suite.Suite
+}
+
+func (suite *CompareFuncSuite) TestNullsFirst() {
+	s := []Int{NewInt(2), {}, NewInt(1)}
+	slices.SortFunc(s, CompareFunc[Int](NullsFirst))
+	suite.Equal([]Int{{}, NewInt(1), NewInt(2)}, s, "should sort correctly")
+}
+
+func (suite *CompareFuncSuite) TestNullsLast() {
+	s := []Optional[string]{NewOptional("b"), {}, NewOptional("a")}
+	slices.SortFunc(s, CompareFunc[Optional[string]](NullsLast))
+	suite.Equal([]Optional[string]{NewOptional("a"), NewOptional("b"), {}}, s, "should sort correctly")
+}
+
+func TestCompareFunc(t *testing.T) {
+	suite.Run(t, new(CompareFuncSuite))
+}
+
+// TestEqualValues tests equalValues.
+func TestEqualValues(t *testing.T) {
+	a := time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)
+	assert.True(t, equalValues(a, a.In(time.FixedZone("CEST", 2*60*60))), "should use Equal method")
+	assert.True(t, equalValues([]int{1, 2}, []int{1, 2}), "should use reflect.DeepEqual")
+	assert.False(t, equalValues([]int{1, 2}, []int{2, 1}), "should use reflect.DeepEqual")
+}
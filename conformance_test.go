@@ -0,0 +1,188 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding"
+	"encoding/json"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// conformanceCase describes a type checked by nullSemanticsSuite.
+type conformanceCase struct {
+	// valid returns a pointer to a new valid value with a non-zero payload.
+	valid func() any
+	// null is the expected NULL-value. If nil, the zero value of the type is
+	// expected.
+	null any
+	// withoutScan is true for types not implementing sql.Scanner.
+	withoutScan bool
+}
+
+// conformanceCases holds the cases for all types of this package.
+var conformanceCases = map[string]conformanceCase{
+	"BigInt":       {valid: func() any { v := NewBigInt(big.NewInt(42)); return &v }},
+	"BigIntString": {valid: func() any { v := NewBigIntString(big.NewInt(42)); return &v }},
+	"Bool":         {valid: func() any { v := NewBool(true); return &v }},
+	"ByteSlice": {
+		valid: func() any { v := NewByteSlice([]byte("meow")); return &v },
+	},
+	"Bytes":        {valid: func() any { v := NewBytes([]byte("meow")); return &v }},
+	"Date":         {valid: func() any { v := NewDate(2024, 2, 29); return &v }},
+	"Duration":     {valid: func() any { v := NewDuration(time.Minute); return &v }},
+	"ModeDuration": {valid: func() any { v := NewModeDuration[DurationInterval](time.Minute); return &v }},
+	"Float32":      {valid: func() any { v := NewFloat32(0.1); return &v }},
+	"Float64":      {valid: func() any { v := NewFloat64(0.1); return &v }},
+	"Int":          {valid: func() any { v := NewInt(42); return &v }},
+	"Int8":         {valid: func() any { v := NewInt8(42); return &v }},
+	"Int16":        {valid: func() any { v := NewInt16(42); return &v }},
+	"Int32":        {valid: func() any { v := NewInt32(42); return &v }},
+	"Int64":        {valid: func() any { v := NewInt64(42); return &v }},
+	"JSONNullable": {
+		valid: func() any { v := NewJSONNullable(myStruct{A: "meow"}); return &v },
+	},
+	"JSONRawMessage": {
+		valid: func() any { v := NewJSONRawMessage(json.RawMessage(`{}`)); return &v },
+	},
+	"LayoutTime": {
+		valid: func() any { v := NewLayoutTime[DateLayout](time.Now()); return &v },
+	},
+	"Named": {valid: func() any { v := NewNamed[testUserID](42); return &v }},
+	"Nullable": {
+		valid: func() any { v := NewNullable(&sql.NullBool{Bool: true, Valid: true}); return &v },
+	},
+	"NullableByValue": {
+		valid: func() any {
+			v := NewNullableByValue[byValueScanner, *byValueScanner](byValueScanner{A: "meow"})
+			return &v
+		},
+	},
+	"NullableInto": {
+		valid: func() any { v := NewNullableInto(myStruct{A: "meow"}); return &v },
+	},
+	"Number":   {valid: func() any { v := NewNumber[int8](42); return &v }},
+	"Optional": {valid: func() any { v := NewOptional("meow"); return &v }},
+	"Patch": {
+		valid:       func() any { v := NewPatch("meow"); return &v },
+		null:        NewNullPatch[string](),
+		withoutScan: true,
+	},
+	"Rat":           {valid: func() any { v := NewRat(big.NewRat(1, 2)); return &v }},
+	"RatString":     {valid: func() any { v := NewRatString(big.NewRat(1, 2)); return &v }},
+	"String":        {valid: func() any { v := NewString("meow"); return &v }},
+	"Time":          {valid: func() any { v := NewTime(time.Now()); return &v }},
+	"TimeOfDay":     {valid: func() any { v := NewTimeOfDay(13, 37, 42, 0); return &v }},
+	"Uint":          {valid: func() any { v := NewUint(42); return &v }},
+	"Uint8":         {valid: func() any { v := NewUint8(42); return &v }},
+	"Uint16":        {valid: func() any { v := NewUint16(42); return &v }},
+	"Uint32":        {valid: func() any { v := NewUint32(42); return &v }},
+	"Uint64":        {valid: func() any { v := NewUint64(42); return &v }},
+	"UnixMilliTime": {valid: func() any { v := NewUnixMilliTime(time.Now()); return &v }},
+	"UnixTime":      {valid: func() any { v := NewUnixTime(time.Now()); return &v }},
+	"UUID":          {valid: func() any { v := UUIDOf(uuid.Must(uuid.NewV4())); return &v }},
+}
+
+// nullSemanticsSuite asserts that all ways of setting a NULL-value reset the
+// payload to the zero value for the type described by c.
+type nullSemanticsSuite struct {
+	suite.Suite
+	c conformanceCase
+}
+
+// assertNull asserts that the value p points to is the expected NULL-value.
+func (suite *nullSemanticsSuite) assertNull(p any) {
+	expect := suite.c.null
+	if expect == nil {
+		expect = reflect.Zero(reflect.TypeOf(p).Elem()).Interface()
+	}
+	suite.Equal(expect, reflect.ValueOf(p).Elem().Interface(), "should reset to NULL-value")
+}
+
+// validValue returns a new valid value and asserts that it is not NULL.
+func (suite *nullSemanticsSuite) validValue() any {
+	p := suite.c.valid()
+	suite.Require().False(reflect.ValueOf(p).Elem().IsZero(), "valid value should not be zero")
+	return p
+}
+
+func (suite *nullSemanticsSuite) TestJSON() {
+	p := suite.validValue()
+	err := p.(json.Unmarshaler).UnmarshalJSON([]byte(`null`))
+	suite.Require().NoError(err, "should not fail")
+	suite.assertNull(p)
+}
+
+func (suite *nullSemanticsSuite) TestJSONField() {
+	p := suite.validValue()
+	s := reflect.New(reflect.StructOf([]reflect.StructField{
+		{Name: "V", Type: reflect.TypeOf(p).Elem(), Tag: `json:"v"`},
+	}))
+	s.Elem().Field(0).Set(reflect.ValueOf(p).Elem())
+	err := json.Unmarshal([]byte(`{"v":null}`), s.Interface())
+	suite.Require().NoError(err, "should not fail")
+	suite.assertNull(s.Elem().Field(0).Addr().Interface())
+}
+
+func (suite *nullSemanticsSuite) TestText() {
+	p := suite.validValue()
+	err := p.(encoding.TextUnmarshaler).UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.assertNull(p)
+}
+
+func (suite *nullSemanticsSuite) TestYAML() {
+	p := suite.validValue()
+	err := p.(yaml.Unmarshaler).UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"})
+	suite.Require().NoError(err, "should not fail")
+	suite.assertNull(p)
+}
+
+// TestYAMLField documents that yaml.v3 does not call UnmarshalYAML for null in
+// mappings. Therefore, the value is left unchanged and only zero when decoding
+// into a fresh struct.
+func (suite *nullSemanticsSuite) TestYAMLField() {
+	p := suite.validValue()
+	s := reflect.New(reflect.StructOf([]reflect.StructField{
+		{Name: "V", Type: reflect.TypeOf(p).Elem(), Tag: `yaml:"v"`},
+	}))
+	s.Elem().Field(0).Set(reflect.ValueOf(p).Elem())
+	err := yaml.Unmarshal([]byte(`v: null`), s.Interface())
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(reflect.ValueOf(p).Elem().Interface(), s.Elem().Field(0).Interface(), "should keep value")
+
+	s = reflect.New(s.Elem().Type())
+	err = yaml.Unmarshal([]byte(`v: null`), s.Interface())
+	suite.Require().NoError(err, "should not fail")
+	suite.True(s.Elem().Field(0).IsZero(), "should be zero")
+}
+
+func (suite *nullSemanticsSuite) TestScan() {
+	if suite.c.withoutScan {
+		suite.T().Skip("sql.Scanner not implemented")
+	}
+	p := suite.validValue()
+	err := p.(sql.Scanner).Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.assertNull(p)
+}
+
+func (suite *nullSemanticsSuite) TestSetNull() {
+	p := suite.validValue()
+	p.(interface{ SetNull() }).SetNull()
+	suite.assertNull(p)
+}
+
+// TestNullSemantics asserts that all types of this package handle NULL-values
+// the same way.
+func TestNullSemantics(t *testing.T) {
+	for name, c := range conformanceCases {
+		t.Run(name, func(t *testing.T) {
+			suite.Run(t, &nullSemanticsSuite{c: c})
+		})
+	}
+}
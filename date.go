@@ -0,0 +1,327 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"time"
+)
+
+// dateLayouts are the layouts accepted when scanning a Date from strings.
+var dateLayouts = []string{time.DateOnly, time.RFC3339Nano, time.DateTime}
+
+// Date holds a nullable calendar date without time and time zone. It can be
+// used for DATE columns and is represented as 2006-01-02 in JSON and text.
+type Date struct {
+	// Year is the year when Valid.
+	Year int `exhaustruct:"optional"`
+	// Month is the month of the year when Valid.
+	Month time.Month `exhaustruct:"optional"`
+	// Day is the day of the month when Valid.
+	Day int `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewDate returns a valid Date with the given values. They are normalized like
+// in time.Date, so October 32 becomes November 1.
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns a valid Date with the date of the given time.Time in its
+// location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{
+		Year:  year,
+		Month: month,
+		Day:   day,
+		Valid: true,
+	}
+}
+
+// Time returns the date as time.Time at midnight in UTC.
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// DateFromPtr returns a Date with the date of the given time.Time in its
+// location that is valid if the given pointer is not nil.
+func DateFromPtr(v *time.Time) Date {
+	if v == nil {
+		return Date{}
+	}
+	return DateOf(*v)
+}
+
+// Ptr returns a pointer to the date as time.Time at midnight in UTC if valid or
+// nil otherwise.
+func (d Date) Ptr() *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	v := d.Time()
+	return &v
+}
+
+// DateFromSQL returns a Date from the given sql.NullTime.
+func DateFromSQL(v sql.NullTime) Date {
+	if !v.Valid {
+		return Date{}
+	}
+	return DateOf(v.Time)
+}
+
+// ToSQL returns the sql.NullTime representation with the date at midnight in
+// UTC.
+func (d Date) ToSQL() sql.NullTime {
+	if !d.Valid {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{
+		Time:  d.Time(),
+		Valid: true,
+	}
+}
+
+// DateFromSQLNull returns a Date from the given sql.Null.
+func DateFromSQLNull(v sql.Null[time.Time]) Date {
+	if !v.Valid {
+		return Date{}
+	}
+	return DateOf(v.V)
+}
+
+// ToSQLNull returns the sql.Null representation with the date at midnight in
+// UTC.
+func (d Date) ToSQLNull() sql.Null[time.Time] {
+	if !d.Valid {
+		return sql.Null[time.Time]{}
+	}
+	return sql.Null[time.Time]{
+		V:     d.Time(),
+		Valid: true,
+	}
+}
+
+// Get returns the date as time.Time at midnight in UTC and whether it is valid.
+func (d Date) Get() (time.Time, bool) {
+	return d.Time(), d.Valid
+}
+
+// IsNull returns true if not valid.
+func (d Date) IsNull() bool {
+	return !d.Valid
+}
+
+// Set sets the date of the given time.Time in its location and makes it valid.
+func (d *Date) Set(v time.Time) {
+	*d = DateOf(v)
+}
+
+// SetNull sets a NULL-value.
+func (d *Date) SetNull() {
+	*d = Date{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (d Date) IsZero() bool {
+	return !d.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal dates.
+func (d Date) Equal(other Date) bool {
+	if !d.Valid || !other.Valid {
+		return d.Valid == other.Valid
+	}
+	return d.Time().Equal(other.Time())
+}
+
+// Before reports whether both are valid and d is before other.
+func (d Date) Before(other Date) bool {
+	return d.Valid && other.Valid && d.Time().Before(other.Time())
+}
+
+// After reports whether both are valid and d is after other.
+func (d Date) After(other Date) bool {
+	return d.Valid && other.Valid && d.Time().After(other.Time())
+}
+
+// AddDays returns the date with the given number of days added. If not valid,
+// the Date is returned unchanged.
+func (d Date) AddDays(days int) Date {
+	return d.AddDate(0, 0, days)
+}
+
+// AddDate returns the date with the given number of years, months and days
+// added. It is normalized like time.Time.AddDate. If not valid, the Date is
+// returned unchanged.
+func (d Date) AddDate(years int, months int, days int) Date {
+	if !d.Valid {
+		return d
+	}
+	return DateOf(d.Time().AddDate(years, months, days))
+}
+
+// DaysUntil returns the number of days from d until other. The result is
+// negative if other is before d. Both need to be valid.
+func (d Date) DaysUntil(other Date) int {
+	return int((other.Time().Unix() - d.Time().Unix()) / (24 * 60 * 60))
+}
+
+// Weekday returns the day of the week.
+func (d Date) Weekday() time.Weekday {
+	return d.Time().Weekday()
+}
+
+// format returns the date formatted as 2006-01-02.
+func (d Date) format() string {
+	return d.Time().Format(time.DateOnly)
+}
+
+// String returns the date formatted as 2006-01-02 or NullToken if not valid.
+func (d Date) String() string {
+	if !d.Valid {
+		return NullToken
+	}
+	return d.format()
+}
+
+// Format implements fmt.Formatter. The date formatted as 2006-01-02 is
+// formatted using the given verb or NullToken is written if not valid. For %#v,
+// GoString is used.
+func (d Date) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, d, d.format(), d.Valid)
+}
+
+// GoString returns a Go expression creating the Date.
+func (d Date) GoString() string {
+	if !d.Valid {
+		return "nulls.Date{}"
+	}
+	return fmt.Sprintf("nulls.NewDate(%d, time.%s, %d)", d.Year, d.Month, d.Day)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (d Date) LogValue() slog.Value {
+	if !d.Valid {
+		return logNullValue()
+	}
+	return slog.StringValue(d.format())
+}
+
+// MarshalJSON marshals the date as 2006-01-02. If not valid, a NULL-value is
+// returned.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(d.format())
+}
+
+// UnmarshalJSON as 2006-01-02 or sets Valid to false if null.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		d.SetNull()
+		return nil
+	}
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	return d.parse(s, time.DateOnly)
+}
+
+// MarshalText marshals the date as 2006-01-02. If not valid, empty text is
+// returned.
+func (d Date) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.format()), nil
+}
+
+// UnmarshalText as 2006-01-02 or sets Valid to false if empty.
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		d.SetNull()
+		return nil
+	}
+	return d.parse(string(text), time.DateOnly)
+}
+
+// MarshalYAML marshals the date as 2006-01-02. If not valid, a NULL-value is
+// returned.
+func (d Date) MarshalYAML() (any, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.format(), nil
+}
+
+// UnmarshalYAML as 2006-01-02 or sets Valid to false if null.
+func (d *Date) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		d.SetNull()
+		return nil
+	}
+	var s string
+	err := node.Decode(&s)
+	if err != nil {
+		return err
+	}
+	return d.parse(s, time.DateOnly)
+}
+
+// Scan to date value or not valid if nil. For time.Time values, the date in
+// their location is used. Strings are parsed as 2006-01-02 and full timestamps
+// are accepted as well.
+func (d *Date) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		d.SetNull()
+		return nil
+	case time.Time:
+		*d = DateOf(src)
+		return nil
+	case string:
+		return d.parse(src, dateLayouts...)
+	case []byte:
+		return d.parse(string(src), dateLayouts...)
+	default:
+		return fmt.Errorf("unsupported source value type: %T", src)
+	}
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The date
+// is returned as time.Time at midnight in UTC.
+func (d Date) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Time(), nil
+}
+
+// parse parses the given string with the first matching layout and sets the
+// Date valid. If no layout matches, the error for the first one is returned.
+func (d *Date) parse(s string, layouts ...string) error {
+	var firstErr error
+	for _, layout := range layouts {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		*d = DateOf(t)
+		return nil
+	}
+	return firstErr
+}
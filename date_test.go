@@ -0,0 +1,360 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+	"time"
+)
+
+// TestNewDate tests NewDate.
+func TestNewDate(t *testing.T) {
+	d := NewDate(2022, time.July, 1)
+	assert.True(t, d.Valid, "should be valid")
+	assert.Equal(t, 2022, d.Year, "should have set correct year")
+	assert.Equal(t, time.July, d.Month, "should have set correct month")
+	assert.Equal(t, 1, d.Day, "should have set correct day")
+	assert.Equal(t, NewDate(2022, time.November, 1), NewDate(2022, time.October, 32), "should normalize")
+}
+
+// TestDateOf tests DateOf.
+func TestDateOf(t *testing.T) {
+	v := time.Date(2022, 7, 1, 23, 30, 0, 0, time.FixedZone("", -2*60*60))
+	assert.Equal(t, NewDate(2022, time.July, 1), DateOf(v), "should use date in location")
+}
+
+// TestDateFromPtr tests DateFromPtr and Date.Ptr.
+func TestDateFromPtr(t *testing.T) {
+	assert.False(t, DateFromPtr(nil).Valid, "should not be valid")
+	assert.Nil(t, Date{Year: 2022}.Ptr(), "should return nil")
+	v := time.Date(2022, 7, 1, 10, 30, 0, 0, time.UTC)
+	d := DateFromPtr(&v)
+	assert.Equal(t, NewDate(2022, time.July, 1), d, "should return correct value")
+	assert.Equal(t, &testDate, d.Ptr(), "should return correct value")
+}
+
+// TestDateFromSQL tests DateFromSQL and Date.ToSQL.
+func TestDateFromSQL(t *testing.T) {
+	assert.Equal(t, Date{}, DateFromSQL(sql.NullTime{Time: testDate}), "should return correct value")
+	n := sql.NullTime{Time: testDate, Valid: true}
+	assert.Equal(t, NewDate(2022, time.July, 1), DateFromSQL(n), "should return correct value")
+	assert.Equal(t, n, NewDate(2022, time.July, 1).ToSQL(), "should return correct value")
+	assert.Equal(t, sql.NullTime{}, Date{Year: 2022}.ToSQL(), "should return correct value")
+}
+
+// TestDateFromSQLNull tests DateFromSQLNull and Date.ToSQLNull.
+func TestDateFromSQLNull(t *testing.T) {
+	assert.Equal(t, Date{}, DateFromSQLNull(sql.Null[time.Time]{V: testDate}), "should return correct value")
+	n := sql.Null[time.Time]{V: testDate, Valid: true}
+	assert.Equal(t, NewDate(2022, time.July, 1), DateFromSQLNull(n), "should return correct value")
+	assert.Equal(t, n, NewDate(2022, time.July, 1).ToSQLNull(), "should return correct value")
+	assert.Equal(t, sql.Null[time.Time]{}, Date{Year: 2022}.ToSQLNull(), "should return correct value")
+}
+
+// TestDate_Get tests Date.Get.
+func TestDate_Get(t *testing.T) {
+	v, ok := NewDate(2022, time.July, 1).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, testDate, v, "should return correct value")
+	_, ok = Date{}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestDate_IsZero tests Date.IsZero.
+func TestDate_IsZero(t *testing.T) {
+	assert.False(t, NewDate(2022, time.July, 1).IsZero(), "should not be zero")
+	assert.True(t, Date{Year: 2022, Month: time.July, Day: 1}.IsZero(), "should be zero")
+}
+
+// TestDate_Equal tests Date.Equal.
+func TestDate_Equal(t *testing.T) {
+	d := NewDate(2022, time.July, 1)
+	assert.True(t, d.Equal(NewDate(2022, time.June, 31)), "should be equal")
+	assert.False(t, d.Equal(NewDate(2022, time.July, 2)), "should not be equal")
+	assert.False(t, d.Equal(Date{Year: 2022, Month: time.July, Day: 1}), "should not be equal")
+	assert.True(t, Date{Year: 2022}.Equal(Date{}), "should be equal")
+}
+
+// TestDate_Before tests Date.Before and Date.After.
+func TestDate_Before(t *testing.T) {
+	d := NewDate(2022, time.July, 1)
+	assert.True(t, d.Before(NewDate(2022, time.July, 2)), "should be before")
+	assert.False(t, d.Before(d), "should not be before")
+	assert.False(t, d.Before(Date{}), "should not be before")
+	assert.True(t, d.After(NewDate(2022, time.June, 30)), "should be after")
+	assert.False(t, d.After(d), "should not be after")
+	assert.False(t, Date{}.After(d), "should not be after")
+}
+
+// TestDate_AddDays tests Date.AddDays and Date.AddDate.
+func TestDate_AddDays(t *testing.T) {
+	d := NewDate(2022, time.July, 1)
+	assert.Equal(t, NewDate(2022, time.August, 1), d.AddDays(31), "should return correct value")
+	assert.Equal(t, NewDate(2022, time.June, 30), d.AddDays(-1), "should return correct value")
+	assert.Equal(t, NewDate(2023, time.August, 2), d.AddDate(1, 1, 1), "should return correct value")
+	assert.Equal(t, Date{}, Date{}.AddDays(1), "should not change invalid date")
+}
+
+// TestDate_DaysUntil tests Date.DaysUntil.
+func TestDate_DaysUntil(t *testing.T) {
+	d := NewDate(2022, time.July, 1)
+	assert.Equal(t, 31, d.DaysUntil(NewDate(2022, time.August, 1)), "should return correct value")
+	assert.Equal(t, -365, d.DaysUntil(NewDate(2021, time.July, 1)), "should return correct value")
+	assert.Equal(t, 0, d.DaysUntil(d), "should return correct value")
+}
+
+// TestDate_Weekday tests Date.Weekday.
+func TestDate_Weekday(t *testing.T) {
+	assert.Equal(t, time.Friday, NewDate(2022, time.July, 1).Weekday(), "should return correct value")
+}
+
+// TestDate_String tests Date.String.
+func TestDate_String(t *testing.T) {
+	assert.Equal(t, "2022-07-01", NewDate(2022, time.July, 1).String(), "should return correct value")
+	assert.Equal(t, NullToken, Date{}.String(), "should return null token")
+}
+
+// TestDate_Format tests Date.Format.
+func TestDate_Format(t *testing.T) {
+	assert.Equal(t, `"2022-07-01"`, fmt.Sprintf("%q", NewDate(2022, time.July, 1)), "should return correct value")
+	assert.Equal(t, "<null>    ", fmt.Sprintf("%-10v", Date{}), "should return null token")
+}
+
+// TestDate_GoString tests Date.GoString.
+func TestDate_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewDate(2022, time.July, 1)", fmt.Sprintf("%#v", NewDate(2022, time.July, 1)),
+		"should return correct value")
+	assert.Equal(t, "nulls.Date{}", Date{}.GoString(), "should return correct value")
+}
+
+// TestDate_LogValue tests Date.LogValue.
+func TestDate_LogValue(t *testing.T) {
+	assert.Equal(t, "2022-07-01", NewDate(2022, time.July, 1).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Date{Year: 2022}.LogValue().Any(), "should return null value")
+}
+
+// DateMarshalJSONSuite tests Date.MarshalJSON.
+type DateMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *DateMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(Date{Year: 2022, Month: time.July, Day: 1})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *DateMarshalJSONSuite) TestOK() {
+	raw, err := json.Marshal(NewDate(2022, time.July, 1))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`"2022-07-01"`, string(raw), "should return correct value")
+}
+
+func TestDate_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(DateMarshalJSONSuite))
+}
+
+// DateUnmarshalJSONSuite tests Date.UnmarshalJSON.
+type DateUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *DateUnmarshalJSONSuite) TestNull() {
+	d := NewDate(2022, time.July, 1)
+	err := json.Unmarshal(jsonNull, &d)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(d.Valid, "should not be valid")
+}
+
+func (suite *DateUnmarshalJSONSuite) TestUnmarshalFail() {
+	var d Date
+	err := json.Unmarshal([]byte(`20220701`), &d)
+	suite.Error(err, "should fail")
+}
+
+func (suite *DateUnmarshalJSONSuite) TestParseFail() {
+	var d Date
+	err := json.Unmarshal([]byte(`"2022-07-01T00:00:00Z"`), &d)
+	suite.Error(err, "should fail")
+}
+
+func (suite *DateUnmarshalJSONSuite) TestOK() {
+	var d Date
+	err := json.Unmarshal([]byte(`"2022-07-01"`), &d)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewDate(2022, time.July, 1), d, "should unmarshal correct value")
+}
+
+func TestDate_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(DateUnmarshalJSONSuite))
+}
+
+// DateMarshalTextSuite tests Date.MarshalText.
+type DateMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *DateMarshalTextSuite) TestNotValid() {
+	text, err := Date{Year: 2022}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *DateMarshalTextSuite) TestOK() {
+	text, err := NewDate(2022, time.July, 1).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`2022-07-01`, string(text), "should return correct value")
+}
+
+func TestDate_MarshalText(t *testing.T) {
+	suite.Run(t, new(DateMarshalTextSuite))
+}
+
+// DateUnmarshalTextSuite tests Date.UnmarshalText.
+type DateUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *DateUnmarshalTextSuite) TestEmpty() {
+	d := NewDate(2022, time.July, 1)
+	err := d.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(d.Valid, "should not be valid")
+}
+
+func (suite *DateUnmarshalTextSuite) TestUnmarshalFail() {
+	var d Date
+	err := d.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *DateUnmarshalTextSuite) TestOK() {
+	var d Date
+	err := d.UnmarshalText([]byte(`2022-07-01`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewDate(2022, time.July, 1), d, "should unmarshal correct value")
+}
+
+func TestDate_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(DateUnmarshalTextSuite))
+}
+
+// DateMarshalYAMLSuite tests Date.MarshalYAML.
+type DateMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *DateMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Date{Year: 2022})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *DateMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewDate(2022, time.July, 1))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("\"2022-07-01\"\n", string(raw), "should return correct value")
+}
+
+func TestDate_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(DateMarshalYAMLSuite))
+}
+
+// DateUnmarshalYAMLSuite tests Date.UnmarshalYAML.
+type DateUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *DateUnmarshalYAMLSuite) TestNull() {
+	d := NewDate(2022, time.July, 1)
+	err := d.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(d.Valid, "should not be valid")
+}
+
+func (suite *DateUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var d Date
+	err := yaml.Unmarshal([]byte(`meow`), &d)
+	suite.Error(err, "should fail")
+}
+
+func (suite *DateUnmarshalYAMLSuite) TestOK() {
+	for _, src := range []string{`2022-07-01`, `"2022-07-01"`} {
+		var d Date
+		err := yaml.Unmarshal([]byte(src), &d)
+		suite.Require().NoErrorf(err, "should not fail for %s", src)
+		suite.Equalf(NewDate(2022, time.July, 1), d, "should unmarshal correct value for %s", src)
+	}
+}
+
+func TestDate_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(DateUnmarshalYAMLSuite))
+}
+
+// DateScanSuite tests Date.Scan.
+type DateScanSuite struct {
+	suite.Suite
+}
+
+func (suite *DateScanSuite) TestNull() {
+	d := NewDate(2022, time.July, 1)
+	err := d.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(d.Valid, "should not be valid")
+}
+
+func (suite *DateScanSuite) TestUnsupported() {
+	var d Date
+	err := d.Scan(16)
+	suite.Error(err, "should fail")
+}
+
+func (suite *DateScanSuite) TestParseFail() {
+	var d Date
+	err := d.Scan("meow")
+	suite.Error(err, "should fail")
+}
+
+func (suite *DateScanSuite) TestOK() {
+	for _, src := range []any{
+		"2022-07-01",
+		[]byte("2022-07-01"),
+		"2022-07-01T00:00:00Z",
+		"2022-07-01 00:00:00",
+		testDate,
+	} {
+		var d Date
+		err := d.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %v", src)
+		suite.Equalf(NewDate(2022, time.July, 1), d, "should scan correct value for %v", src)
+	}
+}
+
+func TestDate_Scan(t *testing.T) {
+	suite.Run(t, new(DateScanSuite))
+}
+
+// DateValueSuite tests Date.Value.
+type DateValueSuite struct {
+	suite.Suite
+}
+
+func (suite *DateValueSuite) TestNull() {
+	raw, err := Date{Year: 2022}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *DateValueSuite) TestOK() {
+	raw, err := NewDate(2022, time.July, 1).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(testDate, raw, "should return correct value")
+}
+
+func TestDate_Value(t *testing.T) {
+	suite.Run(t, new(DateValueSuite))
+}
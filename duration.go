@@ -0,0 +1,338 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"time"
+)
+
+// DurationMode provides the driver.Value representation for ModeDuration.
+// Implementations are usually empty structs.
+type DurationMode interface {
+	// DriverValue returns the given duration as driver.Value.
+	DriverValue(d time.Duration) driver.Value
+}
+
+// DurationNanoseconds is a DurationMode returning durations as int64
+// nanoseconds, for example for BIGINT columns.
+type DurationNanoseconds struct{}
+
+// DriverValue returns the duration as int64 nanoseconds.
+func (DurationNanoseconds) DriverValue(d time.Duration) driver.Value {
+	return int64(d)
+}
+
+// DurationString is a DurationMode returning durations as string like
+// "1h30m0s", for example for text columns.
+type DurationString struct{}
+
+// DriverValue returns the duration as string like "1h30m0s".
+func (DurationString) DriverValue(d time.Duration) driver.Value {
+	return d.String()
+}
+
+// DurationInterval is a DurationMode returning durations as string like
+// "01:30:00" that can be used for PostgreSQL INTERVAL columns.
+type DurationInterval struct{}
+
+// DriverValue returns the duration as interval string like "01:30:00".
+func (DurationInterval) DriverValue(d time.Duration) driver.Value {
+	return formatInterval(d)
+}
+
+// Duration holds a nullable time.Duration that is passed to the database as
+// int64 nanoseconds. Use ModeDuration for other representations.
+type Duration = ModeDuration[DurationNanoseconds]
+
+// ModeDuration holds a nullable time.Duration that is passed to the database as
+// provided by M. Use ModeDuration[DurationString] for text columns or
+// ModeDuration[DurationInterval] for PostgreSQL INTERVAL columns.
+type ModeDuration[M DurationMode] struct {
+	// Duration is the actual value when Valid.
+	Duration time.Duration `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewDuration returns a valid Duration with the given value.
+func NewDuration(d time.Duration) Duration {
+	return NewModeDuration[DurationNanoseconds](d)
+}
+
+// NewModeDuration returns a valid ModeDuration with the given value.
+func NewModeDuration[M DurationMode](d time.Duration) ModeDuration[M] {
+	return ModeDuration[M]{
+		Duration: d,
+		Valid:    true,
+	}
+}
+
+// DurationFromPtr returns a Duration that is valid if the given pointer is not
+// nil.
+func DurationFromPtr(v *time.Duration) Duration {
+	return ModeDurationFromPtr[DurationNanoseconds](v)
+}
+
+// ModeDurationFromPtr returns a ModeDuration that is valid if the given pointer
+// is not nil.
+func ModeDurationFromPtr[M DurationMode](v *time.Duration) ModeDuration[M] {
+	if v == nil {
+		return ModeDuration[M]{}
+	}
+	return NewModeDuration[M](*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (d ModeDuration[M]) Ptr() *time.Duration {
+	if !d.Valid {
+		return nil
+	}
+	v := d.Duration
+	return &v
+}
+
+// DurationFromSQLNull returns a Duration from the given sql.Null.
+func DurationFromSQLNull(v sql.Null[time.Duration]) Duration {
+	return ModeDurationFromSQLNull[DurationNanoseconds](v)
+}
+
+// ModeDurationFromSQLNull returns a ModeDuration from the given sql.Null.
+func ModeDurationFromSQLNull[M DurationMode](v sql.Null[time.Duration]) ModeDuration[M] {
+	return ModeDuration[M]{
+		Duration: v.V,
+		Valid:    v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (d ModeDuration[M]) ToSQLNull() sql.Null[time.Duration] {
+	return sql.Null[time.Duration]{
+		V:     d.Duration,
+		Valid: d.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (d ModeDuration[M]) Get() (time.Duration, bool) {
+	return d.Duration, d.Valid
+}
+
+// IsNull returns true if not valid.
+func (d ModeDuration[M]) IsNull() bool {
+	return !d.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (d *ModeDuration[M]) Set(v time.Duration) {
+	*d = NewModeDuration[M](v)
+}
+
+// SetNull sets a NULL-value.
+func (d *ModeDuration[M]) SetNull() {
+	*d = ModeDuration[M]{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (d ModeDuration[M]) IsZero() bool {
+	return !d.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (d ModeDuration[M]) Equal(other ModeDuration[M]) bool {
+	if !d.Valid || !other.Valid {
+		return d.Valid == other.Valid
+	}
+	return d.Duration == other.Duration
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (d ModeDuration[M]) String() string {
+	return formatString(d.Duration, d.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (d ModeDuration[M]) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, d, d.Duration, d.Valid)
+}
+
+// GoString returns a Go expression creating the ModeDuration.
+func (d ModeDuration[M]) GoString() string {
+	var m M
+	if _, ok := any(m).(DurationNanoseconds); ok {
+		if !d.Valid {
+			return "nulls.Duration{}"
+		}
+		return fmt.Sprintf("nulls.NewDuration(%d)", int64(d.Duration))
+	}
+	if !d.Valid {
+		return fmt.Sprintf("nulls.ModeDuration[%T]{}", m)
+	}
+	return fmt.Sprintf("nulls.NewModeDuration[%T](%d)", m, int64(d.Duration))
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (d ModeDuration[M]) LogValue() slog.Value {
+	if !d.Valid {
+		return logNullValue()
+	}
+	return slog.DurationValue(d.Duration)
+}
+
+// MarshalJSON marshals the duration as string like "1h30m0s". If not valid, a
+// NULL-value is returned.
+func (d ModeDuration[M]) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(d.Duration.String())
+}
+
+// UnmarshalJSON as duration string like "1h30m" or numeric nanoseconds or sets
+// Valid to false if null.
+func (d *ModeDuration[M]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		d.SetNull()
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return err
+		}
+		return d.parse(s)
+	}
+	v, err := unmarshalJSONNumber[time.Duration](data)
+	if err != nil {
+		return err
+	}
+	d.Valid = true
+	d.Duration = time.Duration(v)
+	return nil
+}
+
+// MarshalText marshals the duration as string like "1h30m0s". If not valid,
+// empty text is returned.
+func (d ModeDuration[M]) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.Duration.String()), nil
+}
+
+// UnmarshalText as duration string like "1h30m" or sets Valid to false if
+// empty.
+func (d *ModeDuration[M]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		d.SetNull()
+		return nil
+	}
+	return d.parse(string(text))
+}
+
+// MarshalYAML marshals the duration as string like "1h30m0s". If not valid, a
+// NULL-value is returned.
+func (d ModeDuration[M]) MarshalYAML() (any, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Duration.String(), nil
+}
+
+// UnmarshalYAML as duration string like "1h30m" or integer nanoseconds or sets
+// Valid to false if null.
+func (d *ModeDuration[M]) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		d.SetNull()
+		return nil
+	}
+	if node.Kind == yaml.ScalarNode && node.ShortTag() == "!!int" {
+		v, err := unmarshalYAMLNumber[time.Duration](node)
+		if err != nil {
+			return err
+		}
+		d.Valid = true
+		d.Duration = time.Duration(v)
+		return nil
+	}
+	var s string
+	err := node.Decode(&s)
+	if err != nil {
+		return err
+	}
+	return d.parse(s)
+}
+
+// Scan to duration value or not valid if nil. Integers are scanned as
+// nanoseconds. Strings may hold decimal nanoseconds, duration strings like
+// "1h30m" or PostgreSQL interval text like "1 day 02:30:00". For intervals,
+// years are counted as 365.25 days and months as 30 days.
+func (d *ModeDuration[M]) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return d.scanText(src)
+	case []byte:
+		return d.scanText(string(src))
+	}
+	v, valid, err := scanNumber[time.Duration](src)
+	if err != nil {
+		return err
+	}
+	d.Valid = valid
+	d.Duration = time.Duration(v)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The
+// duration is returned as provided by M.
+func (d ModeDuration[M]) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	var m M
+	return m.DriverValue(d.Duration), nil
+}
+
+// parse parses the given duration string like "1h30m" and sets the Duration
+// valid.
+func (d *ModeDuration[M]) parse(s string) error {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Valid = true
+	d.Duration = v
+	return nil
+}
+
+// scanText parses the given text as decimal nanoseconds, duration string or
+// PostgreSQL interval and sets the Duration valid.
+func (d *ModeDuration[M]) scanText(s string) error {
+	v, err := parseNumber[time.Duration](s)
+	var rangeErr *RangeError
+	if errors.As(err, &rangeErr) {
+		return err
+	}
+	if err == nil {
+		d.Valid = true
+		d.Duration = time.Duration(v)
+		return nil
+	}
+	if d.parse(s) == nil {
+		return nil
+	}
+	interval, err := parseInterval(s)
+	if err != nil {
+		return err
+	}
+	d.Valid = true
+	d.Duration = interval
+	return nil
+}
@@ -0,0 +1,381 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+	"time"
+)
+
+// testDuration is 1h30m.
+const testDuration = 90 * time.Minute
+
+// TestNewDuration tests NewDuration.
+func TestNewDuration(t *testing.T) {
+	d := NewDuration(testDuration)
+	assert.True(t, d.Valid, "should be valid")
+	assert.Equal(t, testDuration, d.Duration, "should have set correct value")
+}
+
+// DurationMarshalJSONSuite tests Duration.MarshalJSON.
+type DurationMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *DurationMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(Duration{Duration: testDuration})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *DurationMarshalJSONSuite) TestOK() {
+	raw, err := json.Marshal(NewDuration(testDuration))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`"1h30m0s"`, string(raw), "should return correct value")
+}
+
+func TestDuration_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(DurationMarshalJSONSuite))
+}
+
+// DurationUnmarshalJSONSuite tests Duration.UnmarshalJSON.
+type DurationUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *DurationUnmarshalJSONSuite) TestNull() {
+	d := NewDuration(testDuration)
+	err := json.Unmarshal(jsonNull, &d)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(d.Valid, "should not be valid")
+}
+
+func (suite *DurationUnmarshalJSONSuite) TestUnmarshalFail() {
+	var d Duration
+	err := json.Unmarshal([]byte(`true`), &d)
+	suite.Error(err, "should fail")
+}
+
+func (suite *DurationUnmarshalJSONSuite) TestParseFail() {
+	var d Duration
+	err := json.Unmarshal([]byte(`"meow"`), &d)
+	suite.Error(err, "should fail")
+}
+
+func (suite *DurationUnmarshalJSONSuite) TestOutOfRange() {
+	var d Duration
+	err := json.Unmarshal([]byte(`9223372036854775808`), &d)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should return range error")
+}
+
+func (suite *DurationUnmarshalJSONSuite) TestString() {
+	var d Duration
+	err := json.Unmarshal([]byte(`"1h30m"`), &d)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(d.Valid, "should be valid")
+	suite.Equal(testDuration, d.Duration, "should unmarshal correct value")
+}
+
+func (suite *DurationUnmarshalJSONSuite) TestNanoseconds() {
+	var d Duration
+	err := json.Unmarshal([]byte(`5400000000000`), &d)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(d.Valid, "should be valid")
+	suite.Equal(testDuration, d.Duration, "should unmarshal correct value")
+}
+
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(DurationUnmarshalJSONSuite))
+}
+
+// DurationMarshalTextSuite tests Duration.MarshalText.
+type DurationMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *DurationMarshalTextSuite) TestNotValid() {
+	text, err := Duration{Duration: testDuration}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *DurationMarshalTextSuite) TestOK() {
+	text, err := NewDuration(testDuration).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`1h30m0s`, string(text), "should return correct value")
+}
+
+func TestDuration_MarshalText(t *testing.T) {
+	suite.Run(t, new(DurationMarshalTextSuite))
+}
+
+// DurationUnmarshalTextSuite tests Duration.UnmarshalText.
+type DurationUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *DurationUnmarshalTextSuite) TestEmpty() {
+	d := NewDuration(testDuration)
+	err := d.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(d.Valid, "should not be valid")
+}
+
+func (suite *DurationUnmarshalTextSuite) TestUnmarshalFail() {
+	var d Duration
+	err := d.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *DurationUnmarshalTextSuite) TestOK() {
+	var d Duration
+	err := d.UnmarshalText([]byte(`1h30m`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(d.Valid, "should be valid")
+	suite.Equal(testDuration, d.Duration, "should unmarshal correct value")
+}
+
+func TestDuration_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(DurationUnmarshalTextSuite))
+}
+
+// DurationMarshalYAMLSuite tests Duration.MarshalYAML.
+type DurationMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *DurationMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Duration{Duration: testDuration})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *DurationMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewDuration(testDuration))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("1h30m0s\n", string(raw), "should return correct value")
+}
+
+func TestDuration_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(DurationMarshalYAMLSuite))
+}
+
+// DurationUnmarshalYAMLSuite tests Duration.UnmarshalYAML.
+type DurationUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *DurationUnmarshalYAMLSuite) TestNull() {
+	d := NewDuration(testDuration)
+	err := d.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(d.Valid, "should not be valid")
+}
+
+func (suite *DurationUnmarshalYAMLSuite) TestUnmarshalFail() {
+	for _, src := range []string{`meow`, `[1]`, `1.5`} {
+		var d Duration
+		err := yaml.Unmarshal([]byte(src), &d)
+		suite.Errorf(err, "should fail for %s", src)
+	}
+}
+
+func (suite *DurationUnmarshalYAMLSuite) TestOK() {
+	for _, src := range []string{`1h30m`, `"1h30m"`, `5400000000000`} {
+		var d Duration
+		err := yaml.Unmarshal([]byte(src), &d)
+		suite.Require().NoErrorf(err, "should not fail for %s", src)
+		suite.Truef(d.Valid, "should be valid for %s", src)
+		suite.Equalf(testDuration, d.Duration, "should unmarshal correct value for %s", src)
+	}
+}
+
+func TestDuration_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(DurationUnmarshalYAMLSuite))
+}
+
+// DurationScanSuite tests Duration.Scan.
+type DurationScanSuite struct {
+	suite.Suite
+}
+
+func (suite *DurationScanSuite) TestNull() {
+	d := NewDuration(testDuration)
+	err := d.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(d.Valid, "should not be valid")
+}
+
+func (suite *DurationScanSuite) TestUnsupported() {
+	var d Duration
+	err := d.Scan(true)
+	suite.Error(err, "should fail")
+}
+
+func (suite *DurationScanSuite) TestParseFail() {
+	var d Duration
+	err := d.Scan("meow")
+	suite.Error(err, "should fail")
+}
+
+func (suite *DurationScanSuite) TestOutOfRange() {
+	for _, src := range []any{"9223372036854775808", "300 years", uint64(1 << 63)} {
+		var d Duration
+		err := d.Scan(src)
+		var rangeErr *RangeError
+		suite.Truef(errors.As(err, &rangeErr), "should return range error for %v", src)
+	}
+}
+
+func (suite *DurationScanSuite) TestOK() {
+	for _, src := range []any{
+		int64(testDuration),
+		"5400000000000",
+		[]byte("5400000000000"),
+		"1h30m",
+		[]byte("1h30m0s"),
+		"01:30:00",
+		[]byte("01:30:00"),
+		"90 mins",
+	} {
+		var d Duration
+		err := d.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %v", src)
+		suite.Truef(d.Valid, "should be valid for %v", src)
+		suite.Equalf(testDuration, d.Duration, "should scan correct value for %v", src)
+	}
+}
+
+func (suite *DurationScanSuite) TestInterval() {
+	var d Duration
+	err := d.Scan("1 day 02:30:00")
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(26*time.Hour+30*time.Minute, d.Duration, "should scan correct value")
+}
+
+func TestDuration_Scan(t *testing.T) {
+	suite.Run(t, new(DurationScanSuite))
+}
+
+// durationSecondsMode is a DurationMode returning seconds as float64.
+type durationSecondsMode struct{}
+
+func (durationSecondsMode) DriverValue(d time.Duration) driver.Value {
+	return d.Seconds()
+}
+
+// DurationValueSuite tests Duration.Value.
+type DurationValueSuite struct {
+	suite.Suite
+}
+
+func (suite *DurationValueSuite) TestNull() {
+	raw, err := Duration{Duration: testDuration}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *DurationValueSuite) TestNanoseconds() {
+	raw, err := NewDuration(testDuration).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(5400000000000), raw, "should return correct value")
+}
+
+func (suite *DurationValueSuite) TestString() {
+	raw, err := NewModeDuration[DurationString](testDuration).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("1h30m0s", raw, "should return correct value")
+}
+
+func (suite *DurationValueSuite) TestInterval() {
+	raw, err := NewModeDuration[DurationInterval](testDuration).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("01:30:00", raw, "should return correct value")
+}
+
+func (suite *DurationValueSuite) TestCustomMode() {
+	raw, err := NewModeDuration[durationSecondsMode](testDuration).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(float64(5400), raw, "should return correct value")
+}
+
+func TestDuration_Value(t *testing.T) {
+	suite.Run(t, new(DurationValueSuite))
+}
+
+// TestDuration_Get tests Duration.Get.
+func TestDuration_Get(t *testing.T) {
+	v, ok := NewDuration(testDuration).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, testDuration, v, "should return correct value")
+	_, ok = Duration{Duration: testDuration}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestDuration_IsZero tests Duration.IsZero.
+func TestDuration_IsZero(t *testing.T) {
+	assert.False(t, NewDuration(0).IsZero(), "should not be zero")
+	assert.True(t, Duration{Duration: testDuration}.IsZero(), "should be zero")
+}
+
+// TestDuration_Equal tests Duration.Equal.
+func TestDuration_Equal(t *testing.T) {
+	d := NewDuration(testDuration)
+	assert.True(t, d.Equal(NewDuration(testDuration)), "should be equal")
+	assert.False(t, d.Equal(NewDuration(time.Hour)), "should not be equal")
+	assert.False(t, d.Equal(Duration{Duration: testDuration}), "should not be equal")
+	assert.True(t, Duration{Duration: testDuration}.Equal(Duration{}), "should be equal")
+}
+
+// TestDuration_String tests Duration.String.
+func TestDuration_String(t *testing.T) {
+	assert.Equal(t, "1h30m0s", NewDuration(testDuration).String(), "should return correct value")
+	assert.Equal(t, NullToken, Duration{}.String(), "should return null token")
+}
+
+// TestDuration_Format tests Duration.Format.
+func TestDuration_Format(t *testing.T) {
+	assert.Equal(t, `"1h30m0s"`, fmt.Sprintf("%q", NewDuration(testDuration)), "should return correct value")
+	assert.Equal(t, "5400000000000", fmt.Sprintf("%d", NewDuration(testDuration)), "should return correct value")
+	assert.Equal(t, "<null>", fmt.Sprintf("%v", Duration{}), "should return null token")
+}
+
+// TestDuration_GoString tests Duration.GoString.
+func TestDuration_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewDuration(5400000000000)", fmt.Sprintf("%#v", NewDuration(testDuration)),
+		"should return correct value")
+	assert.Equal(t, "nulls.Duration{}", Duration{}.GoString(), "should return correct value")
+	assert.Equal(t, "nulls.NewModeDuration[nulls.DurationInterval](5400000000000)",
+		NewModeDuration[DurationInterval](testDuration).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.ModeDuration[nulls.DurationString]{}", ModeDuration[DurationString]{}.GoString(),
+		"should return correct value")
+}
+
+// TestDuration_LogValue tests Duration.LogValue.
+func TestDuration_LogValue(t *testing.T) {
+	assert.Equal(t, testDuration, NewDuration(testDuration).LogValue().Duration(), "should return correct value")
+	assert.Nil(t, Duration{Duration: testDuration}.LogValue().Any(), "should return null value")
+}
+
+// TestDurationFromPtr tests DurationFromPtr and Duration.Ptr.
+func TestDurationFromPtr(t *testing.T) {
+	assert.False(t, DurationFromPtr(nil).Valid, "should not be valid")
+	assert.Nil(t, Duration{Duration: testDuration}.Ptr(), "should return nil")
+	v := testDuration
+	p := DurationFromPtr(&v).Ptr()
+	assert.Equal(t, &v, p, "should return correct value")
+}
+
+// TestDurationFromSQLNull tests DurationFromSQLNull and Duration.ToSQLNull.
+func TestDurationFromSQLNull(t *testing.T) {
+	n := sql.Null[time.Duration]{V: testDuration, Valid: true}
+	assert.Equal(t, NewDuration(testDuration), DurationFromSQLNull(n), "should return correct value")
+	assert.Equal(t, n, NewDuration(testDuration).ToSQLNull(), "should return correct value")
+}
@@ -3,7 +3,9 @@ package nulls
 import (
 	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // Float32 holds a nullable float32.
@@ -22,40 +24,195 @@ func NewFloat32(f float32) Float32 {
 	}
 }
 
-// MarshalJSON marshals the float32. If not valid, a NULL-value is returned.
-func (f Float32) MarshalJSON() ([]byte, error) {
+// Float32FromPtr returns a Float32 that is valid if the given pointer is not
+// nil.
+func Float32FromPtr(v *float32) Float32 {
+	if v == nil {
+		return Float32{}
+	}
+	return NewFloat32(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (f Float32) Ptr() *float32 {
+	if !f.Valid {
+		return nil
+	}
+	v := f.Float32
+	return &v
+}
+
+// Float32FromSQL returns a Float32 from the given sql.NullFloat64.
+func Float32FromSQL(v sql.NullFloat64) Float32 {
+	return Float32{
+		Float32: float32(v.Float64),
+		Valid:   v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullFloat64 representation.
+func (f Float32) ToSQL() sql.NullFloat64 {
+	return sql.NullFloat64{
+		Float64: float64(f.Float32),
+		Valid:   f.Valid,
+	}
+}
+
+// Float32FromSQLNull returns a Float32 from the given sql.Null.
+func Float32FromSQLNull(v sql.Null[float32]) Float32 {
+	return Float32{
+		Float32: v.V,
+		Valid:   v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (f Float32) ToSQLNull() sql.Null[float32] {
+	return sql.Null[float32]{
+		V:     f.Float32,
+		Valid: f.Valid,
+	}
+}
+
+// Float32FromNumber returns a Float32 from the given Number.
+func Float32FromNumber(n Number[float32]) Float32 {
+	return Float32{
+		Float32: n.V,
+		Valid:   n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (f Float32) ToNumber() Number[float32] {
+	return Number[float32]{
+		V:     f.Float32,
+		Valid: f.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (f Float32) Get() (float32, bool) {
+	return f.Float32, f.Valid
+}
+
+// IsNull returns true if not valid.
+func (f Float32) IsNull() bool {
+	return !f.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (f *Float32) Set(v float32) {
+	*f = NewFloat32(v)
+}
+
+// SetNull sets a NULL-value.
+func (f *Float32) SetNull() {
+	*f = Float32{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (f Float32) IsZero() bool {
+	return !f.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (f Float32) Equal(other Float32) bool {
+	if !f.Valid || !other.Valid {
+		return f.Valid == other.Valid
+	}
+	return f.Float32 == other.Float32
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (f Float32) String() string {
+	return formatString(f.Float32, f.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (f Float32) Format(state fmt.State, verb rune) {
+	formatNullable(state, verb, f, f.Float32, f.Valid)
+}
+
+// GoString returns a Go expression creating the Float32.
+func (f Float32) GoString() string {
 	if !f.Valid {
-		return json.Marshal(nil)
+		return "nulls.Float32{}"
 	}
-	return json.Marshal(f.Float32)
+	return fmt.Sprintf("nulls.NewFloat32(%#v)", f.Float32)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (f Float32) LogValue() slog.Value {
+	return f.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the float32. If not valid, a NULL-value is returned.
+func (f Float32) MarshalJSON() ([]byte, error) {
+	return f.ToNumber().MarshalJSON()
 }
 
-// UnmarshalJSON as float32 or sets Valid to false if null.
+// UnmarshalJSON as float32 or sets Valid to false if null. If the value does
+// not fit into float32, a RangeError or PrecisionError is returned.
 func (f *Float32) UnmarshalJSON(data []byte) error {
-	if isNull(data) {
-		f.Valid = false
-		return nil
+	n := f.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
-	f.Valid = true
-	return json.Unmarshal(data, &f.Float32)
+	*f = Float32FromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the float32 as text. If not valid, empty text is
+// returned.
+func (f Float32) MarshalText() ([]byte, error) {
+	return f.ToNumber().MarshalText()
+}
+
+// UnmarshalText as float32 or sets Valid to false if empty. If the value does
+// not fit into float32, a RangeError or PrecisionError is returned.
+func (f *Float32) UnmarshalText(text []byte) error {
+	n := f.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*f = Float32FromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the float32. If not valid, a NULL-value is returned.
+func (f Float32) MarshalYAML() (any, error) {
+	return f.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as float32 or sets Valid to false if null. If the value does
+// not fit into float32, a RangeError or PrecisionError is returned.
+func (f *Float32) UnmarshalYAML(node *yaml.Node) error {
+	n := f.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*f = Float32FromNumber(n)
+	return nil
 }
 
-// Scan to float32 value or not valid if nil.
+// Scan to float32 value or not valid if nil. If the value does not fit into
+// float32, a RangeError or PrecisionError is returned.
 func (f *Float32) Scan(src any) error {
-	var sqlFloat sql.NullFloat64
-	err := sqlFloat.Scan(src)
+	n := f.ToNumber()
+	err := n.Scan(src)
 	if err != nil {
 		return err
 	}
-	f.Valid = sqlFloat.Valid
-	f.Float32 = float32(sqlFloat.Float64)
+	*f = Float32FromNumber(n)
 	return nil
 }
 
 // Value returns the value for satisfying the driver.Valuer interface.
 func (f Float32) Value() (driver.Value, error) {
-	return sql.NullFloat64{
-		Float64: float64(f.Float32),
-		Valid:   f.Valid,
-	}.Value()
+	return f.ToNumber().Value()
 }
@@ -1,9 +1,13 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -61,6 +65,68 @@ func TestFloat32_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(Float32UnmarshalJSONSuite))
 }
 
+// Float32MarshalYAMLSuite tests Float32.MarshalYAML.
+type Float32MarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Float32MarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Float32{Float32: -0.25})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *Float32MarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewFloat32(-0.25))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("-0.25\n", string(raw), "should return correct value")
+}
+
+func TestFloat32_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(Float32MarshalYAMLSuite))
+}
+
+// Float32UnmarshalYAMLSuite tests Float32.UnmarshalYAML.
+type Float32UnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Float32UnmarshalYAMLSuite) TestNull() {
+	v := NewFloat32(-0.25)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Float32UnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Float32 `yaml:"v"`
+		W Float32 `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *Float32UnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Float32
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Float32UnmarshalYAMLSuite) TestOK() {
+	var v Float32
+	err := yaml.Unmarshal([]byte(`-0.25`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(float32(-0.25), v.Float32, "should unmarshal correct value")
+}
+
+func TestFloat32_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(Float32UnmarshalYAMLSuite))
+}
+
 // Float32ScanSuite tests Float32.Scan.
 type Float32ScanSuite struct {
 	suite.Suite
@@ -107,3 +173,254 @@ func (suite *Float32ValueSuite) TestOK() {
 func TestFloat32_Value(t *testing.T) {
 	suite.Run(t, new(Float32ValueSuite))
 }
+
+// TestFloat32_Get tests Float32.Get.
+func TestFloat32_Get(t *testing.T) {
+	v, ok := NewFloat32(16.5).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, float32(16.5), v, "should return correct value")
+	_, ok = Float32{Float32: 16.5}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestFloat32_IsZero tests Float32.IsZero.
+func TestFloat32_IsZero(t *testing.T) {
+	assert.False(t, NewFloat32(16.5).IsZero(), "should not be zero")
+	assert.True(t, Float32{Float32: 16.5}.IsZero(), "should be zero")
+}
+
+// TestFloat32_Equal tests Float32.Equal.
+func TestFloat32_Equal(t *testing.T) {
+	assert.True(t, NewFloat32(16.5).Equal(NewFloat32(16.5)), "should be equal")
+	assert.False(t, NewFloat32(16.5).Equal(NewFloat32(-16.5)), "should not be equal")
+	assert.False(t, NewFloat32(16.5).Equal(Float32{Float32: 16.5}), "should not be equal")
+	assert.False(t, Float32{Float32: 16.5}.Equal(NewFloat32(16.5)), "should not be equal")
+	assert.True(t, Float32{Float32: 16.5}.Equal(Float32{}), "should be equal")
+}
+
+// TestFloat32_String tests Float32.String.
+func TestFloat32_String(t *testing.T) {
+	assert.Equal(t, "16.5", NewFloat32(16.5).String(), "should return correct value")
+	assert.Equal(t, NullToken, Float32{Float32: 16.5}.String(), "should return null token")
+}
+
+// Float32FormatSuite tests Float32.Format.
+type Float32FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *Float32FormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Float32{Float32: 16.5}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Float32{Float32: 16.5}), "should respect width")
+}
+
+func (suite *Float32FormatSuite) TestValue() {
+	suite.Equal("16.5", fmt.Sprintf("%v", NewFloat32(16.5)), "should return correct value")
+}
+
+func (suite *Float32FormatSuite) TestFixedPrecision() {
+	suite.Equal("16.50", fmt.Sprintf("%.2f", NewFloat32(16.5)), "should return correct value")
+}
+
+func (suite *Float32FormatSuite) TestScientific() {
+	suite.Equal("1.650000e+01", fmt.Sprintf("%e", NewFloat32(16.5)), "should return correct value")
+}
+
+func (suite *Float32FormatSuite) TestGoSyntax() {
+	suite.Equal(NewFloat32(16.5).GoString(), fmt.Sprintf("%#v", NewFloat32(16.5)), "should use GoString")
+}
+
+func TestFloat32_Format(t *testing.T) {
+	suite.Run(t, new(Float32FormatSuite))
+}
+
+// TestFloat32_GoString tests Float32.GoString.
+func TestFloat32_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewFloat32(16.5)", NewFloat32(16.5).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Float32{}", Float32{Float32: 16.5}.GoString(), "should return correct value")
+}
+
+// TestFloat32_LogValue tests Float32.LogValue.
+func TestFloat32_LogValue(t *testing.T) {
+	assert.Equal(t, 16.5, NewFloat32(16.5).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Float32{Float32: 16.5}.LogValue().Any(), "should return null value")
+}
+
+// Float32FromPtrSuite tests Float32FromPtr.
+type Float32FromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Float32FromPtrSuite) TestNil() {
+	v := Float32FromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Float32FromPtrSuite) TestZero() {
+	x := float32(0)
+	v := Float32FromPtr(&x)
+	suite.Equal(NewFloat32(x), v, "should return correct value")
+}
+
+func (suite *Float32FromPtrSuite) TestOK() {
+	x := float32(16.5)
+	v := Float32FromPtr(&x)
+	suite.Equal(NewFloat32(x), v, "should return correct value")
+}
+
+func TestFloat32FromPtr(t *testing.T) {
+	suite.Run(t, new(Float32FromPtrSuite))
+}
+
+// Float32PtrSuite tests Float32.Ptr.
+type Float32PtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Float32PtrSuite) TestNotValid() {
+	v := Float32{Float32: float32(16.5)}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *Float32PtrSuite) TestZero() {
+	p := NewFloat32(float32(0)).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(float32(0), *p, "should return correct value")
+}
+
+func (suite *Float32PtrSuite) TestOK() {
+	v := NewFloat32(float32(16.5))
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(float32(16.5), *p, "should return correct value")
+	*p = float32(0)
+	suite.Equal(float32(16.5), v.Float32, "should return copy")
+}
+
+func TestFloat32_Ptr(t *testing.T) {
+	suite.Run(t, new(Float32PtrSuite))
+}
+
+// TestFloat32FromSQL tests Float32FromSQL.
+func TestFloat32FromSQL(t *testing.T) {
+	assert.Equal(t, Float32{}, Float32FromSQL(sql.NullFloat64{}), "should return correct value")
+	assert.Equal(t, NewFloat32(float32(16.5)), Float32FromSQL(sql.NullFloat64{Float64: 16.5, Valid: true}), "should return correct value")
+}
+
+// TestFloat32_ToSQL tests Float32.ToSQL.
+func TestFloat32_ToSQL(t *testing.T) {
+	assert.False(t, Float32{Float32: float32(16.5)}.ToSQL().Valid, "should not be valid")
+	assert.Equal(t, sql.NullFloat64{Float64: 16.5, Valid: true}, NewFloat32(float32(16.5)).ToSQL(), "should return correct value")
+}
+
+// TestFloat32FromSQLNull tests Float32FromSQLNull.
+func TestFloat32FromSQLNull(t *testing.T) {
+	assert.Equal(t, Float32{}, Float32FromSQLNull(sql.Null[float32]{}), "should return correct value")
+	assert.Equal(t, NewFloat32(float32(16.5)), Float32FromSQLNull(sql.Null[float32]{V: float32(16.5), Valid: true}), "should return correct value")
+}
+
+// TestFloat32_ToSQLNull tests Float32.ToSQLNull.
+func TestFloat32_ToSQLNull(t *testing.T) {
+	assert.False(t, Float32{Float32: float32(16.5)}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[float32]{V: float32(16.5), Valid: true}, NewFloat32(float32(16.5)).ToSQLNull(), "should return correct value")
+}
+
+// Float32MarshalTextSuite tests Float32.MarshalText.
+type Float32MarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Float32MarshalTextSuite) TestNotValid() {
+	v := Float32{Float32: 16.5}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *Float32MarshalTextSuite) TestOK() {
+	text, err := NewFloat32(16.5).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`16.5`, string(text), "should return correct value")
+}
+
+func TestFloat32_MarshalText(t *testing.T) {
+	suite.Run(t, new(Float32MarshalTextSuite))
+}
+
+// Float32UnmarshalTextSuite tests Float32.UnmarshalText.
+type Float32UnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Float32UnmarshalTextSuite) TestEmpty() {
+	v := NewFloat32(16.5)
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Float32UnmarshalTextSuite) TestUnmarshalFail() {
+	var v Float32
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *Float32UnmarshalTextSuite) TestOK() {
+	var v Float32
+	err := v.UnmarshalText([]byte(`-0.25`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(float32(-0.25), v.Float32, "should unmarshal correct value")
+}
+
+func TestFloat32_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(Float32UnmarshalTextSuite))
+}
+
+// TestFloat32FromNumber tests Float32FromNumber and Float32.ToNumber.
+func TestFloat32FromNumber(t *testing.T) {
+	assert.Equal(t, Float32{}, Float32FromNumber(Number[float32]{}), "should return correct value")
+	assert.Equal(t, NewFloat32(16), Float32FromNumber(NewNumber[float32](16)), "should return correct value")
+	assert.Equal(t, NewNumber[float32](16), NewFloat32(16).ToNumber(), "should return correct value")
+}
+
+// Float32PrecisionSuite tests range and precision checks of Float32.
+type Float32PrecisionSuite struct {
+	suite.Suite
+}
+
+func (suite *Float32PrecisionSuite) TestShortestDecimal() {
+	var f Float32
+	err := f.Scan(0.1)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewFloat32(0.1), f, "should scan correct value")
+}
+
+func (suite *Float32PrecisionSuite) TestExact() {
+	var f Float32
+	err := f.Scan(float64(float32(0.1)))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewFloat32(0.1), f, "should scan correct value")
+}
+
+func (suite *Float32PrecisionSuite) TestPrecisionLoss() {
+	for _, src := range []any{0.123456789012, "0.123456789012", int64(16777217)} {
+		var f Float32
+		err := f.Scan(src)
+		var precisionErr *PrecisionError
+		suite.Truef(errors.As(err, &precisionErr), "should return precision error for %v", src)
+	}
+}
+
+func (suite *Float32PrecisionSuite) TestOutOfRange() {
+	for _, src := range []any{1e39, "-1e39"} {
+		var f Float32
+		err := f.Scan(src)
+		var rangeErr *RangeError
+		suite.Truef(errors.As(err, &rangeErr), "should return range error for %v", src)
+	}
+}
+
+func TestFloat32_Precision(t *testing.T) {
+	suite.Run(t, new(Float32PrecisionSuite))
+}
@@ -3,7 +3,9 @@ package nulls
 import (
 	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // Float64 holds a nullable float64.
@@ -22,40 +24,195 @@ func NewFloat64(f float64) Float64 {
 	}
 }
 
-// MarshalJSON marshals the float64. If not valid, a NULL-value is returned.
-func (f Float64) MarshalJSON() ([]byte, error) {
+// Float64FromPtr returns a Float64 that is valid if the given pointer is not
+// nil.
+func Float64FromPtr(v *float64) Float64 {
+	if v == nil {
+		return Float64{}
+	}
+	return NewFloat64(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (f Float64) Ptr() *float64 {
+	if !f.Valid {
+		return nil
+	}
+	v := f.Float64
+	return &v
+}
+
+// Float64FromSQL returns a Float64 from the given sql.NullFloat64.
+func Float64FromSQL(v sql.NullFloat64) Float64 {
+	return Float64{
+		Float64: v.Float64,
+		Valid:   v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullFloat64 representation.
+func (f Float64) ToSQL() sql.NullFloat64 {
+	return sql.NullFloat64{
+		Float64: f.Float64,
+		Valid:   f.Valid,
+	}
+}
+
+// Float64FromSQLNull returns a Float64 from the given sql.Null.
+func Float64FromSQLNull(v sql.Null[float64]) Float64 {
+	return Float64{
+		Float64: v.V,
+		Valid:   v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (f Float64) ToSQLNull() sql.Null[float64] {
+	return sql.Null[float64]{
+		V:     f.Float64,
+		Valid: f.Valid,
+	}
+}
+
+// Float64FromNumber returns a Float64 from the given Number.
+func Float64FromNumber(n Number[float64]) Float64 {
+	return Float64{
+		Float64: n.V,
+		Valid:   n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (f Float64) ToNumber() Number[float64] {
+	return Number[float64]{
+		V:     f.Float64,
+		Valid: f.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (f Float64) Get() (float64, bool) {
+	return f.Float64, f.Valid
+}
+
+// IsNull returns true if not valid.
+func (f Float64) IsNull() bool {
+	return !f.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (f *Float64) Set(v float64) {
+	*f = NewFloat64(v)
+}
+
+// SetNull sets a NULL-value.
+func (f *Float64) SetNull() {
+	*f = Float64{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (f Float64) IsZero() bool {
+	return !f.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (f Float64) Equal(other Float64) bool {
+	if !f.Valid || !other.Valid {
+		return f.Valid == other.Valid
+	}
+	return f.Float64 == other.Float64
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (f Float64) String() string {
+	return formatString(f.Float64, f.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (f Float64) Format(state fmt.State, verb rune) {
+	formatNullable(state, verb, f, f.Float64, f.Valid)
+}
+
+// GoString returns a Go expression creating the Float64.
+func (f Float64) GoString() string {
 	if !f.Valid {
-		return json.Marshal(nil)
+		return "nulls.Float64{}"
 	}
-	return json.Marshal(f.Float64)
+	return fmt.Sprintf("nulls.NewFloat64(%#v)", f.Float64)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (f Float64) LogValue() slog.Value {
+	return f.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the float64. If not valid, a NULL-value is returned.
+func (f Float64) MarshalJSON() ([]byte, error) {
+	return f.ToNumber().MarshalJSON()
 }
 
-// UnmarshalJSON as float64 or sets Valid to false if null.
+// UnmarshalJSON as float64 or sets Valid to false if null. If the value does
+// not fit into float64, a RangeError or PrecisionError is returned.
 func (f *Float64) UnmarshalJSON(data []byte) error {
-	if isNull(data) {
-		f.Valid = false
-		return nil
+	n := f.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
-	f.Valid = true
-	return json.Unmarshal(data, &f.Float64)
+	*f = Float64FromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the float64 as text. If not valid, empty text is
+// returned.
+func (f Float64) MarshalText() ([]byte, error) {
+	return f.ToNumber().MarshalText()
+}
+
+// UnmarshalText as float64 or sets Valid to false if empty. If the value does
+// not fit into float64, a RangeError or PrecisionError is returned.
+func (f *Float64) UnmarshalText(text []byte) error {
+	n := f.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*f = Float64FromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the float64. If not valid, a NULL-value is returned.
+func (f Float64) MarshalYAML() (any, error) {
+	return f.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as float64 or sets Valid to false if null. If the value does
+// not fit into float64, a RangeError or PrecisionError is returned.
+func (f *Float64) UnmarshalYAML(node *yaml.Node) error {
+	n := f.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*f = Float64FromNumber(n)
+	return nil
 }
 
-// Scan to float64 value or not valid if nil.
+// Scan to float64 value or not valid if nil. If the value does not fit into
+// float64, a RangeError or PrecisionError is returned.
 func (f *Float64) Scan(src any) error {
-	var sqlFloat sql.NullFloat64
-	err := sqlFloat.Scan(src)
+	n := f.ToNumber()
+	err := n.Scan(src)
 	if err != nil {
 		return err
 	}
-	f.Valid = sqlFloat.Valid
-	f.Float64 = sqlFloat.Float64
+	*f = Float64FromNumber(n)
 	return nil
 }
 
 // Value returns the value for satisfying the driver.Valuer interface.
 func (f Float64) Value() (driver.Value, error) {
-	return sql.NullFloat64{
-		Float64: f.Float64,
-		Valid:   f.Valid,
-	}.Value()
+	return f.ToNumber().Value()
 }
@@ -1,9 +1,12 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -61,6 +64,68 @@ func TestFloat64_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(Float64UnmarshalJSONSuite))
 }
 
+// Float64MarshalYAMLSuite tests Float64.MarshalYAML.
+type Float64MarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Float64MarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Float64{Float64: -0.25})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *Float64MarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewFloat64(-0.25))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("-0.25\n", string(raw), "should return correct value")
+}
+
+func TestFloat64_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(Float64MarshalYAMLSuite))
+}
+
+// Float64UnmarshalYAMLSuite tests Float64.UnmarshalYAML.
+type Float64UnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Float64UnmarshalYAMLSuite) TestNull() {
+	v := NewFloat64(-0.25)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Float64UnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Float64 `yaml:"v"`
+		W Float64 `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *Float64UnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Float64
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Float64UnmarshalYAMLSuite) TestOK() {
+	var v Float64
+	err := yaml.Unmarshal([]byte(`-0.25`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(-0.25, v.Float64, "should unmarshal correct value")
+}
+
+func TestFloat64_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(Float64UnmarshalYAMLSuite))
+}
+
 // Float64ScanSuite tests Float64.Scan.
 type Float64ScanSuite struct {
 	suite.Suite
@@ -107,3 +172,213 @@ func (suite *Float64ValueSuite) TestOK() {
 func TestFloat64_Value(t *testing.T) {
 	suite.Run(t, new(Float64ValueSuite))
 }
+
+// TestFloat64_Get tests Float64.Get.
+func TestFloat64_Get(t *testing.T) {
+	v, ok := NewFloat64(16.5).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, 16.5, v, "should return correct value")
+	_, ok = Float64{Float64: 16.5}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestFloat64_IsZero tests Float64.IsZero.
+func TestFloat64_IsZero(t *testing.T) {
+	assert.False(t, NewFloat64(16.5).IsZero(), "should not be zero")
+	assert.True(t, Float64{Float64: 16.5}.IsZero(), "should be zero")
+}
+
+// TestFloat64_Equal tests Float64.Equal.
+func TestFloat64_Equal(t *testing.T) {
+	assert.True(t, NewFloat64(16.5).Equal(NewFloat64(16.5)), "should be equal")
+	assert.False(t, NewFloat64(16.5).Equal(NewFloat64(-16.5)), "should not be equal")
+	assert.False(t, NewFloat64(16.5).Equal(Float64{Float64: 16.5}), "should not be equal")
+	assert.False(t, Float64{Float64: 16.5}.Equal(NewFloat64(16.5)), "should not be equal")
+	assert.True(t, Float64{Float64: 16.5}.Equal(Float64{}), "should be equal")
+}
+
+// TestFloat64_String tests Float64.String.
+func TestFloat64_String(t *testing.T) {
+	assert.Equal(t, "16.5", NewFloat64(16.5).String(), "should return correct value")
+	assert.Equal(t, NullToken, Float64{Float64: 16.5}.String(), "should return null token")
+}
+
+// Float64FormatSuite tests Float64.Format.
+type Float64FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *Float64FormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Float64{Float64: 16.5}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Float64{Float64: 16.5}), "should respect width")
+}
+
+func (suite *Float64FormatSuite) TestValue() {
+	suite.Equal("16.5", fmt.Sprintf("%v", NewFloat64(16.5)), "should return correct value")
+}
+
+func (suite *Float64FormatSuite) TestFixedPrecision() {
+	suite.Equal("16.50", fmt.Sprintf("%.2f", NewFloat64(16.5)), "should return correct value")
+}
+
+func (suite *Float64FormatSuite) TestScientific() {
+	suite.Equal("1.650000e+01", fmt.Sprintf("%e", NewFloat64(16.5)), "should return correct value")
+}
+
+func (suite *Float64FormatSuite) TestGoSyntax() {
+	suite.Equal(NewFloat64(16.5).GoString(), fmt.Sprintf("%#v", NewFloat64(16.5)), "should use GoString")
+}
+
+func TestFloat64_Format(t *testing.T) {
+	suite.Run(t, new(Float64FormatSuite))
+}
+
+// TestFloat64_GoString tests Float64.GoString.
+func TestFloat64_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewFloat64(16.5)", NewFloat64(16.5).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Float64{}", Float64{Float64: 16.5}.GoString(), "should return correct value")
+}
+
+// TestFloat64_LogValue tests Float64.LogValue.
+func TestFloat64_LogValue(t *testing.T) {
+	assert.Equal(t, 16.5, NewFloat64(16.5).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Float64{Float64: 16.5}.LogValue().Any(), "should return null value")
+}
+
+// Float64FromPtrSuite tests Float64FromPtr.
+type Float64FromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Float64FromPtrSuite) TestNil() {
+	v := Float64FromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Float64FromPtrSuite) TestZero() {
+	x := float64(0)
+	v := Float64FromPtr(&x)
+	suite.Equal(NewFloat64(x), v, "should return correct value")
+}
+
+func (suite *Float64FromPtrSuite) TestOK() {
+	x := 16.5
+	v := Float64FromPtr(&x)
+	suite.Equal(NewFloat64(x), v, "should return correct value")
+}
+
+func TestFloat64FromPtr(t *testing.T) {
+	suite.Run(t, new(Float64FromPtrSuite))
+}
+
+// Float64PtrSuite tests Float64.Ptr.
+type Float64PtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Float64PtrSuite) TestNotValid() {
+	v := Float64{Float64: 16.5}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *Float64PtrSuite) TestZero() {
+	p := NewFloat64(float64(0)).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(float64(0), *p, "should return correct value")
+}
+
+func (suite *Float64PtrSuite) TestOK() {
+	v := NewFloat64(16.5)
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(16.5, *p, "should return correct value")
+	*p = float64(0)
+	suite.Equal(16.5, v.Float64, "should return copy")
+}
+
+func TestFloat64_Ptr(t *testing.T) {
+	suite.Run(t, new(Float64PtrSuite))
+}
+
+// TestFloat64FromSQL tests Float64FromSQL.
+func TestFloat64FromSQL(t *testing.T) {
+	assert.Equal(t, Float64{}, Float64FromSQL(sql.NullFloat64{}), "should return correct value")
+	assert.Equal(t, NewFloat64(16.5), Float64FromSQL(sql.NullFloat64{Float64: 16.5, Valid: true}), "should return correct value")
+}
+
+// TestFloat64_ToSQL tests Float64.ToSQL.
+func TestFloat64_ToSQL(t *testing.T) {
+	assert.False(t, Float64{Float64: 16.5}.ToSQL().Valid, "should not be valid")
+	assert.Equal(t, sql.NullFloat64{Float64: 16.5, Valid: true}, NewFloat64(16.5).ToSQL(), "should return correct value")
+}
+
+// TestFloat64FromSQLNull tests Float64FromSQLNull.
+func TestFloat64FromSQLNull(t *testing.T) {
+	assert.Equal(t, Float64{}, Float64FromSQLNull(sql.Null[float64]{}), "should return correct value")
+	assert.Equal(t, NewFloat64(16.5), Float64FromSQLNull(sql.Null[float64]{V: 16.5, Valid: true}), "should return correct value")
+}
+
+// TestFloat64_ToSQLNull tests Float64.ToSQLNull.
+func TestFloat64_ToSQLNull(t *testing.T) {
+	assert.False(t, Float64{Float64: 16.5}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[float64]{V: 16.5, Valid: true}, NewFloat64(16.5).ToSQLNull(), "should return correct value")
+}
+
+// Float64MarshalTextSuite tests Float64.MarshalText.
+type Float64MarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Float64MarshalTextSuite) TestNotValid() {
+	v := Float64{Float64: 16.5}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *Float64MarshalTextSuite) TestOK() {
+	text, err := NewFloat64(16.5).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`16.5`, string(text), "should return correct value")
+}
+
+func TestFloat64_MarshalText(t *testing.T) {
+	suite.Run(t, new(Float64MarshalTextSuite))
+}
+
+// Float64UnmarshalTextSuite tests Float64.UnmarshalText.
+type Float64UnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Float64UnmarshalTextSuite) TestEmpty() {
+	v := NewFloat64(16.5)
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Float64UnmarshalTextSuite) TestUnmarshalFail() {
+	var v Float64
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *Float64UnmarshalTextSuite) TestOK() {
+	var v Float64
+	err := v.UnmarshalText([]byte(`-0.25`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(-0.25, v.Float64, "should unmarshal correct value")
+}
+
+func TestFloat64_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(Float64UnmarshalTextSuite))
+}
+
+// TestFloat64FromNumber tests Float64FromNumber and Float64.ToNumber.
+func TestFloat64FromNumber(t *testing.T) {
+	assert.Equal(t, Float64{}, Float64FromNumber(Number[float64]{}), "should return correct value")
+	assert.Equal(t, NewFloat64(16), Float64FromNumber(NewNumber[float64](16)), "should return correct value")
+	assert.Equal(t, NewNumber[float64](16), NewFloat64(16).ToNumber(), "should return correct value")
+}
@@ -0,0 +1,45 @@
+package nulls
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// NullToken is used by String and Format for representing NULL-values.
+var NullToken = "<null>"
+
+// goStringer is implemented by all types of this package.
+type goStringer interface {
+	GoString() string
+}
+
+// formatString returns the given value formatted with fmt.Sprint if valid or
+// NullToken otherwise.
+func formatString(v any, valid bool) string {
+	if !valid {
+		return NullToken
+	}
+	return fmt.Sprint(v)
+}
+
+// formatNullable writes the given value to f using the given verb if valid. If
+// not valid, NullToken is written with respect to width and the minus flag.
+// For %#v, the GoString of n is written.
+func formatNullable(f fmt.State, verb rune, n goStringer, v any, valid bool) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		_, _ = io.WriteString(f, n.GoString())
+	case !valid:
+		format := "%"
+		if f.Flag('-') {
+			format += "-"
+		}
+		if width, ok := f.Width(); ok {
+			format += strconv.Itoa(width)
+		}
+		_, _ = fmt.Fprintf(f, format+"s", NullToken)
+	default:
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), v)
+	}
+}
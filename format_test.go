@@ -0,0 +1,16 @@
+package nulls
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestNullToken tests changing NullToken.
+func TestNullToken(t *testing.T) {
+	defer func(token string) { NullToken = token }(NullToken)
+	NullToken = "NULL"
+	assert.Equal(t, "NULL", Int{}.String(), "should use null token")
+	assert.Equal(t, "NULL  |", fmt.Sprintf("%-6v|", String{}), "should use null token")
+	assert.Equal(t, "[1 NULL]", fmt.Sprint([]Int{NewInt(1), {}}), "should use null token")
+}
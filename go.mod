@@ -1,15 +1,16 @@
 module github.com/lefinal/nulls
 
-go 1.18
+go 1.22
 
 require (
 	github.com/gofrs/uuid v4.2.0+incompatible
+	github.com/google/uuid v1.6.0
 	github.com/stretchr/testify v1.7.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/objx v0.1.0 // indirect
-	gopkg.in/yaml.v3 v3.0.0 // indirect
 )
@@ -3,7 +3,9 @@ package nulls
 import (
 	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // Int holds a nullable int.
@@ -22,40 +24,194 @@ func NewInt(i int) Int {
 	}
 }
 
-// MarshalJSON marshals the int. If not valid, a NULL-value is returned.
-func (i Int) MarshalJSON() ([]byte, error) {
+// IntFromPtr returns an Int that is valid if the given pointer is not nil.
+func IntFromPtr(v *int) Int {
+	if v == nil {
+		return Int{}
+	}
+	return NewInt(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (i Int) Ptr() *int {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Int
+	return &v
+}
+
+// IntFromSQL returns an Int from the given sql.NullInt64.
+func IntFromSQL(v sql.NullInt64) Int {
+	return Int{
+		Int:   int(v.Int64),
+		Valid: v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullInt64 representation.
+func (i Int) ToSQL() sql.NullInt64 {
+	return sql.NullInt64{
+		Int64: int64(i.Int),
+		Valid: i.Valid,
+	}
+}
+
+// IntFromSQLNull returns an Int from the given sql.Null.
+func IntFromSQLNull(v sql.Null[int]) Int {
+	return Int{
+		Int:   v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (i Int) ToSQLNull() sql.Null[int] {
+	return sql.Null[int]{
+		V:     i.Int,
+		Valid: i.Valid,
+	}
+}
+
+// IntFromNumber returns an Int from the given Number.
+func IntFromNumber(n Number[int]) Int {
+	return Int{
+		Int:   n.V,
+		Valid: n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (i Int) ToNumber() Number[int] {
+	return Number[int]{
+		V:     i.Int,
+		Valid: i.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (i Int) Get() (int, bool) {
+	return i.Int, i.Valid
+}
+
+// IsNull returns true if not valid.
+func (i Int) IsNull() bool {
+	return !i.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (i *Int) Set(v int) {
+	*i = NewInt(v)
+}
+
+// SetNull sets a NULL-value.
+func (i *Int) SetNull() {
+	*i = Int{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (i Int) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (i Int) Equal(other Int) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+	return i.Int == other.Int
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (i Int) String() string {
+	return formatString(i.Int, i.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (i Int) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, i, i.Int, i.Valid)
+}
+
+// GoString returns a Go expression creating the Int.
+func (i Int) GoString() string {
 	if !i.Valid {
-		return json.Marshal(nil)
+		return "nulls.Int{}"
 	}
-	return json.Marshal(i.Int)
+	return fmt.Sprintf("nulls.NewInt(%#v)", i.Int)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (i Int) LogValue() slog.Value {
+	return i.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the int. If not valid, a NULL-value is returned.
+func (i Int) MarshalJSON() ([]byte, error) {
+	return i.ToNumber().MarshalJSON()
 }
 
-// UnmarshalJSON as int or sets Valid to false if null.
+// UnmarshalJSON as int or sets Valid to false if null. If the value does not
+// fit into int, a RangeError or PrecisionError is returned.
 func (i *Int) UnmarshalJSON(data []byte) error {
-	if isNull(data) {
-		i.Valid = false
-		return nil
+	n := i.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
-	i.Valid = true
-	return json.Unmarshal(data, &i.Int)
+	*i = IntFromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the int as decimal text. If not valid, empty text is
+// returned.
+func (i Int) MarshalText() ([]byte, error) {
+	return i.ToNumber().MarshalText()
+}
+
+// UnmarshalText as decimal int or sets Valid to false if empty. If the value
+// does not fit into int, a RangeError or PrecisionError is returned.
+func (i *Int) UnmarshalText(text []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*i = IntFromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the int. If not valid, a NULL-value is returned.
+func (i Int) MarshalYAML() (any, error) {
+	return i.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as int or sets Valid to false if null. If the value does not
+// fit into int, a RangeError or PrecisionError is returned.
+func (i *Int) UnmarshalYAML(node *yaml.Node) error {
+	n := i.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*i = IntFromNumber(n)
+	return nil
 }
 
-// Scan to int value or not valid if nil.
+// Scan to int value or not valid if nil. If the value does not fit into int, a
+// RangeError or PrecisionError is returned.
 func (i *Int) Scan(src any) error {
-	var sqlInt sql.NullInt64
-	err := sqlInt.Scan(src)
+	n := i.ToNumber()
+	err := n.Scan(src)
 	if err != nil {
 		return err
 	}
-	i.Valid = sqlInt.Valid
-	i.Int = int(sqlInt.Int64)
+	*i = IntFromNumber(n)
 	return nil
 }
 
 // Value returns the value for satisfying the driver.Valuer interface.
 func (i Int) Value() (driver.Value, error) {
-	return sql.NullInt64{
-		Int64: int64(i.Int),
-		Valid: i.Valid,
-	}.Value()
+	return i.ToNumber().Value()
 }
@@ -3,7 +3,9 @@ package nulls
 import (
 	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // Int16 holds a nullable int16.
@@ -22,40 +24,194 @@ func NewInt16(i int16) Int16 {
 	}
 }
 
-// MarshalJSON marshals the int. If not valid, a NULL-value is returned.
-func (i Int16) MarshalJSON() ([]byte, error) {
+// Int16FromPtr returns an Int16 that is valid if the given pointer is not nil.
+func Int16FromPtr(v *int16) Int16 {
+	if v == nil {
+		return Int16{}
+	}
+	return NewInt16(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (i Int16) Ptr() *int16 {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Int16
+	return &v
+}
+
+// Int16FromSQL returns an Int16 from the given sql.NullInt16.
+func Int16FromSQL(v sql.NullInt16) Int16 {
+	return Int16{
+		Int16: v.Int16,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullInt16 representation.
+func (i Int16) ToSQL() sql.NullInt16 {
+	return sql.NullInt16{
+		Int16: i.Int16,
+		Valid: i.Valid,
+	}
+}
+
+// Int16FromSQLNull returns an Int16 from the given sql.Null.
+func Int16FromSQLNull(v sql.Null[int16]) Int16 {
+	return Int16{
+		Int16: v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (i Int16) ToSQLNull() sql.Null[int16] {
+	return sql.Null[int16]{
+		V:     i.Int16,
+		Valid: i.Valid,
+	}
+}
+
+// Int16FromNumber returns an Int16 from the given Number.
+func Int16FromNumber(n Number[int16]) Int16 {
+	return Int16{
+		Int16: n.V,
+		Valid: n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (i Int16) ToNumber() Number[int16] {
+	return Number[int16]{
+		V:     i.Int16,
+		Valid: i.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (i Int16) Get() (int16, bool) {
+	return i.Int16, i.Valid
+}
+
+// IsNull returns true if not valid.
+func (i Int16) IsNull() bool {
+	return !i.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (i *Int16) Set(v int16) {
+	*i = NewInt16(v)
+}
+
+// SetNull sets a NULL-value.
+func (i *Int16) SetNull() {
+	*i = Int16{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (i Int16) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (i Int16) Equal(other Int16) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+	return i.Int16 == other.Int16
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (i Int16) String() string {
+	return formatString(i.Int16, i.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (i Int16) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, i, i.Int16, i.Valid)
+}
+
+// GoString returns a Go expression creating the Int16.
+func (i Int16) GoString() string {
 	if !i.Valid {
-		return json.Marshal(nil)
+		return "nulls.Int16{}"
 	}
-	return json.Marshal(i.Int16)
+	return fmt.Sprintf("nulls.NewInt16(%#v)", i.Int16)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (i Int16) LogValue() slog.Value {
+	return i.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the int16. If not valid, a NULL-value is returned.
+func (i Int16) MarshalJSON() ([]byte, error) {
+	return i.ToNumber().MarshalJSON()
 }
 
-// UnmarshalJSON as int or sets Valid to false if null.
+// UnmarshalJSON as int16 or sets Valid to false if null. If the value does not
+// fit into int16, a RangeError or PrecisionError is returned.
 func (i *Int16) UnmarshalJSON(data []byte) error {
-	if isNull(data) {
-		i.Valid = false
-		return nil
+	n := i.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
-	i.Valid = true
-	return json.Unmarshal(data, &i.Int16)
+	*i = Int16FromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the int16 as decimal text. If not valid, empty text is
+// returned.
+func (i Int16) MarshalText() ([]byte, error) {
+	return i.ToNumber().MarshalText()
+}
+
+// UnmarshalText as decimal int16 or sets Valid to false if empty. If the value
+// does not fit into int16, a RangeError or PrecisionError is returned.
+func (i *Int16) UnmarshalText(text []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*i = Int16FromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the int16. If not valid, a NULL-value is returned.
+func (i Int16) MarshalYAML() (any, error) {
+	return i.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as int16 or sets Valid to false if null. If the value does not
+// fit into int16, a RangeError or PrecisionError is returned.
+func (i *Int16) UnmarshalYAML(node *yaml.Node) error {
+	n := i.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*i = Int16FromNumber(n)
+	return nil
 }
 
-// Scan to int value or not valid if nil.
+// Scan to int16 value or not valid if nil. If the value does not fit into
+// int16, a RangeError or PrecisionError is returned.
 func (i *Int16) Scan(src any) error {
-	var sqlInt16 sql.NullInt16
-	err := sqlInt16.Scan(src)
+	n := i.ToNumber()
+	err := n.Scan(src)
 	if err != nil {
 		return err
 	}
-	i.Valid = sqlInt16.Valid
-	i.Int16 = sqlInt16.Int16
+	*i = Int16FromNumber(n)
 	return nil
 }
 
 // Value returns the value for satisfying the driver.Valuer interface.
 func (i Int16) Value() (driver.Value, error) {
-	return sql.NullInt16{
-		Int16: i.Int16,
-		Valid: i.Valid,
-	}.Value()
+	return i.ToNumber().Value()
 }
@@ -1,9 +1,13 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -57,10 +61,92 @@ func (suite *Int16UnmarshalJSONSuite) TestOK() {
 	suite.EqualValues(16, i.Int16, "should unmarshal correct value")
 }
 
+func (suite *Int16UnmarshalJSONSuite) TestOutOfRange() {
+	var i Int16
+	err := json.Unmarshal([]byte("32768"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int16UnmarshalJSONSuite) TestInvalid() {
+	var i Int16
+	err := json.Unmarshal(marshalMust("meow"), &i)
+	suite.Error(err, "should fail")
+}
+
 func TestInt16_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(Int16UnmarshalJSONSuite))
 }
 
+// Int16MarshalYAMLSuite tests Int16.MarshalYAML.
+type Int16MarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Int16MarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Int16{Int16: -16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *Int16MarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewInt16(-16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("-16\n", string(raw), "should return correct value")
+}
+
+func TestInt16_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(Int16MarshalYAMLSuite))
+}
+
+// Int16UnmarshalYAMLSuite tests Int16.UnmarshalYAML.
+type Int16UnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Int16UnmarshalYAMLSuite) TestNull() {
+	v := NewInt16(-16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int16UnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Int16 `yaml:"v"`
+		W Int16 `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *Int16UnmarshalYAMLSuite) TestOutOfRange() {
+	var v Int16
+	err := yaml.Unmarshal([]byte(`70000`), &v)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int16UnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Int16
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Int16UnmarshalYAMLSuite) TestOK() {
+	var v Int16
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(int16(42), v.Int16, "should unmarshal correct value")
+}
+
+func TestInt16_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(Int16UnmarshalYAMLSuite))
+}
+
 // Int16ScanSuite tests Int16.Scan.
 type Int16ScanSuite struct {
 	suite.Suite
@@ -81,6 +167,21 @@ func (suite *Int16ScanSuite) TestOK() {
 	suite.EqualValues(16, i.Int16, "should scan correct value")
 }
 
+func (suite *Int16ScanSuite) TestOutOfRange() {
+	var i Int16
+	err := i.Scan(int64(-32769))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int16ScanSuite) TestString() {
+	var i Int16
+	err := i.Scan([]byte("16"))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Int16, "should scan correct value")
+}
+
 func TestInt16_Scan(t *testing.T) {
 	suite.Run(t, new(Int16ScanSuite))
 }
@@ -107,3 +208,213 @@ func (suite *Int16ValueSuite) TestOK() {
 func TestInt16_Value(t *testing.T) {
 	suite.Run(t, new(Int16ValueSuite))
 }
+
+// TestInt16_Get tests Int16.Get.
+func TestInt16_Get(t *testing.T) {
+	v, ok := NewInt16(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, int16(16), v, "should return correct value")
+	_, ok = Int16{Int16: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestInt16_IsZero tests Int16.IsZero.
+func TestInt16_IsZero(t *testing.T) {
+	assert.False(t, NewInt16(16).IsZero(), "should not be zero")
+	assert.True(t, Int16{Int16: 16}.IsZero(), "should be zero")
+}
+
+// TestInt16_Equal tests Int16.Equal.
+func TestInt16_Equal(t *testing.T) {
+	assert.True(t, NewInt16(16).Equal(NewInt16(16)), "should be equal")
+	assert.False(t, NewInt16(16).Equal(NewInt16(17)), "should not be equal")
+	assert.False(t, NewInt16(16).Equal(Int16{Int16: 16}), "should not be equal")
+	assert.False(t, Int16{Int16: 16}.Equal(NewInt16(16)), "should not be equal")
+	assert.True(t, Int16{Int16: 16}.Equal(Int16{}), "should be equal")
+}
+
+// TestInt16_String tests Int16.String.
+func TestInt16_String(t *testing.T) {
+	assert.Equal(t, "16", NewInt16(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Int16{Int16: 16}.String(), "should return null token")
+}
+
+// Int16FormatSuite tests Int16.Format.
+type Int16FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *Int16FormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Int16{Int16: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Int16{Int16: 16}), "should respect width")
+}
+
+func (suite *Int16FormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewInt16(16)), "should return correct value")
+}
+
+func (suite *Int16FormatSuite) TestPaddedDecimal() {
+	suite.Equal("00016", fmt.Sprintf("%05d", NewInt16(16)), "should return correct value")
+}
+
+func (suite *Int16FormatSuite) TestHex() {
+	suite.Equal("10", fmt.Sprintf("%x", NewInt16(16)), "should return correct value")
+}
+
+func (suite *Int16FormatSuite) TestGoSyntax() {
+	suite.Equal(NewInt16(16).GoString(), fmt.Sprintf("%#v", NewInt16(16)), "should use GoString")
+}
+
+func TestInt16_Format(t *testing.T) {
+	suite.Run(t, new(Int16FormatSuite))
+}
+
+// TestInt16_GoString tests Int16.GoString.
+func TestInt16_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewInt16(16)", NewInt16(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Int16{}", Int16{Int16: 16}.GoString(), "should return correct value")
+}
+
+// TestInt16_LogValue tests Int16.LogValue.
+func TestInt16_LogValue(t *testing.T) {
+	assert.Equal(t, int64(16), NewInt16(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Int16{Int16: 16}.LogValue().Any(), "should return null value")
+}
+
+// Int16FromPtrSuite tests Int16FromPtr.
+type Int16FromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Int16FromPtrSuite) TestNil() {
+	v := Int16FromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int16FromPtrSuite) TestZero() {
+	x := int16(0)
+	v := Int16FromPtr(&x)
+	suite.Equal(NewInt16(x), v, "should return correct value")
+}
+
+func (suite *Int16FromPtrSuite) TestOK() {
+	x := int16(16)
+	v := Int16FromPtr(&x)
+	suite.Equal(NewInt16(x), v, "should return correct value")
+}
+
+func TestInt16FromPtr(t *testing.T) {
+	suite.Run(t, new(Int16FromPtrSuite))
+}
+
+// Int16PtrSuite tests Int16.Ptr.
+type Int16PtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Int16PtrSuite) TestNotValid() {
+	v := Int16{Int16: int16(16)}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *Int16PtrSuite) TestZero() {
+	p := NewInt16(int16(0)).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(int16(0), *p, "should return correct value")
+}
+
+func (suite *Int16PtrSuite) TestOK() {
+	v := NewInt16(int16(16))
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(int16(16), *p, "should return correct value")
+	*p = int16(0)
+	suite.Equal(int16(16), v.Int16, "should return copy")
+}
+
+func TestInt16_Ptr(t *testing.T) {
+	suite.Run(t, new(Int16PtrSuite))
+}
+
+// TestInt16FromSQL tests Int16FromSQL.
+func TestInt16FromSQL(t *testing.T) {
+	assert.Equal(t, Int16{}, Int16FromSQL(sql.NullInt16{}), "should return correct value")
+	assert.Equal(t, NewInt16(int16(16)), Int16FromSQL(sql.NullInt16{Int16: 16, Valid: true}), "should return correct value")
+}
+
+// TestInt16_ToSQL tests Int16.ToSQL.
+func TestInt16_ToSQL(t *testing.T) {
+	assert.False(t, Int16{Int16: int16(16)}.ToSQL().Valid, "should not be valid")
+	assert.Equal(t, sql.NullInt16{Int16: 16, Valid: true}, NewInt16(int16(16)).ToSQL(), "should return correct value")
+}
+
+// TestInt16FromSQLNull tests Int16FromSQLNull.
+func TestInt16FromSQLNull(t *testing.T) {
+	assert.Equal(t, Int16{}, Int16FromSQLNull(sql.Null[int16]{}), "should return correct value")
+	assert.Equal(t, NewInt16(int16(16)), Int16FromSQLNull(sql.Null[int16]{V: int16(16), Valid: true}), "should return correct value")
+}
+
+// TestInt16_ToSQLNull tests Int16.ToSQLNull.
+func TestInt16_ToSQLNull(t *testing.T) {
+	assert.False(t, Int16{Int16: int16(16)}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[int16]{V: int16(16), Valid: true}, NewInt16(int16(16)).ToSQLNull(), "should return correct value")
+}
+
+// Int16MarshalTextSuite tests Int16.MarshalText.
+type Int16MarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Int16MarshalTextSuite) TestNotValid() {
+	v := Int16{Int16: -16}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *Int16MarshalTextSuite) TestOK() {
+	text, err := NewInt16(-16).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`-16`, string(text), "should return correct value")
+}
+
+func TestInt16_MarshalText(t *testing.T) {
+	suite.Run(t, new(Int16MarshalTextSuite))
+}
+
+// Int16UnmarshalTextSuite tests Int16.UnmarshalText.
+type Int16UnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Int16UnmarshalTextSuite) TestEmpty() {
+	v := NewInt16(-16)
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int16UnmarshalTextSuite) TestUnmarshalFail() {
+	var v Int16
+	err := v.UnmarshalText([]byte(`70000`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *Int16UnmarshalTextSuite) TestOK() {
+	var v Int16
+	err := v.UnmarshalText([]byte(`42`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(int16(42), v.Int16, "should unmarshal correct value")
+}
+
+func TestInt16_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(Int16UnmarshalTextSuite))
+}
+
+// TestInt16FromNumber tests Int16FromNumber and Int16.ToNumber.
+func TestInt16FromNumber(t *testing.T) {
+	assert.Equal(t, Int16{}, Int16FromNumber(Number[int16]{}), "should return correct value")
+	assert.Equal(t, NewInt16(16), Int16FromNumber(NewNumber[int16](16)), "should return correct value")
+	assert.Equal(t, NewNumber[int16](16), NewInt16(16).ToNumber(), "should return correct value")
+}
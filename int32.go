@@ -3,7 +3,9 @@ package nulls
 import (
 	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // Int32 holds a nullable int32.
@@ -22,40 +24,194 @@ func NewInt32(i int32) Int32 {
 	}
 }
 
-// MarshalJSON marshals the int. If not valid, a NULL-value is returned.
-func (i Int32) MarshalJSON() ([]byte, error) {
+// Int32FromPtr returns an Int32 that is valid if the given pointer is not nil.
+func Int32FromPtr(v *int32) Int32 {
+	if v == nil {
+		return Int32{}
+	}
+	return NewInt32(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (i Int32) Ptr() *int32 {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Int32
+	return &v
+}
+
+// Int32FromSQL returns an Int32 from the given sql.NullInt32.
+func Int32FromSQL(v sql.NullInt32) Int32 {
+	return Int32{
+		Int32: v.Int32,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullInt32 representation.
+func (i Int32) ToSQL() sql.NullInt32 {
+	return sql.NullInt32{
+		Int32: i.Int32,
+		Valid: i.Valid,
+	}
+}
+
+// Int32FromSQLNull returns an Int32 from the given sql.Null.
+func Int32FromSQLNull(v sql.Null[int32]) Int32 {
+	return Int32{
+		Int32: v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (i Int32) ToSQLNull() sql.Null[int32] {
+	return sql.Null[int32]{
+		V:     i.Int32,
+		Valid: i.Valid,
+	}
+}
+
+// Int32FromNumber returns an Int32 from the given Number.
+func Int32FromNumber(n Number[int32]) Int32 {
+	return Int32{
+		Int32: n.V,
+		Valid: n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (i Int32) ToNumber() Number[int32] {
+	return Number[int32]{
+		V:     i.Int32,
+		Valid: i.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (i Int32) Get() (int32, bool) {
+	return i.Int32, i.Valid
+}
+
+// IsNull returns true if not valid.
+func (i Int32) IsNull() bool {
+	return !i.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (i *Int32) Set(v int32) {
+	*i = NewInt32(v)
+}
+
+// SetNull sets a NULL-value.
+func (i *Int32) SetNull() {
+	*i = Int32{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (i Int32) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (i Int32) Equal(other Int32) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+	return i.Int32 == other.Int32
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (i Int32) String() string {
+	return formatString(i.Int32, i.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (i Int32) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, i, i.Int32, i.Valid)
+}
+
+// GoString returns a Go expression creating the Int32.
+func (i Int32) GoString() string {
 	if !i.Valid {
-		return json.Marshal(nil)
+		return "nulls.Int32{}"
 	}
-	return json.Marshal(i.Int32)
+	return fmt.Sprintf("nulls.NewInt32(%#v)", i.Int32)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (i Int32) LogValue() slog.Value {
+	return i.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the int32. If not valid, a NULL-value is returned.
+func (i Int32) MarshalJSON() ([]byte, error) {
+	return i.ToNumber().MarshalJSON()
 }
 
-// UnmarshalJSON as int or sets Valid to false if null.
+// UnmarshalJSON as int32 or sets Valid to false if null. If the value does not
+// fit into int32, a RangeError or PrecisionError is returned.
 func (i *Int32) UnmarshalJSON(data []byte) error {
-	if isNull(data) {
-		i.Valid = false
-		return nil
+	n := i.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
-	i.Valid = true
-	return json.Unmarshal(data, &i.Int32)
+	*i = Int32FromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the int32 as decimal text. If not valid, empty text is
+// returned.
+func (i Int32) MarshalText() ([]byte, error) {
+	return i.ToNumber().MarshalText()
+}
+
+// UnmarshalText as decimal int32 or sets Valid to false if empty. If the value
+// does not fit into int32, a RangeError or PrecisionError is returned.
+func (i *Int32) UnmarshalText(text []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*i = Int32FromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the int32. If not valid, a NULL-value is returned.
+func (i Int32) MarshalYAML() (any, error) {
+	return i.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as int32 or sets Valid to false if null. If the value does not
+// fit into int32, a RangeError or PrecisionError is returned.
+func (i *Int32) UnmarshalYAML(node *yaml.Node) error {
+	n := i.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*i = Int32FromNumber(n)
+	return nil
 }
 
-// Scan to int value or not valid if nil.
+// Scan to int32 value or not valid if nil. If the value does not fit into
+// int32, a RangeError or PrecisionError is returned.
 func (i *Int32) Scan(src any) error {
-	var sqlInt32 sql.NullInt32
-	err := sqlInt32.Scan(src)
+	n := i.ToNumber()
+	err := n.Scan(src)
 	if err != nil {
 		return err
 	}
-	i.Valid = sqlInt32.Valid
-	i.Int32 = sqlInt32.Int32
+	*i = Int32FromNumber(n)
 	return nil
 }
 
 // Value returns the value for satisfying the driver.Valuer interface.
 func (i Int32) Value() (driver.Value, error) {
-	return sql.NullInt32{
-		Int32: i.Int32,
-		Valid: i.Valid,
-	}.Value()
+	return i.ToNumber().Value()
 }
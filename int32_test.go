@@ -1,9 +1,13 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -57,10 +61,92 @@ func (suite *Int32UnmarshalJSONSuite) TestOK() {
 	suite.EqualValues(64, i.Int32, "should unmarshal correct value")
 }
 
+func (suite *Int32UnmarshalJSONSuite) TestOutOfRange() {
+	var i Int32
+	err := json.Unmarshal([]byte("2147483648"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int32UnmarshalJSONSuite) TestInvalid() {
+	var i Int32
+	err := json.Unmarshal(marshalMust("meow"), &i)
+	suite.Error(err, "should fail")
+}
+
 func TestInt32_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(Int32UnmarshalJSONSuite))
 }
 
+// Int32MarshalYAMLSuite tests Int32.MarshalYAML.
+type Int32MarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Int32MarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Int32{Int32: -16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *Int32MarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewInt32(-16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("-16\n", string(raw), "should return correct value")
+}
+
+func TestInt32_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(Int32MarshalYAMLSuite))
+}
+
+// Int32UnmarshalYAMLSuite tests Int32.UnmarshalYAML.
+type Int32UnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Int32UnmarshalYAMLSuite) TestNull() {
+	v := NewInt32(-16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int32UnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Int32 `yaml:"v"`
+		W Int32 `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *Int32UnmarshalYAMLSuite) TestOutOfRange() {
+	var v Int32
+	err := yaml.Unmarshal([]byte(`2147483648`), &v)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int32UnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Int32
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Int32UnmarshalYAMLSuite) TestOK() {
+	var v Int32
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(int32(42), v.Int32, "should unmarshal correct value")
+}
+
+func TestInt32_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(Int32UnmarshalYAMLSuite))
+}
+
 // Int32ScanSuite tests Int32.Scan.
 type Int32ScanSuite struct {
 	suite.Suite
@@ -81,6 +167,21 @@ func (suite *Int32ScanSuite) TestOK() {
 	suite.EqualValues(64, i.Int32, "should scan correct value")
 }
 
+func (suite *Int32ScanSuite) TestOutOfRange() {
+	var i Int32
+	err := i.Scan(int64(2147483648))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int32ScanSuite) TestString() {
+	var i Int32
+	err := i.Scan([]byte("16"))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Int32, "should scan correct value")
+}
+
 func TestInt32_Scan(t *testing.T) {
 	suite.Run(t, new(Int32ScanSuite))
 }
@@ -107,3 +208,213 @@ func (suite *Int32ValueSuite) TestOK() {
 func TestInt32_Value(t *testing.T) {
 	suite.Run(t, new(Int32ValueSuite))
 }
+
+// TestInt32_Get tests Int32.Get.
+func TestInt32_Get(t *testing.T) {
+	v, ok := NewInt32(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, int32(16), v, "should return correct value")
+	_, ok = Int32{Int32: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestInt32_IsZero tests Int32.IsZero.
+func TestInt32_IsZero(t *testing.T) {
+	assert.False(t, NewInt32(16).IsZero(), "should not be zero")
+	assert.True(t, Int32{Int32: 16}.IsZero(), "should be zero")
+}
+
+// TestInt32_Equal tests Int32.Equal.
+func TestInt32_Equal(t *testing.T) {
+	assert.True(t, NewInt32(16).Equal(NewInt32(16)), "should be equal")
+	assert.False(t, NewInt32(16).Equal(NewInt32(17)), "should not be equal")
+	assert.False(t, NewInt32(16).Equal(Int32{Int32: 16}), "should not be equal")
+	assert.False(t, Int32{Int32: 16}.Equal(NewInt32(16)), "should not be equal")
+	assert.True(t, Int32{Int32: 16}.Equal(Int32{}), "should be equal")
+}
+
+// TestInt32_String tests Int32.String.
+func TestInt32_String(t *testing.T) {
+	assert.Equal(t, "16", NewInt32(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Int32{Int32: 16}.String(), "should return null token")
+}
+
+// Int32FormatSuite tests Int32.Format.
+type Int32FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *Int32FormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Int32{Int32: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Int32{Int32: 16}), "should respect width")
+}
+
+func (suite *Int32FormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewInt32(16)), "should return correct value")
+}
+
+func (suite *Int32FormatSuite) TestPaddedDecimal() {
+	suite.Equal("00016", fmt.Sprintf("%05d", NewInt32(16)), "should return correct value")
+}
+
+func (suite *Int32FormatSuite) TestHex() {
+	suite.Equal("10", fmt.Sprintf("%x", NewInt32(16)), "should return correct value")
+}
+
+func (suite *Int32FormatSuite) TestGoSyntax() {
+	suite.Equal(NewInt32(16).GoString(), fmt.Sprintf("%#v", NewInt32(16)), "should use GoString")
+}
+
+func TestInt32_Format(t *testing.T) {
+	suite.Run(t, new(Int32FormatSuite))
+}
+
+// TestInt32_GoString tests Int32.GoString.
+func TestInt32_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewInt32(16)", NewInt32(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Int32{}", Int32{Int32: 16}.GoString(), "should return correct value")
+}
+
+// TestInt32_LogValue tests Int32.LogValue.
+func TestInt32_LogValue(t *testing.T) {
+	assert.Equal(t, int64(16), NewInt32(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Int32{Int32: 16}.LogValue().Any(), "should return null value")
+}
+
+// Int32FromPtrSuite tests Int32FromPtr.
+type Int32FromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Int32FromPtrSuite) TestNil() {
+	v := Int32FromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int32FromPtrSuite) TestZero() {
+	x := int32(0)
+	v := Int32FromPtr(&x)
+	suite.Equal(NewInt32(x), v, "should return correct value")
+}
+
+func (suite *Int32FromPtrSuite) TestOK() {
+	x := int32(16)
+	v := Int32FromPtr(&x)
+	suite.Equal(NewInt32(x), v, "should return correct value")
+}
+
+func TestInt32FromPtr(t *testing.T) {
+	suite.Run(t, new(Int32FromPtrSuite))
+}
+
+// Int32PtrSuite tests Int32.Ptr.
+type Int32PtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Int32PtrSuite) TestNotValid() {
+	v := Int32{Int32: int32(16)}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *Int32PtrSuite) TestZero() {
+	p := NewInt32(int32(0)).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(int32(0), *p, "should return correct value")
+}
+
+func (suite *Int32PtrSuite) TestOK() {
+	v := NewInt32(int32(16))
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(int32(16), *p, "should return correct value")
+	*p = int32(0)
+	suite.Equal(int32(16), v.Int32, "should return copy")
+}
+
+func TestInt32_Ptr(t *testing.T) {
+	suite.Run(t, new(Int32PtrSuite))
+}
+
+// TestInt32FromSQL tests Int32FromSQL.
+func TestInt32FromSQL(t *testing.T) {
+	assert.Equal(t, Int32{}, Int32FromSQL(sql.NullInt32{}), "should return correct value")
+	assert.Equal(t, NewInt32(int32(16)), Int32FromSQL(sql.NullInt32{Int32: 16, Valid: true}), "should return correct value")
+}
+
+// TestInt32_ToSQL tests Int32.ToSQL.
+func TestInt32_ToSQL(t *testing.T) {
+	assert.False(t, Int32{Int32: int32(16)}.ToSQL().Valid, "should not be valid")
+	assert.Equal(t, sql.NullInt32{Int32: 16, Valid: true}, NewInt32(int32(16)).ToSQL(), "should return correct value")
+}
+
+// TestInt32FromSQLNull tests Int32FromSQLNull.
+func TestInt32FromSQLNull(t *testing.T) {
+	assert.Equal(t, Int32{}, Int32FromSQLNull(sql.Null[int32]{}), "should return correct value")
+	assert.Equal(t, NewInt32(int32(16)), Int32FromSQLNull(sql.Null[int32]{V: int32(16), Valid: true}), "should return correct value")
+}
+
+// TestInt32_ToSQLNull tests Int32.ToSQLNull.
+func TestInt32_ToSQLNull(t *testing.T) {
+	assert.False(t, Int32{Int32: int32(16)}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[int32]{V: int32(16), Valid: true}, NewInt32(int32(16)).ToSQLNull(), "should return correct value")
+}
+
+// Int32MarshalTextSuite tests Int32.MarshalText.
+type Int32MarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Int32MarshalTextSuite) TestNotValid() {
+	v := Int32{Int32: -16}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *Int32MarshalTextSuite) TestOK() {
+	text, err := NewInt32(-16).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`-16`, string(text), "should return correct value")
+}
+
+func TestInt32_MarshalText(t *testing.T) {
+	suite.Run(t, new(Int32MarshalTextSuite))
+}
+
+// Int32UnmarshalTextSuite tests Int32.UnmarshalText.
+type Int32UnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Int32UnmarshalTextSuite) TestEmpty() {
+	v := NewInt32(-16)
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int32UnmarshalTextSuite) TestUnmarshalFail() {
+	var v Int32
+	err := v.UnmarshalText([]byte(`3000000000`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *Int32UnmarshalTextSuite) TestOK() {
+	var v Int32
+	err := v.UnmarshalText([]byte(`42`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(int32(42), v.Int32, "should unmarshal correct value")
+}
+
+func TestInt32_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(Int32UnmarshalTextSuite))
+}
+
+// TestInt32FromNumber tests Int32FromNumber and Int32.ToNumber.
+func TestInt32FromNumber(t *testing.T) {
+	assert.Equal(t, Int32{}, Int32FromNumber(Number[int32]{}), "should return correct value")
+	assert.Equal(t, NewInt32(16), Int32FromNumber(NewNumber[int32](16)), "should return correct value")
+	assert.Equal(t, NewNumber[int32](16), NewInt32(16).ToNumber(), "should return correct value")
+}
@@ -3,7 +3,9 @@ package nulls
 import (
 	"database/sql"
 	"database/sql/driver"
-	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // Int64 holds a nullable int64.
@@ -22,40 +24,194 @@ func NewInt64(i int64) Int64 {
 	}
 }
 
-// MarshalJSON marshals the int. If not valid, a NULL-value is returned.
-func (i Int64) MarshalJSON() ([]byte, error) {
+// Int64FromPtr returns an Int64 that is valid if the given pointer is not nil.
+func Int64FromPtr(v *int64) Int64 {
+	if v == nil {
+		return Int64{}
+	}
+	return NewInt64(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (i Int64) Ptr() *int64 {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Int64
+	return &v
+}
+
+// Int64FromSQL returns an Int64 from the given sql.NullInt64.
+func Int64FromSQL(v sql.NullInt64) Int64 {
+	return Int64{
+		Int64: v.Int64,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullInt64 representation.
+func (i Int64) ToSQL() sql.NullInt64 {
+	return sql.NullInt64{
+		Int64: i.Int64,
+		Valid: i.Valid,
+	}
+}
+
+// Int64FromSQLNull returns an Int64 from the given sql.Null.
+func Int64FromSQLNull(v sql.Null[int64]) Int64 {
+	return Int64{
+		Int64: v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (i Int64) ToSQLNull() sql.Null[int64] {
+	return sql.Null[int64]{
+		V:     i.Int64,
+		Valid: i.Valid,
+	}
+}
+
+// Int64FromNumber returns an Int64 from the given Number.
+func Int64FromNumber(n Number[int64]) Int64 {
+	return Int64{
+		Int64: n.V,
+		Valid: n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (i Int64) ToNumber() Number[int64] {
+	return Number[int64]{
+		V:     i.Int64,
+		Valid: i.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (i Int64) Get() (int64, bool) {
+	return i.Int64, i.Valid
+}
+
+// IsNull returns true if not valid.
+func (i Int64) IsNull() bool {
+	return !i.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (i *Int64) Set(v int64) {
+	*i = NewInt64(v)
+}
+
+// SetNull sets a NULL-value.
+func (i *Int64) SetNull() {
+	*i = Int64{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (i Int64) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (i Int64) Equal(other Int64) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+	return i.Int64 == other.Int64
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (i Int64) String() string {
+	return formatString(i.Int64, i.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (i Int64) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, i, i.Int64, i.Valid)
+}
+
+// GoString returns a Go expression creating the Int64.
+func (i Int64) GoString() string {
 	if !i.Valid {
-		return json.Marshal(nil)
+		return "nulls.Int64{}"
 	}
-	return json.Marshal(i.Int64)
+	return fmt.Sprintf("nulls.NewInt64(%#v)", i.Int64)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (i Int64) LogValue() slog.Value {
+	return i.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the int64. If not valid, a NULL-value is returned.
+func (i Int64) MarshalJSON() ([]byte, error) {
+	return i.ToNumber().MarshalJSON()
 }
 
-// UnmarshalJSON as int or sets Valid to false if null.
+// UnmarshalJSON as int64 or sets Valid to false if null. If the value does not
+// fit into int64, a RangeError or PrecisionError is returned.
 func (i *Int64) UnmarshalJSON(data []byte) error {
-	if isNull(data) {
-		i.Valid = false
-		return nil
+	n := i.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
-	i.Valid = true
-	return json.Unmarshal(data, &i.Int64)
+	*i = Int64FromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the int64 as decimal text. If not valid, empty text is
+// returned.
+func (i Int64) MarshalText() ([]byte, error) {
+	return i.ToNumber().MarshalText()
+}
+
+// UnmarshalText as decimal int64 or sets Valid to false if empty. If the value
+// does not fit into int64, a RangeError or PrecisionError is returned.
+func (i *Int64) UnmarshalText(text []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*i = Int64FromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the int64. If not valid, a NULL-value is returned.
+func (i Int64) MarshalYAML() (any, error) {
+	return i.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as int64 or sets Valid to false if null. If the value does not
+// fit into int64, a RangeError or PrecisionError is returned.
+func (i *Int64) UnmarshalYAML(node *yaml.Node) error {
+	n := i.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*i = Int64FromNumber(n)
+	return nil
 }
 
-// Scan to int value or not valid if nil.
+// Scan to int64 value or not valid if nil. If the value does not fit into
+// int64, a RangeError or PrecisionError is returned.
 func (i *Int64) Scan(src any) error {
-	var sqlInt64 sql.NullInt64
-	err := sqlInt64.Scan(src)
+	n := i.ToNumber()
+	err := n.Scan(src)
 	if err != nil {
 		return err
 	}
-	i.Valid = sqlInt64.Valid
-	i.Int64 = sqlInt64.Int64
+	*i = Int64FromNumber(n)
 	return nil
 }
 
 // Value returns the value for satisfying the driver.Valuer interface.
 func (i Int64) Value() (driver.Value, error) {
-	return sql.NullInt64{
-		Int64: i.Int64,
-		Valid: i.Valid,
-	}.Value()
+	return i.ToNumber().Value()
 }
@@ -1,9 +1,13 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -57,10 +61,92 @@ func (suite *Int64UnmarshalJSONSuite) TestOK() {
 	suite.EqualValues(64, i.Int64, "should unmarshal correct value")
 }
 
+func (suite *Int64UnmarshalJSONSuite) TestOutOfRange() {
+	var i Int64
+	err := json.Unmarshal([]byte("9223372036854775808"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int64UnmarshalJSONSuite) TestInvalid() {
+	var i Int64
+	err := json.Unmarshal(marshalMust("meow"), &i)
+	suite.Error(err, "should fail")
+}
+
 func TestInt64_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(Int64UnmarshalJSONSuite))
 }
 
+// Int64MarshalYAMLSuite tests Int64.MarshalYAML.
+type Int64MarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Int64MarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Int64{Int64: -16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *Int64MarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewInt64(-16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("-16\n", string(raw), "should return correct value")
+}
+
+func TestInt64_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(Int64MarshalYAMLSuite))
+}
+
+// Int64UnmarshalYAMLSuite tests Int64.UnmarshalYAML.
+type Int64UnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Int64UnmarshalYAMLSuite) TestNull() {
+	v := NewInt64(-16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int64UnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Int64 `yaml:"v"`
+		W Int64 `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *Int64UnmarshalYAMLSuite) TestOutOfRange() {
+	var v Int64
+	err := yaml.Unmarshal([]byte(`9223372036854775808`), &v)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int64UnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Int64
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Int64UnmarshalYAMLSuite) TestOK() {
+	var v Int64
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(int64(42), v.Int64, "should unmarshal correct value")
+}
+
+func TestInt64_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(Int64UnmarshalYAMLSuite))
+}
+
 // Int64ScanSuite tests Int64.Scan.
 type Int64ScanSuite struct {
 	suite.Suite
@@ -81,6 +167,21 @@ func (suite *Int64ScanSuite) TestOK() {
 	suite.EqualValues(64, i.Int64, "should scan correct value")
 }
 
+func (suite *Int64ScanSuite) TestOutOfRange() {
+	var i Int64
+	err := i.Scan(uint64(9223372036854775808))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int64ScanSuite) TestString() {
+	var i Int64
+	err := i.Scan([]byte("16"))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Int64, "should scan correct value")
+}
+
 func TestInt64_Scan(t *testing.T) {
 	suite.Run(t, new(Int64ScanSuite))
 }
@@ -107,3 +208,213 @@ func (suite *Int64ValueSuite) TestOK() {
 func TestInt64_Value(t *testing.T) {
 	suite.Run(t, new(Int64ValueSuite))
 }
+
+// TestInt64_Get tests Int64.Get.
+func TestInt64_Get(t *testing.T) {
+	v, ok := NewInt64(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, int64(16), v, "should return correct value")
+	_, ok = Int64{Int64: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestInt64_IsZero tests Int64.IsZero.
+func TestInt64_IsZero(t *testing.T) {
+	assert.False(t, NewInt64(16).IsZero(), "should not be zero")
+	assert.True(t, Int64{Int64: 16}.IsZero(), "should be zero")
+}
+
+// TestInt64_Equal tests Int64.Equal.
+func TestInt64_Equal(t *testing.T) {
+	assert.True(t, NewInt64(16).Equal(NewInt64(16)), "should be equal")
+	assert.False(t, NewInt64(16).Equal(NewInt64(17)), "should not be equal")
+	assert.False(t, NewInt64(16).Equal(Int64{Int64: 16}), "should not be equal")
+	assert.False(t, Int64{Int64: 16}.Equal(NewInt64(16)), "should not be equal")
+	assert.True(t, Int64{Int64: 16}.Equal(Int64{}), "should be equal")
+}
+
+// TestInt64_String tests Int64.String.
+func TestInt64_String(t *testing.T) {
+	assert.Equal(t, "16", NewInt64(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Int64{Int64: 16}.String(), "should return null token")
+}
+
+// Int64FormatSuite tests Int64.Format.
+type Int64FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *Int64FormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Int64{Int64: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Int64{Int64: 16}), "should respect width")
+}
+
+func (suite *Int64FormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewInt64(16)), "should return correct value")
+}
+
+func (suite *Int64FormatSuite) TestPaddedDecimal() {
+	suite.Equal("00016", fmt.Sprintf("%05d", NewInt64(16)), "should return correct value")
+}
+
+func (suite *Int64FormatSuite) TestHex() {
+	suite.Equal("10", fmt.Sprintf("%x", NewInt64(16)), "should return correct value")
+}
+
+func (suite *Int64FormatSuite) TestGoSyntax() {
+	suite.Equal(NewInt64(16).GoString(), fmt.Sprintf("%#v", NewInt64(16)), "should use GoString")
+}
+
+func TestInt64_Format(t *testing.T) {
+	suite.Run(t, new(Int64FormatSuite))
+}
+
+// TestInt64_GoString tests Int64.GoString.
+func TestInt64_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewInt64(16)", NewInt64(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Int64{}", Int64{Int64: 16}.GoString(), "should return correct value")
+}
+
+// TestInt64_LogValue tests Int64.LogValue.
+func TestInt64_LogValue(t *testing.T) {
+	assert.Equal(t, int64(16), NewInt64(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Int64{Int64: 16}.LogValue().Any(), "should return null value")
+}
+
+// Int64FromPtrSuite tests Int64FromPtr.
+type Int64FromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Int64FromPtrSuite) TestNil() {
+	v := Int64FromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int64FromPtrSuite) TestZero() {
+	x := int64(0)
+	v := Int64FromPtr(&x)
+	suite.Equal(NewInt64(x), v, "should return correct value")
+}
+
+func (suite *Int64FromPtrSuite) TestOK() {
+	x := int64(16)
+	v := Int64FromPtr(&x)
+	suite.Equal(NewInt64(x), v, "should return correct value")
+}
+
+func TestInt64FromPtr(t *testing.T) {
+	suite.Run(t, new(Int64FromPtrSuite))
+}
+
+// Int64PtrSuite tests Int64.Ptr.
+type Int64PtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Int64PtrSuite) TestNotValid() {
+	v := Int64{Int64: int64(16)}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *Int64PtrSuite) TestZero() {
+	p := NewInt64(int64(0)).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(int64(0), *p, "should return correct value")
+}
+
+func (suite *Int64PtrSuite) TestOK() {
+	v := NewInt64(int64(16))
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(int64(16), *p, "should return correct value")
+	*p = int64(0)
+	suite.Equal(int64(16), v.Int64, "should return copy")
+}
+
+func TestInt64_Ptr(t *testing.T) {
+	suite.Run(t, new(Int64PtrSuite))
+}
+
+// TestInt64FromSQL tests Int64FromSQL.
+func TestInt64FromSQL(t *testing.T) {
+	assert.Equal(t, Int64{}, Int64FromSQL(sql.NullInt64{}), "should return correct value")
+	assert.Equal(t, NewInt64(int64(16)), Int64FromSQL(sql.NullInt64{Int64: 16, Valid: true}), "should return correct value")
+}
+
+// TestInt64_ToSQL tests Int64.ToSQL.
+func TestInt64_ToSQL(t *testing.T) {
+	assert.False(t, Int64{Int64: int64(16)}.ToSQL().Valid, "should not be valid")
+	assert.Equal(t, sql.NullInt64{Int64: 16, Valid: true}, NewInt64(int64(16)).ToSQL(), "should return correct value")
+}
+
+// TestInt64FromSQLNull tests Int64FromSQLNull.
+func TestInt64FromSQLNull(t *testing.T) {
+	assert.Equal(t, Int64{}, Int64FromSQLNull(sql.Null[int64]{}), "should return correct value")
+	assert.Equal(t, NewInt64(int64(16)), Int64FromSQLNull(sql.Null[int64]{V: int64(16), Valid: true}), "should return correct value")
+}
+
+// TestInt64_ToSQLNull tests Int64.ToSQLNull.
+func TestInt64_ToSQLNull(t *testing.T) {
+	assert.False(t, Int64{Int64: int64(16)}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[int64]{V: int64(16), Valid: true}, NewInt64(int64(16)).ToSQLNull(), "should return correct value")
+}
+
+// Int64MarshalTextSuite tests Int64.MarshalText.
+type Int64MarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Int64MarshalTextSuite) TestNotValid() {
+	v := Int64{Int64: -16}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *Int64MarshalTextSuite) TestOK() {
+	text, err := NewInt64(-16).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`-16`, string(text), "should return correct value")
+}
+
+func TestInt64_MarshalText(t *testing.T) {
+	suite.Run(t, new(Int64MarshalTextSuite))
+}
+
+// Int64UnmarshalTextSuite tests Int64.UnmarshalText.
+type Int64UnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Int64UnmarshalTextSuite) TestEmpty() {
+	v := NewInt64(-16)
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int64UnmarshalTextSuite) TestUnmarshalFail() {
+	var v Int64
+	err := v.UnmarshalText([]byte(`10000000000000000000`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *Int64UnmarshalTextSuite) TestOK() {
+	var v Int64
+	err := v.UnmarshalText([]byte(`42`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(int64(42), v.Int64, "should unmarshal correct value")
+}
+
+func TestInt64_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(Int64UnmarshalTextSuite))
+}
+
+// TestInt64FromNumber tests Int64FromNumber and Int64.ToNumber.
+func TestInt64FromNumber(t *testing.T) {
+	assert.Equal(t, Int64{}, Int64FromNumber(Number[int64]{}), "should return correct value")
+	assert.Equal(t, NewInt64(16), Int64FromNumber(NewNumber[int64](16)), "should return correct value")
+	assert.Equal(t, NewNumber[int64](16), NewInt64(16).ToNumber(), "should return correct value")
+}
@@ -0,0 +1,201 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+)
+
+// Int8 holds a nullable int8.
+type Int8 struct {
+	// Int8 is the actual value when Valid.
+	Int8 int8 `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewInt8 returns a valid Int8 with the given value.
+func NewInt8(i int8) Int8 {
+	return Int8{
+		Int8:  i,
+		Valid: true,
+	}
+}
+
+// Int8FromPtr returns an Int8 that is valid if the given pointer is not nil.
+func Int8FromPtr(v *int8) Int8 {
+	if v == nil {
+		return Int8{}
+	}
+	return NewInt8(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (i Int8) Ptr() *int8 {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Int8
+	return &v
+}
+
+// Int8FromSQLNull returns an Int8 from the given sql.Null.
+func Int8FromSQLNull(v sql.Null[int8]) Int8 {
+	return Int8{
+		Int8:  v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (i Int8) ToSQLNull() sql.Null[int8] {
+	return sql.Null[int8]{
+		V:     i.Int8,
+		Valid: i.Valid,
+	}
+}
+
+// Int8FromNumber returns an Int8 from the given Number.
+func Int8FromNumber(n Number[int8]) Int8 {
+	return Int8{
+		Int8:  n.V,
+		Valid: n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (i Int8) ToNumber() Number[int8] {
+	return Number[int8]{
+		V:     i.Int8,
+		Valid: i.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (i Int8) Get() (int8, bool) {
+	return i.Int8, i.Valid
+}
+
+// IsNull returns true if not valid.
+func (i Int8) IsNull() bool {
+	return !i.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (i *Int8) Set(v int8) {
+	*i = NewInt8(v)
+}
+
+// SetNull sets a NULL-value.
+func (i *Int8) SetNull() {
+	*i = Int8{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (i Int8) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (i Int8) Equal(other Int8) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+	return i.Int8 == other.Int8
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (i Int8) String() string {
+	return formatString(i.Int8, i.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (i Int8) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, i, i.Int8, i.Valid)
+}
+
+// GoString returns a Go expression creating the Int8.
+func (i Int8) GoString() string {
+	if !i.Valid {
+		return "nulls.Int8{}"
+	}
+	return fmt.Sprintf("nulls.NewInt8(%#v)", i.Int8)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (i Int8) LogValue() slog.Value {
+	return i.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the int8. If not valid, a NULL-value is returned.
+func (i Int8) MarshalJSON() ([]byte, error) {
+	return i.ToNumber().MarshalJSON()
+}
+
+// UnmarshalJSON as int8 or sets Valid to false if null. If the value does not
+// fit into int8, a RangeError or PrecisionError is returned.
+func (i *Int8) UnmarshalJSON(data []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	*i = Int8FromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the int8 as decimal text. If not valid, empty text is
+// returned.
+func (i Int8) MarshalText() ([]byte, error) {
+	return i.ToNumber().MarshalText()
+}
+
+// UnmarshalText as decimal int8 or sets Valid to false if empty. If the value
+// does not fit into int8, a RangeError or PrecisionError is returned.
+func (i *Int8) UnmarshalText(text []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*i = Int8FromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the int8. If not valid, a NULL-value is returned.
+func (i Int8) MarshalYAML() (any, error) {
+	return i.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as int8 or sets Valid to false if null. If the value does not
+// fit into int8, a RangeError or PrecisionError is returned.
+func (i *Int8) UnmarshalYAML(node *yaml.Node) error {
+	n := i.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*i = Int8FromNumber(n)
+	return nil
+}
+
+// Scan to int8 value or not valid if nil. If the value does not fit into int8,
+// a RangeError or PrecisionError is returned.
+func (i *Int8) Scan(src any) error {
+	n := i.ToNumber()
+	err := n.Scan(src)
+	if err != nil {
+		return err
+	}
+	*i = Int8FromNumber(n)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface.
+func (i Int8) Value() (driver.Value, error) {
+	return i.ToNumber().Value()
+}
@@ -0,0 +1,408 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNewInt8 tests NewInt8.
+func TestNewInt8(t *testing.T) {
+	i := NewInt8(16)
+	assert.True(t, i.Valid, "should be valid")
+	assert.EqualValues(t, 16, i.Int8, "should contain correct value")
+}
+
+// Int8MarshalJSONSuite tests Int8.MarshalJSON.
+type Int8MarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8MarshalJSONSuite) TestNotValid() {
+	i := Int8{Int8: 16}
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *Int8MarshalJSONSuite) TestOK() {
+	i := NewInt8(16)
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(marshalMust(16), raw, "should return correct value")
+}
+
+func TestInt8_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(Int8MarshalJSONSuite))
+}
+
+// Int8UnmarshalJSONSuite tests Int8.UnmarshalJSON.
+type Int8UnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8UnmarshalJSONSuite) TestNull() {
+	var i Int8
+	err := json.Unmarshal(jsonNull, &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Int8UnmarshalJSONSuite) TestOK() {
+	var i Int8
+	err := json.Unmarshal(marshalMust(16), &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Int8, "should unmarshal correct value")
+}
+
+func (suite *Int8UnmarshalJSONSuite) TestOutOfRange() {
+	var i Int8
+	err := json.Unmarshal([]byte("128"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int8UnmarshalJSONSuite) TestInvalid() {
+	var i Int8
+	err := json.Unmarshal(marshalMust("meow"), &i)
+	suite.Error(err, "should fail")
+}
+
+func TestInt8_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(Int8UnmarshalJSONSuite))
+}
+
+// Int8MarshalYAMLSuite tests Int8.MarshalYAML.
+type Int8MarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8MarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Int8{Int8: -16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *Int8MarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewInt8(-16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("-16\n", string(raw), "should return correct value")
+}
+
+func TestInt8_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(Int8MarshalYAMLSuite))
+}
+
+// Int8UnmarshalYAMLSuite tests Int8.UnmarshalYAML.
+type Int8UnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8UnmarshalYAMLSuite) TestNull() {
+	v := NewInt8(-16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int8UnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Int8 `yaml:"v"`
+		W Int8 `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *Int8UnmarshalYAMLSuite) TestOutOfRange() {
+	var v Int8
+	err := yaml.Unmarshal([]byte(`300`), &v)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int8UnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Int8
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Int8UnmarshalYAMLSuite) TestOK() {
+	var v Int8
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(int8(42), v.Int8, "should unmarshal correct value")
+}
+
+func TestInt8_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(Int8UnmarshalYAMLSuite))
+}
+
+// Int8ScanSuite tests Int8.Scan.
+type Int8ScanSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8ScanSuite) TestNull() {
+	var i Int8
+	err := i.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Int8ScanSuite) TestOK() {
+	var i Int8
+	err := i.Scan(16)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Int8, "should scan correct value")
+}
+
+func (suite *Int8ScanSuite) TestOutOfRange() {
+	var i Int8
+	err := i.Scan(int64(-129))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Int8ScanSuite) TestString() {
+	var i Int8
+	err := i.Scan([]byte("16"))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Int8, "should scan correct value")
+}
+
+func TestInt8_Scan(t *testing.T) {
+	suite.Run(t, new(Int8ScanSuite))
+}
+
+// Int8ValueSuite tests Int.Value.
+type Int8ValueSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8ValueSuite) TestNull() {
+	i := Int8{Int8: 16}
+	raw, err := i.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *Int8ValueSuite) TestOK() {
+	i := NewInt8(16)
+	raw, err := i.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.EqualValues(16, raw, "should return correct value")
+}
+
+func TestInt8_Value(t *testing.T) {
+	suite.Run(t, new(Int8ValueSuite))
+}
+
+// TestInt8_Get tests Int8.Get.
+func TestInt8_Get(t *testing.T) {
+	v, ok := NewInt8(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, int8(16), v, "should return correct value")
+	_, ok = Int8{Int8: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestInt8_IsZero tests Int8.IsZero.
+func TestInt8_IsZero(t *testing.T) {
+	assert.False(t, NewInt8(16).IsZero(), "should not be zero")
+	assert.True(t, Int8{Int8: 16}.IsZero(), "should be zero")
+}
+
+// TestInt8_Equal tests Int8.Equal.
+func TestInt8_Equal(t *testing.T) {
+	assert.True(t, NewInt8(16).Equal(NewInt8(16)), "should be equal")
+	assert.False(t, NewInt8(16).Equal(NewInt8(17)), "should not be equal")
+	assert.False(t, NewInt8(16).Equal(Int8{Int8: 16}), "should not be equal")
+	assert.False(t, Int8{Int8: 16}.Equal(NewInt8(16)), "should not be equal")
+	assert.True(t, Int8{Int8: 16}.Equal(Int8{}), "should be equal")
+}
+
+// TestInt8_String tests Int8.String.
+func TestInt8_String(t *testing.T) {
+	assert.Equal(t, "16", NewInt8(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Int8{Int8: 16}.String(), "should return null token")
+}
+
+// Int8FormatSuite tests Int8.Format.
+type Int8FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8FormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Int8{Int8: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Int8{Int8: 16}), "should respect width")
+}
+
+func (suite *Int8FormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewInt8(16)), "should return correct value")
+}
+
+func (suite *Int8FormatSuite) TestPaddedDecimal() {
+	suite.Equal("00016", fmt.Sprintf("%05d", NewInt8(16)), "should return correct value")
+}
+
+func (suite *Int8FormatSuite) TestHex() {
+	suite.Equal("10", fmt.Sprintf("%x", NewInt8(16)), "should return correct value")
+}
+
+func (suite *Int8FormatSuite) TestGoSyntax() {
+	suite.Equal(NewInt8(16).GoString(), fmt.Sprintf("%#v", NewInt8(16)), "should use GoString")
+}
+
+func TestInt8_Format(t *testing.T) {
+	suite.Run(t, new(Int8FormatSuite))
+}
+
+// TestInt8_GoString tests Int8.GoString.
+func TestInt8_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewInt8(16)", NewInt8(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Int8{}", Int8{Int8: 16}.GoString(), "should return correct value")
+}
+
+// TestInt8_LogValue tests Int8.LogValue.
+func TestInt8_LogValue(t *testing.T) {
+	assert.Equal(t, int64(16), NewInt8(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Int8{Int8: 16}.LogValue().Any(), "should return null value")
+}
+
+// Int8FromPtrSuite tests Int8FromPtr.
+type Int8FromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8FromPtrSuite) TestNil() {
+	v := Int8FromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int8FromPtrSuite) TestZero() {
+	x := int8(0)
+	v := Int8FromPtr(&x)
+	suite.Equal(NewInt8(x), v, "should return correct value")
+}
+
+func (suite *Int8FromPtrSuite) TestOK() {
+	x := int8(16)
+	v := Int8FromPtr(&x)
+	suite.Equal(NewInt8(x), v, "should return correct value")
+}
+
+func TestInt8FromPtr(t *testing.T) {
+	suite.Run(t, new(Int8FromPtrSuite))
+}
+
+// Int8PtrSuite tests Int8.Ptr.
+type Int8PtrSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8PtrSuite) TestNotValid() {
+	v := Int8{Int8: int8(16)}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *Int8PtrSuite) TestZero() {
+	p := NewInt8(int8(0)).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(int8(0), *p, "should return correct value")
+}
+
+func (suite *Int8PtrSuite) TestOK() {
+	v := NewInt8(int8(16))
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(int8(16), *p, "should return correct value")
+	*p = int8(0)
+	suite.Equal(int8(16), v.Int8, "should return copy")
+}
+
+func TestInt8_Ptr(t *testing.T) {
+	suite.Run(t, new(Int8PtrSuite))
+}
+
+// TestInt8FromSQLNull tests Int8FromSQLNull.
+func TestInt8FromSQLNull(t *testing.T) {
+	assert.Equal(t, Int8{}, Int8FromSQLNull(sql.Null[int8]{}), "should return correct value")
+	assert.Equal(t, NewInt8(int8(16)), Int8FromSQLNull(sql.Null[int8]{V: int8(16), Valid: true}), "should return correct value")
+}
+
+// TestInt8_ToSQLNull tests Int8.ToSQLNull.
+func TestInt8_ToSQLNull(t *testing.T) {
+	assert.False(t, Int8{Int8: int8(16)}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[int8]{V: int8(16), Valid: true}, NewInt8(int8(16)).ToSQLNull(), "should return correct value")
+}
+
+// Int8MarshalTextSuite tests Int8.MarshalText.
+type Int8MarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8MarshalTextSuite) TestNotValid() {
+	v := Int8{Int8: -16}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *Int8MarshalTextSuite) TestOK() {
+	text, err := NewInt8(-16).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`-16`, string(text), "should return correct value")
+}
+
+func TestInt8_MarshalText(t *testing.T) {
+	suite.Run(t, new(Int8MarshalTextSuite))
+}
+
+// Int8UnmarshalTextSuite tests Int8.UnmarshalText.
+type Int8UnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Int8UnmarshalTextSuite) TestEmpty() {
+	v := NewInt8(-16)
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Int8UnmarshalTextSuite) TestUnmarshalFail() {
+	var v Int8
+	err := v.UnmarshalText([]byte(`300`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *Int8UnmarshalTextSuite) TestOK() {
+	var v Int8
+	err := v.UnmarshalText([]byte(`42`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(int8(42), v.Int8, "should unmarshal correct value")
+}
+
+func TestInt8_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(Int8UnmarshalTextSuite))
+}
+
+// TestInt8FromNumber tests Int8FromNumber and Int8.ToNumber.
+func TestInt8FromNumber(t *testing.T) {
+	assert.Equal(t, Int8{}, Int8FromNumber(Number[int8]{}), "should return correct value")
+	assert.Equal(t, NewInt8(16), Int8FromNumber(NewNumber[int8](16)), "should return correct value")
+	assert.Equal(t, NewNumber[int8](16), NewInt8(16).ToNumber(), "should return correct value")
+}
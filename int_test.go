@@ -1,9 +1,13 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -57,10 +61,92 @@ func (suite *IntUnmarshalJSONSuite) TestOK() {
 	suite.EqualValues(64, i.Int, "should unmarshal correct value")
 }
 
+func (suite *IntUnmarshalJSONSuite) TestOutOfRange() {
+	var i Int
+	err := json.Unmarshal([]byte("18446744073709551616"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *IntUnmarshalJSONSuite) TestInvalid() {
+	var i Int
+	err := json.Unmarshal(marshalMust("meow"), &i)
+	suite.Error(err, "should fail")
+}
+
 func TestInt_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(IntUnmarshalJSONSuite))
 }
 
+// IntMarshalYAMLSuite tests Int.MarshalYAML.
+type IntMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *IntMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Int{Int: -16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *IntMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewInt(-16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("-16\n", string(raw), "should return correct value")
+}
+
+func TestInt_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(IntMarshalYAMLSuite))
+}
+
+// IntUnmarshalYAMLSuite tests Int.UnmarshalYAML.
+type IntUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *IntUnmarshalYAMLSuite) TestNull() {
+	v := NewInt(-16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *IntUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Int `yaml:"v"`
+		W Int `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *IntUnmarshalYAMLSuite) TestOutOfRange() {
+	var v Int
+	err := yaml.Unmarshal([]byte(`9223372036854775808`), &v)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *IntUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Int
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *IntUnmarshalYAMLSuite) TestOK() {
+	var v Int
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(int(42), v.Int, "should unmarshal correct value")
+}
+
+func TestInt_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(IntUnmarshalYAMLSuite))
+}
+
 // IntScanSuite tests Int.Scan.
 type IntScanSuite struct {
 	suite.Suite
@@ -81,6 +167,21 @@ func (suite *IntScanSuite) TestOK() {
 	suite.EqualValues(64, i.Int, "should scan correct value")
 }
 
+func (suite *IntScanSuite) TestOutOfRange() {
+	var i Int
+	err := i.Scan("18446744073709551616")
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *IntScanSuite) TestString() {
+	var i Int
+	err := i.Scan([]byte("16"))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Int, "should scan correct value")
+}
+
 func TestInt_Scan(t *testing.T) {
 	suite.Run(t, new(IntScanSuite))
 }
@@ -107,3 +208,224 @@ func (suite *IntValueSuite) TestOK() {
 func TestInt_Value(t *testing.T) {
 	suite.Run(t, new(IntValueSuite))
 }
+
+// TestInt_Get tests Int.Get.
+func TestInt_Get(t *testing.T) {
+	v, ok := NewInt(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, 16, v, "should return correct value")
+	_, ok = Int{Int: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestInt_IsZero tests Int.IsZero.
+func TestInt_IsZero(t *testing.T) {
+	assert.False(t, NewInt(16).IsZero(), "should not be zero")
+	assert.True(t, Int{Int: 16}.IsZero(), "should be zero")
+}
+
+// TestInt_Equal tests Int.Equal.
+func TestInt_Equal(t *testing.T) {
+	assert.True(t, NewInt(16).Equal(NewInt(16)), "should be equal")
+	assert.False(t, NewInt(16).Equal(NewInt(17)), "should not be equal")
+	assert.False(t, NewInt(16).Equal(Int{Int: 16}), "should not be equal")
+	assert.False(t, Int{Int: 16}.Equal(NewInt(16)), "should not be equal")
+	assert.True(t, Int{Int: 16}.Equal(Int{}), "should be equal")
+}
+
+// TestInt_String tests Int.String.
+func TestInt_String(t *testing.T) {
+	assert.Equal(t, "16", NewInt(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Int{Int: 16}.String(), "should return null token")
+}
+
+// IntFormatSuite tests Int.Format.
+type IntFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *IntFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Int{Int: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Int{Int: 16}), "should respect width")
+}
+
+func (suite *IntFormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewInt(16)), "should return correct value")
+}
+
+func (suite *IntFormatSuite) TestPaddedDecimal() {
+	suite.Equal("00016", fmt.Sprintf("%05d", NewInt(16)), "should return correct value")
+}
+
+func (suite *IntFormatSuite) TestHex() {
+	suite.Equal("10", fmt.Sprintf("%x", NewInt(16)), "should return correct value")
+}
+
+func (suite *IntFormatSuite) TestGoSyntax() {
+	suite.Equal(NewInt(16).GoString(), fmt.Sprintf("%#v", NewInt(16)), "should use GoString")
+}
+
+func TestInt_Format(t *testing.T) {
+	suite.Run(t, new(IntFormatSuite))
+}
+
+// TestInt_GoString tests Int.GoString.
+func TestInt_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewInt(16)", NewInt(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Int{}", Int{Int: 16}.GoString(), "should return correct value")
+}
+
+// TestInt_LogValue tests Int.LogValue.
+func TestInt_LogValue(t *testing.T) {
+	assert.Equal(t, int64(16), NewInt(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Int{Int: 16}.LogValue().Any(), "should return null value")
+}
+
+// IntFromPtrSuite tests IntFromPtr.
+type IntFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *IntFromPtrSuite) TestNil() {
+	v := IntFromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *IntFromPtrSuite) TestZero() {
+	x := 0
+	v := IntFromPtr(&x)
+	suite.Equal(NewInt(x), v, "should return correct value")
+}
+
+func (suite *IntFromPtrSuite) TestOK() {
+	x := 16
+	v := IntFromPtr(&x)
+	suite.Equal(NewInt(x), v, "should return correct value")
+}
+
+func TestIntFromPtr(t *testing.T) {
+	suite.Run(t, new(IntFromPtrSuite))
+}
+
+// IntPtrSuite tests Int.Ptr.
+type IntPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *IntPtrSuite) TestNotValid() {
+	v := Int{Int: 16}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *IntPtrSuite) TestZero() {
+	p := NewInt(0).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(0, *p, "should return correct value")
+}
+
+func (suite *IntPtrSuite) TestOK() {
+	v := NewInt(16)
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(16, *p, "should return correct value")
+	*p = 0
+	suite.Equal(16, v.Int, "should return copy")
+}
+
+func TestInt_Ptr(t *testing.T) {
+	suite.Run(t, new(IntPtrSuite))
+}
+
+// TestIntFromSQL tests IntFromSQL.
+func TestIntFromSQL(t *testing.T) {
+	assert.Equal(t, Int{}, IntFromSQL(sql.NullInt64{}), "should return correct value")
+	assert.Equal(t, NewInt(16), IntFromSQL(sql.NullInt64{Int64: 16, Valid: true}), "should return correct value")
+}
+
+// TestInt_ToSQL tests Int.ToSQL.
+func TestInt_ToSQL(t *testing.T) {
+	assert.False(t, Int{Int: 16}.ToSQL().Valid, "should not be valid")
+	assert.Equal(t, sql.NullInt64{Int64: 16, Valid: true}, NewInt(16).ToSQL(), "should return correct value")
+}
+
+// TestIntFromSQLNull tests IntFromSQLNull.
+func TestIntFromSQLNull(t *testing.T) {
+	assert.Equal(t, Int{}, IntFromSQLNull(sql.Null[int]{}), "should return correct value")
+	assert.Equal(t, NewInt(16), IntFromSQLNull(sql.Null[int]{V: 16, Valid: true}), "should return correct value")
+}
+
+// TestInt_ToSQLNull tests Int.ToSQLNull.
+func TestInt_ToSQLNull(t *testing.T) {
+	assert.False(t, Int{Int: 16}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[int]{V: 16, Valid: true}, NewInt(16).ToSQLNull(), "should return correct value")
+}
+
+// IntMarshalTextSuite tests Int.MarshalText.
+type IntMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *IntMarshalTextSuite) TestNotValid() {
+	v := Int{Int: -16}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *IntMarshalTextSuite) TestOK() {
+	text, err := NewInt(-16).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`-16`, string(text), "should return correct value")
+}
+
+func TestInt_MarshalText(t *testing.T) {
+	suite.Run(t, new(IntMarshalTextSuite))
+}
+
+// IntUnmarshalTextSuite tests Int.UnmarshalText.
+type IntUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *IntUnmarshalTextSuite) TestEmpty() {
+	v := NewInt(-16)
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *IntUnmarshalTextSuite) TestUnmarshalFail() {
+	var v Int
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *IntUnmarshalTextSuite) TestOK() {
+	var v Int
+	err := v.UnmarshalText([]byte(`42`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(42, v.Int, "should unmarshal correct value")
+}
+
+func TestInt_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(IntUnmarshalTextSuite))
+}
+
+// TestInt_mapKey tests using Int as JSON map key.
+func TestInt_mapKey(t *testing.T) {
+	raw, err := json.Marshal(map[Int]string{NewInt(16): "meow"})
+	assert.NoError(t, err, "marshal should not fail")
+	assert.Equal(t, `{"16":"meow"}`, string(raw), "should marshal correct value")
+	var m map[Int]string
+	err = json.Unmarshal(raw, &m)
+	assert.NoError(t, err, "unmarshal should not fail")
+	assert.Equal(t, map[Int]string{NewInt(16): "meow"}, m, "should unmarshal correct value")
+}
+
+// TestIntFromNumber tests IntFromNumber and Int.ToNumber.
+func TestIntFromNumber(t *testing.T) {
+	assert.Equal(t, Int{}, IntFromNumber(Number[int]{}), "should return correct value")
+	assert.Equal(t, NewInt(16), IntFromNumber(NewNumber[int](16)), "should return correct value")
+	assert.Equal(t, NewNumber[int](16), NewInt(16).ToNumber(), "should return correct value")
+}
@@ -0,0 +1,126 @@
+package nulls
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RangeError is returned when scanning or unmarshalling a value that does not
+// fit into the target type.
+type RangeError struct {
+	// Value is the string representation of the value being out of range.
+	Value string
+	// Type is the name of the target type like int16.
+	Type string
+}
+
+// Error returns the error message.
+func (err *RangeError) Error() string {
+	return fmt.Sprintf("value %s out of range for %s", err.Value, err.Type)
+}
+
+// parseInt parses the given decimal string as signed integer with the given bit
+// size. If the value is out of range, a RangeError is returned.
+func parseInt(s string, bitSize int, typeName string) (int64, error) {
+	v, err := strconv.ParseInt(s, 10, bitSize)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, &RangeError{Value: s, Type: typeName}
+		}
+		return 0, err
+	}
+	return v, nil
+}
+
+// parseUint parses the given decimal string as unsigned integer with the given
+// bit size. If the value is out of range, including negative values, a
+// RangeError is returned.
+func parseUint(s string, bitSize int, typeName string) (uint64, error) {
+	if strings.HasPrefix(s, "-") {
+		_, err := strconv.ParseInt(s, 10, 64)
+		if err == nil || errors.Is(err, strconv.ErrRange) {
+			return 0, &RangeError{Value: s, Type: typeName}
+		}
+		return 0, err
+	}
+	v, err := strconv.ParseUint(s, 10, bitSize)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, &RangeError{Value: s, Type: typeName}
+		}
+		return 0, err
+	}
+	return v, nil
+}
+
+// checkInt returns a RangeError if the given value does not fit into a signed
+// integer with the given bit size.
+func checkInt(v int64, bitSize int, typeName string) error {
+	if bitSize >= 64 {
+		return nil
+	}
+	minValue := int64(-1) << (bitSize - 1)
+	maxValue := -minValue - 1
+	if v < minValue || v > maxValue {
+		return &RangeError{Value: strconv.FormatInt(v, 10), Type: typeName}
+	}
+	return nil
+}
+
+// checkUint returns a RangeError if the given value does not fit into an
+// unsigned integer with the given bit size.
+func checkUint(v uint64, bitSize int, typeName string) error {
+	if bitSize >= 64 {
+		return nil
+	}
+	if v > uint64(1)<<bitSize-1 {
+		return &RangeError{Value: strconv.FormatUint(v, 10), Type: typeName}
+	}
+	return nil
+}
+
+// uint64Value returns the driver.Value for the given unsigned integer. As
+// driver values only support int64, a RangeError is returned for larger values.
+func uint64Value(v uint64) (int64, error) {
+	if v > math.MaxInt64 {
+		return 0, &RangeError{Value: strconv.FormatUint(v, 10), Type: "int64"}
+	}
+	return int64(v), nil
+}
+
+// unmarshalYAMLInt unmarshals the given YAML node as signed integer with the
+// given bit size. If the value is out of range, a RangeError is returned.
+func unmarshalYAMLInt(node *yaml.Node, bitSize int, typeName string) (int64, error) {
+	var v int64
+	err := node.Decode(&v)
+	if err != nil {
+		// Values exceeding int64 are still integers, but out of range.
+		var u uint64
+		if node.Decode(&u) == nil {
+			return 0, &RangeError{Value: strconv.FormatUint(u, 10), Type: typeName}
+		}
+		return 0, err
+	}
+	return v, checkInt(v, bitSize, typeName)
+}
+
+// unmarshalYAMLUint unmarshals the given YAML node as unsigned integer with the
+// given bit size. If the value is out of range, including negative values, a
+// RangeError is returned.
+func unmarshalYAMLUint(node *yaml.Node, bitSize int, typeName string) (uint64, error) {
+	var v uint64
+	err := node.Decode(&v)
+	if err != nil {
+		// Negative values are still integers, but out of range.
+		var i int64
+		if node.Decode(&i) == nil {
+			return 0, &RangeError{Value: strconv.FormatInt(i, 10), Type: typeName}
+		}
+		return 0, err
+	}
+	return v, checkUint(v, bitSize, typeName)
+}
@@ -0,0 +1,65 @@
+package nulls
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"math"
+	"testing"
+)
+
+// TestRangeError_Error tests RangeError.Error.
+func TestRangeError_Error(t *testing.T) {
+	err := &RangeError{Value: "70000", Type: "int16"}
+	assert.Equal(t, "value 70000 out of range for int16", err.Error(), "should return correct message")
+}
+
+// checkIntSuite tests checkInt.
+type checkIntSuite struct {
+	suite.Suite
+}
+
+func (suite *checkIntSuite) TestBounds() {
+	suite.NoError(checkInt(math.MinInt16, 16, "int16"), "should accept min")
+	suite.NoError(checkInt(math.MaxInt16, 16, "int16"), "should accept max")
+	suite.Error(checkInt(math.MinInt16-1, 16, "int16"), "should reject below min")
+	suite.Error(checkInt(math.MaxInt16+1, 16, "int16"), "should reject above max")
+}
+
+func (suite *checkIntSuite) Test64() {
+	suite.NoError(checkInt(math.MinInt64, 64, "int64"), "should accept min")
+	suite.NoError(checkInt(math.MaxInt64, 64, "int64"), "should accept max")
+}
+
+func TestCheckInt(t *testing.T) {
+	suite.Run(t, new(checkIntSuite))
+}
+
+// checkUintSuite tests checkUint.
+type checkUintSuite struct {
+	suite.Suite
+}
+
+func (suite *checkUintSuite) TestBounds() {
+	suite.NoError(checkUint(0, 8, "uint8"), "should accept min")
+	suite.NoError(checkUint(math.MaxUint8, 8, "uint8"), "should accept max")
+	suite.Error(checkUint(math.MaxUint8+1, 8, "uint8"), "should reject above max")
+}
+
+func (suite *checkUintSuite) Test64() {
+	suite.NoError(checkUint(math.MaxUint64, 64, "uint64"), "should accept max")
+}
+
+func TestCheckUint(t *testing.T) {
+	suite.Run(t, new(checkUintSuite))
+}
+
+// TestUint64Value tests uint64Value.
+func TestUint64Value(t *testing.T) {
+	v, err := uint64Value(math.MaxInt64)
+	assert.NoError(t, err, "should not fail")
+	assert.EqualValues(t, math.MaxInt64, v, "should return correct value")
+	_, err = uint64Value(math.MaxInt64 + 1)
+	var rangeErr *RangeError
+	assert.True(t, errors.As(err, &rangeErr), "should fail with range error")
+}
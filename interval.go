@@ -0,0 +1,160 @@
+package nulls
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Durations used for interval units without a fixed length. They match the
+// ones used by PostgreSQL when extracting the epoch of an interval.
+const (
+	intervalDay   = 24 * time.Hour
+	intervalMonth = 30 * intervalDay
+	intervalYear  = 8766 * time.Hour
+)
+
+// intervalUnits maps units of PostgreSQL interval text in singular to their
+// duration.
+var intervalUnits = map[string]time.Duration{
+	"year":        intervalYear,
+	"mon":         intervalMonth,
+	"month":       intervalMonth,
+	"week":        7 * intervalDay,
+	"day":         intervalDay,
+	"hour":        time.Hour,
+	"min":         time.Minute,
+	"minute":      time.Minute,
+	"sec":         time.Second,
+	"second":      time.Second,
+	"millisecond": time.Millisecond,
+	"microsecond": time.Microsecond,
+}
+
+// parseInterval parses the given interval text as output by PostgreSQL with
+// the default postgres interval style like "1 day 02:30:00" or
+// "-1 days +02:00:00". Years are counted as 365.25 days and months as 30 days.
+// If the interval exceeds time.Duration, a RangeError is returned.
+func parseInterval(s string) (time.Duration, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("invalid interval %q", s)
+	}
+	var total time.Duration
+	for i := 0; i < len(fields); i++ {
+		var d time.Duration
+		var ok bool
+		if strings.Contains(fields[i], ":") {
+			clock, err := parseIntervalClock(fields[i])
+			if err != nil {
+				return 0, fmt.Errorf("invalid interval %q: %w", s, err)
+			}
+			d, ok = clock, true
+		} else {
+			if i+1 >= len(fields) {
+				return 0, fmt.Errorf("invalid interval %q: missing unit for %s", s, fields[i])
+			}
+			n, err := strconv.ParseInt(fields[i], 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid interval %q: %w", s, err)
+			}
+			i++
+			unit, known := intervalUnits[strings.TrimSuffix(strings.ToLower(fields[i]), "s")]
+			if !known {
+				return 0, fmt.Errorf("invalid interval %q: unknown unit %s", s, fields[i])
+			}
+			d, ok = mulDuration(n, unit)
+		}
+		if ok {
+			total, ok = addDuration(total, d)
+		}
+		if !ok {
+			return 0, &RangeError{Value: s, Type: "time.Duration"}
+		}
+	}
+	return total, nil
+}
+
+// parseIntervalClock parses the time part of an interval like "02:30:00.5" or
+// "-02:30" with an optional sign.
+func parseIntervalClock(s string) (time.Duration, error) {
+	negative := strings.HasPrefix(s, "-")
+	parts := strings.Split(strings.TrimLeft(s, "+-"), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid time %s", s)
+	}
+	hours, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hours in %s: %w", s, err)
+	}
+	minutes, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil || minutes > 59 {
+		return 0, fmt.Errorf("invalid minutes in %s", s)
+	}
+	var seconds time.Duration
+	if len(parts) == 3 {
+		if strings.ContainsAny(parts[2], "+-") {
+			return 0, fmt.Errorf("invalid seconds in %s", s)
+		}
+		seconds, err = time.ParseDuration(parts[2] + "s")
+		if err != nil || seconds >= time.Minute {
+			return 0, fmt.Errorf("invalid seconds in %s", s)
+		}
+	}
+	d, ok := mulDuration(hours, time.Hour)
+	if ok {
+		d, ok = addDuration(d, time.Duration(minutes)*time.Minute+seconds)
+	}
+	if !ok {
+		return 0, &RangeError{Value: s, Type: "time.Duration"}
+	}
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
+
+// formatInterval formats the given duration as interval text accepted by
+// PostgreSQL like "-26:03:04.5".
+func formatInterval(d time.Duration) string {
+	var b strings.Builder
+	// Use unsigned values as -math.MinInt64 overflows.
+	abs := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		abs = -abs
+	}
+	hours := abs / uint64(time.Hour)
+	minutes := abs / uint64(time.Minute) % 60
+	seconds := abs / uint64(time.Second) % 60
+	nanoseconds := abs % uint64(time.Second)
+	_, _ = fmt.Fprintf(&b, "%02d:%02d:%02d", hours, minutes, seconds)
+	if nanoseconds > 0 {
+		b.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", nanoseconds), "0"))
+	}
+	return b.String()
+}
+
+// mulDuration returns n times the given unit. The returned boolean is false if
+// the result overflows.
+func mulDuration(n int64, unit time.Duration) (time.Duration, bool) {
+	if n == 0 {
+		return 0, true
+	}
+	if n > math.MaxInt64/int64(unit) || n < math.MinInt64/int64(unit) {
+		return 0, false
+	}
+	return time.Duration(n) * unit, true
+}
+
+// addDuration returns the sum of a and b. The returned boolean is false if the
+// result overflows.
+func addDuration(a time.Duration, b time.Duration) (time.Duration, bool) {
+	sum := a + b
+	if (a > 0 && b > 0 && sum < 0) || (a < 0 && b < 0 && sum >= 0) {
+		return 0, false
+	}
+	return sum, true
+}
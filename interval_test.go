@@ -0,0 +1,72 @@
+package nulls
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"math"
+	"testing"
+	"time"
+)
+
+// parseIntervalSuite tests parseInterval.
+type parseIntervalSuite struct {
+	suite.Suite
+}
+
+func (suite *parseIntervalSuite) TestOK() {
+	for s, expect := range map[string]time.Duration{
+		"00:00:00":                      0,
+		"01:30:00":                      90 * time.Minute,
+		"-01:30":                        -90 * time.Minute,
+		"00:00:00.000001":               time.Microsecond,
+		"36:00:00":                      36 * time.Hour,
+		"1 day":                         24 * time.Hour,
+		"1 day 02:30:00":                26*time.Hour + 30*time.Minute,
+		"-1 days +02:00:00":             -22 * time.Hour,
+		"2 mons":                        60 * 24 * time.Hour,
+		"1 year 1 mon":                  8766*time.Hour + 30*24*time.Hour,
+		"1 week 3 hours 2 mins 1 sec":   7*24*time.Hour + 3*time.Hour + 2*time.Minute + time.Second,
+		"5 milliseconds 7 microseconds": 5*time.Millisecond + 7*time.Microsecond,
+		"3 Days -04:05:06.5":            72*time.Hour - 4*time.Hour - 5*time.Minute - 6500*time.Millisecond,
+		"  1 day   00:00:01  ":          24*time.Hour + time.Second,
+	} {
+		d, err := parseInterval(s)
+		suite.Require().NoErrorf(err, "should not fail for %s", s)
+		suite.Equalf(expect, d, "should return correct value for %s", s)
+	}
+}
+
+func (suite *parseIntervalSuite) TestInvalid() {
+	for _, s := range []string{"", "meow", "1", "1 fortnight", "a day", "1:2:3:4", "01:60:00", "01:00:60", "01:00:-1", "x:00"} {
+		_, err := parseInterval(s)
+		suite.Errorf(err, "should fail for %s", s)
+	}
+}
+
+func (suite *parseIntervalSuite) TestOutOfRange() {
+	for _, s := range []string{"300 years", "2562048:00:00", "200 years 100 years"} {
+		_, err := parseInterval(s)
+		var rangeErr *RangeError
+		suite.Truef(errors.As(err, &rangeErr), "should return range error for %s", s)
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	suite.Run(t, new(parseIntervalSuite))
+}
+
+// TestFormatInterval tests formatInterval.
+func TestFormatInterval(t *testing.T) {
+	assert.Equal(t, "00:00:00", formatInterval(0), "should return correct value")
+	assert.Equal(t, "01:30:00", formatInterval(90*time.Minute), "should return correct value")
+	assert.Equal(t, "-26:03:04.5", formatInterval(-(26*time.Hour + 3*time.Minute + 4500*time.Millisecond)),
+		"should return correct value")
+	assert.Equal(t, "00:00:00.000000001", formatInterval(1), "should return correct value")
+	assert.Equal(t, "-2562047:47:16.854775808", formatInterval(math.MinInt64), "should return correct value")
+	for _, d := range []time.Duration{0, time.Microsecond, -90 * time.Minute, 100 * time.Hour, math.MaxInt64} {
+		parsed, err := parseInterval(formatInterval(d))
+		assert.NoErrorf(t, err, "should not fail for %v", d)
+		assert.Equalf(t, d, parsed, "should parse formatted value for %v", d)
+	}
+}
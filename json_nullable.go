@@ -1,13 +1,17 @@
 package nulls
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
-	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // JSONNullable holds a nullable value. Keep in mind, that T must be
-// (un)marshallable. However, it cannot be used as sql.Scanner or driver.Valuer.
+// (un)marshallable. In databases, the value is stored as JSON, for example in
+// JSON or JSONB columns.
 type JSONNullable[T any] struct {
 	// V is the actual value when Valid.
 	V T `exhaustruct:"optional"`
@@ -23,6 +27,104 @@ func NewJSONNullable[T any](v T) JSONNullable[T] {
 	}
 }
 
+// JSONNullableFromPtr returns a JSONNullable that is valid if the given pointer
+// is not nil.
+func JSONNullableFromPtr[T any](v *T) JSONNullable[T] {
+	if v == nil {
+		return JSONNullable[T]{}
+	}
+	return NewJSONNullable(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n JSONNullable[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// JSONNullableFromSQLNull returns a JSONNullable from the given sql.Null.
+func JSONNullableFromSQLNull[T any](v sql.Null[T]) JSONNullable[T] {
+	return JSONNullable[T]{
+		V:     v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n JSONNullable[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{
+		V:     n.V,
+		Valid: n.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (n JSONNullable[T]) Get() (T, bool) {
+	return n.V, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n JSONNullable[T]) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *JSONNullable[T]) Set(v T) {
+	*n = NewJSONNullable(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *JSONNullable[T]) SetNull() {
+	*n = JSONNullable[T]{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n JSONNullable[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+// Values are compared using their Equal method if available or
+// reflect.DeepEqual otherwise.
+func (n JSONNullable[T]) Equal(other JSONNullable[T]) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return equalValues(n.V, other.V)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (n JSONNullable[T]) String() string {
+	return formatString(n.V, n.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (n JSONNullable[T]) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n, n.V, n.Valid)
+}
+
+// GoString returns a Go expression creating the JSONNullable.
+func (n JSONNullable[T]) GoString() string {
+	if !n.Valid {
+		return fmt.Sprintf("nulls.JSONNullable[%T]{}", n.V)
+	}
+	return fmt.Sprintf("nulls.NewJSONNullable[%T](%#v)", n.V, n.V)
+}
+
+// LogValue implements slog.LogValuer. The value is logged like T or as NULL-value
+// if not valid.
+func (n JSONNullable[T]) LogValue() slog.Value {
+	if !n.Valid {
+		return logNullValue()
+	}
+	return slog.AnyValue(n.V)
+}
+
 // MarshalJSON as value. If not vot valid, a NULL-value is returned.
 func (n JSONNullable[T]) MarshalJSON() ([]byte, error) {
 	if !n.Valid {
@@ -33,20 +135,99 @@ func (n JSONNullable[T]) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON as value ro sets Valid o false if null.
 func (n *JSONNullable[T]) UnmarshalJSON(data []byte) error {
-	if string(data) == "null" {
-		n.Valid = false
+	if isNull(data) {
+		n.SetNull()
 		return nil
 	}
 	n.Valid = true
 	return json.Unmarshal(data, &n.V)
 }
 
-// Scan to value or not valid if nil.
+// MarshalText marshals the value using encoding.TextMarshaler if T implements
+// it or formats strings, booleans and numbers like with strconv. If not valid,
+// empty text is returned.
+func (n JSONNullable[T]) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return marshalText(&n.V)
+}
+
+// UnmarshalText using encoding.TextUnmarshaler if T implements it or parses
+// strings, booleans and numbers like with strconv. If empty, Valid is set to
+// false.
+func (n *JSONNullable[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+	var v T
+	err := unmarshalText(&v, text)
+	if err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// MarshalYAML as value. If not valid, a NULL-value is returned.
+func (n JSONNullable[T]) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.V, nil
+}
+
+// UnmarshalYAML as value or sets Valid to false if null.
+func (n *JSONNullable[T]) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		n.SetNull()
+		return nil
+	}
+	n.Valid = true
+	return node.Decode(&n.V)
+}
+
+// Scan to value or not valid if nil. The source is expected to be JSON, for
+// example from a JSON column, and unmarshalled into a zero T, so that values
+// from previous scans do not leak into the new one. Keep in mind, that a JSON
+// null in the column is scanned as NULL-value as well. Value then returns nil,
+// so that SQL NULL instead of JSON null is written back.
 func (n *JSONNullable[T]) Scan(src any) error {
-	return errors.New("unsupported operation")
+	switch src := src.(type) {
+	case nil:
+		n.SetNull()
+		return nil
+	case []byte:
+		return n.scanJSON(src)
+	case string:
+		return n.scanJSON([]byte(src))
+	default:
+		return fmt.Errorf("unsupported source value type: %T", src)
+	}
 }
 
-// Value returns the value for satisfying the driver.Valuer interface.
+// scanJSON unmarshals the given JSON into a zero T and sets it as value or sets
+// Valid to false if null.
+func (n *JSONNullable[T]) scanJSON(data []byte) error {
+	if isNull(data) {
+		n.SetNull()
+		return nil
+	}
+	var v T
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The value
+// is marshalled as JSON, which allows storing it in JSON columns.
 func (n JSONNullable[T]) Value() (driver.Value, error) {
-	return nil, errors.New("unsupported operation")
+	if !n.Valid {
+		return nil, nil
+	}
+	return json.Marshal(n.V)
 }
@@ -1,12 +1,16 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
+	"time"
 )
 
 type aStruct struct {
@@ -105,22 +109,122 @@ func TestJSONNullable_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(JSONNullableUnmarshalJSONSuite))
 }
 
+// JSONNullableMarshalYAMLSuite tests JSONNullable.MarshalYAML.
+type JSONNullableMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONNullableMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(JSONNullable[int]{V: 16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *JSONNullableMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewJSONNullable(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16\n", string(raw), "should return correct value")
+}
+
+func TestJSONNullable_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(JSONNullableMarshalYAMLSuite))
+}
+
+// JSONNullableUnmarshalYAMLSuite tests JSONNullable.UnmarshalYAML.
+type JSONNullableUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONNullableUnmarshalYAMLSuite) TestNull() {
+	v := NewJSONNullable(16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *JSONNullableUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V JSONNullable[int] `yaml:"v"`
+		W JSONNullable[int] `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *JSONNullableUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v JSONNullable[int]
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *JSONNullableUnmarshalYAMLSuite) TestOK() {
+	var v JSONNullable[int]
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(42, v.V, "should unmarshal correct value")
+}
+
+func TestJSONNullable_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(JSONNullableUnmarshalYAMLSuite))
+}
+
 // JSONNullableScanSuite tests JSONNullable.Scan.
 type JSONNullableScanSuite struct {
 	suite.Suite
 }
 
 func (suite *JSONNullableScanSuite) TestNull() {
-	n := JSONNullable[*jSONNullableValueMock]{V: &jSONNullableValueMock{}}
+	n := NewJSONNullable(&jSONNullableValueMock{})
 	err := n.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *JSONNullableScanSuite) TestUnsupported() {
+	var n JSONNullable[int]
+	err := n.Scan(16)
 	suite.Error(err, "should fail")
 }
 
-func (suite *JSONNullableScanSuite) TestOK() {
-	src := "Hello World!"
-	n := JSONNullable[*jSONNullableValueMock]{V: &jSONNullableValueMock{}}
-	err := n.Scan(src)
+func (suite *JSONNullableScanSuite) TestUnmarshalFail() {
+	n := NewJSONNullable(16)
+	err := n.Scan([]byte(`{"a": 1}`))
 	suite.Error(err, "should fail")
+	suite.Equal(NewJSONNullable(16), n, "should not change value")
+}
+
+func (suite *JSONNullableScanSuite) TestJSONNull() {
+	n := NewJSONNullable(map[string]int{"a": 1})
+	err := n.Scan([]byte(`null`))
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *JSONNullableScanSuite) TestReset() {
+	n := NewJSONNullable(map[string]int{"a": 1})
+	err := n.Scan([]byte(`{"b": 2}`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(n.Valid, "should be valid")
+	suite.Equal(map[string]int{"b": 2}, n.V, "should not merge with previous value")
+}
+
+func (suite *JSONNullableScanSuite) TestBytes() {
+	var n JSONNullable[map[string]int]
+	err := n.Scan([]byte(`{"a": 1}`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(n.Valid, "should be valid")
+	suite.Equal(map[string]int{"a": 1}, n.V, "should scan correct value")
+}
+
+func (suite *JSONNullableScanSuite) TestString() {
+	var n JSONNullable[[]string]
+	err := n.Scan(`["a", "b"]`)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(n.Valid, "should be valid")
+	suite.Equal([]string{"a", "b"}, n.V, "should scan correct value")
 }
 
 func TestJSONNullable_Scan(t *testing.T) {
@@ -134,16 +238,272 @@ type JSONNullableValueSuite struct {
 
 func (suite *JSONNullableValueSuite) TestNull() {
 	n := JSONNullable[*jSONNullableValueMock]{V: &jSONNullableValueMock{}}
-	_, err := n.Value()
-	suite.Error(err, "should fail")
+	v, err := n.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(v, "should return correct value")
 }
 
-func (suite *JSONNullableValueSuite) TestOK() {
+func (suite *JSONNullableValueSuite) TestMarshalFail() {
 	n := NewJSONNullable(&jSONNullableValueMock{})
+	n.V.On("MarshalJSON").Return(nil, errors.New("sad life"))
+	defer n.V.AssertExpectations(suite.T())
 	_, err := n.Value()
 	suite.Error(err, "should fail")
 }
 
+func (suite *JSONNullableValueSuite) TestOK() {
+	n := NewJSONNullable(map[string]int{"a": 1})
+	v, err := n.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal([]byte(`{"a":1}`), v, "should return correct value")
+}
+
 func TestJSONNullable_Value(t *testing.T) {
 	suite.Run(t, new(JSONNullableValueSuite))
 }
+
+// TestJSONNullable_Get tests JSONNullable.Get.
+func TestJSONNullable_Get(t *testing.T) {
+	v, ok := NewJSONNullable(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, 16, v, "should return correct value")
+	_, ok = JSONNullable[int]{V: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestJSONNullable_IsZero tests JSONNullable.IsZero.
+func TestJSONNullable_IsZero(t *testing.T) {
+	assert.False(t, NewJSONNullable(16).IsZero(), "should not be zero")
+	assert.True(t, JSONNullable[int]{V: 16}.IsZero(), "should be zero")
+}
+
+// TestJSONNullable_Equal tests JSONNullable.Equal.
+func TestJSONNullable_Equal(t *testing.T) {
+	assert.True(t, NewJSONNullable(16).Equal(NewJSONNullable(16)), "should be equal")
+	assert.False(t, NewJSONNullable(16).Equal(NewJSONNullable(17)), "should not be equal")
+	assert.False(t, NewJSONNullable(16).Equal(JSONNullable[int]{V: 16}), "should not be equal")
+	assert.False(t, JSONNullable[int]{V: 16}.Equal(NewJSONNullable(16)), "should not be equal")
+	assert.True(t, JSONNullable[int]{V: 16}.Equal(JSONNullable[int]{}), "should be equal")
+}
+
+// TestJSONNullable_String tests JSONNullable.String.
+func TestJSONNullable_String(t *testing.T) {
+	assert.Equal(t, "16", NewJSONNullable(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, JSONNullable[int]{V: 16}.String(), "should return null token")
+}
+
+// JSONNullableFormatSuite tests JSONNullable.Format.
+type JSONNullableFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONNullableFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", JSONNullable[int]{V: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", JSONNullable[int]{V: 16}), "should respect width")
+}
+
+func (suite *JSONNullableFormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewJSONNullable(16)), "should return correct value")
+}
+
+func (suite *JSONNullableFormatSuite) TestVerb() {
+	suite.Equal("016", fmt.Sprintf("%03d", NewJSONNullable(16)), "should return correct value")
+}
+
+func (suite *JSONNullableFormatSuite) TestGoSyntax() {
+	suite.Equal(NewJSONNullable(16).GoString(), fmt.Sprintf("%#v", NewJSONNullable(16)), "should use GoString")
+}
+
+func TestJSONNullable_Format(t *testing.T) {
+	suite.Run(t, new(JSONNullableFormatSuite))
+}
+
+// TestJSONNullable_GoString tests JSONNullable.GoString.
+func TestJSONNullable_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewJSONNullable[int](16)", NewJSONNullable(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.JSONNullable[int]{}", JSONNullable[int]{V: 16}.GoString(), "should return correct value")
+}
+
+// TestJSONNullable_LogValue tests JSONNullable.LogValue.
+func TestJSONNullable_LogValue(t *testing.T) {
+	assert.Equal(t, int64(16), NewJSONNullable(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, JSONNullable[int]{V: 16}.LogValue().Any(), "should return null value")
+}
+
+// JSONNullableFromPtrSuite tests JSONNullableFromPtr.
+type JSONNullableFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONNullableFromPtrSuite) TestNil() {
+	v := JSONNullableFromPtr[int](nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *JSONNullableFromPtrSuite) TestZero() {
+	x := 0
+	v := JSONNullableFromPtr[int](&x)
+	suite.Equal(NewJSONNullable(x), v, "should return correct value")
+}
+
+func (suite *JSONNullableFromPtrSuite) TestOK() {
+	x := 16
+	v := JSONNullableFromPtr[int](&x)
+	suite.Equal(NewJSONNullable(x), v, "should return correct value")
+}
+
+func TestJSONNullableFromPtr(t *testing.T) {
+	suite.Run(t, new(JSONNullableFromPtrSuite))
+}
+
+// JSONNullablePtrSuite tests JSONNullable.Ptr.
+type JSONNullablePtrSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONNullablePtrSuite) TestNotValid() {
+	v := JSONNullable[int]{V: 16}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *JSONNullablePtrSuite) TestZero() {
+	p := NewJSONNullable(0).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(0, *p, "should return correct value")
+}
+
+func (suite *JSONNullablePtrSuite) TestOK() {
+	v := NewJSONNullable(16)
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(16, *p, "should return correct value")
+	*p = 0
+	suite.Equal(16, v.V, "should return copy")
+}
+
+func TestJSONNullable_Ptr(t *testing.T) {
+	suite.Run(t, new(JSONNullablePtrSuite))
+}
+
+// TestJSONNullableFromSQLNull tests JSONNullableFromSQLNull.
+func TestJSONNullableFromSQLNull(t *testing.T) {
+	assert.Equal(t, JSONNullable[int]{}, JSONNullableFromSQLNull[int](sql.Null[int]{}), "should return correct value")
+	v := 16
+	assert.Equal(t, NewJSONNullable(v), JSONNullableFromSQLNull[int](sql.Null[int]{V: v, Valid: true}), "should return correct value")
+}
+
+// TestJSONNullable_ToSQLNull tests JSONNullable.ToSQLNull.
+func TestJSONNullable_ToSQLNull(t *testing.T) {
+	assert.False(t, JSONNullable[int]{V: 16}.ToSQLNull().Valid, "should not be valid")
+	v := 16
+	assert.Equal(t, sql.Null[int]{V: v, Valid: true}, NewJSONNullable(v).ToSQLNull(), "should return correct value")
+}
+
+// JSONNullableMarshalTextSuite tests JSONNullable.MarshalText.
+type JSONNullableMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONNullableMarshalTextSuite) TestNotValid() {
+	v := JSONNullable[time.Time]{V: time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *JSONNullableMarshalTextSuite) TestUnsupported() {
+	_, err := NewJSONNullable([]int{42}).MarshalText()
+	suite.Error(err, "should fail")
+}
+
+func (suite *JSONNullableMarshalTextSuite) TestBasicKinds() {
+	text, err := NewJSONNullable("meow").MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`meow`, string(text), "should return correct value for string")
+	text, err = NewJSONNullable(-42).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`-42`, string(text), "should return correct value for int")
+	text, err = NewJSONNullable(uint8(42)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`42`, string(text), "should return correct value for uint8")
+	text, err = NewJSONNullable(float32(0.1)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`0.1`, string(text), "should return correct value for float32")
+	text, err = NewJSONNullable(true).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`true`, string(text), "should return correct value for bool")
+}
+
+func (suite *JSONNullableMarshalTextSuite) TestOK() {
+	text, err := NewJSONNullable(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`2022-07-01T12:30:00Z`, string(text), "should return correct value")
+}
+
+func TestJSONNullable_MarshalText(t *testing.T) {
+	suite.Run(t, new(JSONNullableMarshalTextSuite))
+}
+
+// JSONNullableUnmarshalTextSuite tests JSONNullable.UnmarshalText.
+type JSONNullableUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONNullableUnmarshalTextSuite) TestEmpty() {
+	v := NewJSONNullable(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC))
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *JSONNullableUnmarshalTextSuite) TestUnsupported() {
+	var v JSONNullable[[]int]
+	err := v.UnmarshalText([]byte(`42`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *JSONNullableUnmarshalTextSuite) TestBasicKinds() {
+	var s JSONNullable[string]
+	err := s.UnmarshalText([]byte(`meow`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewJSONNullable("meow"), s, "should unmarshal correct value for string")
+	var i JSONNullable[int]
+	err = i.UnmarshalText([]byte(`-42`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewJSONNullable(-42), i, "should unmarshal correct value for int")
+	var f JSONNullable[float64]
+	err = f.UnmarshalText([]byte(`0.5`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewJSONNullable(0.5), f, "should unmarshal correct value for float64")
+	var b JSONNullable[bool]
+	err = b.UnmarshalText([]byte(`true`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewJSONNullable(true), b, "should unmarshal correct value for bool")
+}
+
+func (suite *JSONNullableUnmarshalTextSuite) TestOutOfRange() {
+	var v JSONNullable[uint8]
+	err := v.UnmarshalText([]byte(`256`))
+	var rangeErr *RangeError
+	suite.ErrorAs(err, &rangeErr, "should return RangeError")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *JSONNullableUnmarshalTextSuite) TestUnmarshalFail() {
+	var v JSONNullable[time.Time]
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *JSONNullableUnmarshalTextSuite) TestOK() {
+	var v JSONNullable[time.Time]
+	err := v.UnmarshalText([]byte(`2022-07-01T12:30:00Z`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.True(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC).Equal(v.V), "should unmarshal correct value")
+}
+
+func TestJSONNullable_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(JSONNullableUnmarshalTextSuite))
+}
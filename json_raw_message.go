@@ -1,9 +1,14 @@
 package nulls
 
 import (
+	"bytes"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // JSONRawMessage holds a json.RawMessage. Keep in mind, that the JSON NULL
@@ -23,6 +28,106 @@ func NewJSONRawMessage(raw json.RawMessage) JSONRawMessage {
 	}
 }
 
+// JSONRawMessageFromPtr returns a JSONRawMessage that is valid if the given
+// pointer is not nil.
+func JSONRawMessageFromPtr(v *json.RawMessage) JSONRawMessage {
+	if v == nil {
+		return JSONRawMessage{}
+	}
+	return NewJSONRawMessage(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (rm JSONRawMessage) Ptr() *json.RawMessage {
+	if !rm.Valid {
+		return nil
+	}
+	v := rm.RawMessage
+	return &v
+}
+
+// JSONRawMessageFromSQLNull returns a JSONRawMessage from the given sql.Null.
+func JSONRawMessageFromSQLNull(v sql.Null[json.RawMessage]) JSONRawMessage {
+	return JSONRawMessage{
+		RawMessage: v.V,
+		Valid:      v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (rm JSONRawMessage) ToSQLNull() sql.Null[json.RawMessage] {
+	return sql.Null[json.RawMessage]{
+		V:     rm.RawMessage,
+		Valid: rm.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (rm JSONRawMessage) Get() (json.RawMessage, bool) {
+	return rm.RawMessage, rm.Valid
+}
+
+// IsNull returns true if not valid.
+func (rm JSONRawMessage) IsNull() bool {
+	return !rm.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (rm *JSONRawMessage) Set(v json.RawMessage) {
+	*rm = NewJSONRawMessage(v)
+}
+
+// SetNull sets a NULL-value.
+func (rm *JSONRawMessage) SetNull() {
+	*rm = JSONRawMessage{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (rm JSONRawMessage) IsZero() bool {
+	return !rm.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (rm JSONRawMessage) Equal(other JSONRawMessage) bool {
+	if !rm.Valid || !other.Valid {
+		return rm.Valid == other.Valid
+	}
+	return bytes.Equal(rm.RawMessage, other.RawMessage)
+}
+
+// String returns the value as string or NullToken if not valid.
+func (rm JSONRawMessage) String() string {
+	return formatString(string(rm.RawMessage), rm.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted as string using the
+// given verb or NullToken is written if not valid. For %#v, GoString is used.
+func (rm JSONRawMessage) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, rm, string(rm.RawMessage), rm.Valid)
+}
+
+// GoString returns a Go expression creating the JSONRawMessage.
+func (rm JSONRawMessage) GoString() string {
+	if !rm.Valid {
+		return "nulls.JSONRawMessage{}"
+	}
+	return fmt.Sprintf("nulls.NewJSONRawMessage(json.RawMessage(%q))", rm.RawMessage)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged. The
+// json.RawMessage is logged as is, so that JSON handlers embed it. If
+// RedactLogBytes is set, only the length is logged.
+func (rm JSONRawMessage) LogValue() slog.Value {
+	if !rm.Valid {
+		return logNullValue()
+	}
+	if RedactLogBytes {
+		return logBytesValue(rm.RawMessage)
+	}
+	return slog.AnyValue(rm.RawMessage)
+}
+
 // MarshalJSON marshals the RawMessage. If not valid, a NULL-value is returned.
 func (rm JSONRawMessage) MarshalJSON() ([]byte, error) {
 	if !rm.Valid {
@@ -35,19 +140,81 @@ func (rm JSONRawMessage) MarshalJSON() ([]byte, error) {
 func (rm *JSONRawMessage) UnmarshalJSON(data []byte) error {
 	// Do NOT use regular NULL-check here.
 	if isNull(data) {
-		rm.Valid = false
-		rm.RawMessage = nil
+		rm.SetNull()
 		return nil
 	}
 	rm.Valid = true
 	return json.Unmarshal(data, &rm.RawMessage)
 }
 
+// MarshalText returns the RawMessage as is. If not valid, empty text is
+// returned.
+func (rm JSONRawMessage) MarshalText() ([]byte, error) {
+	if !rm.Valid {
+		return []byte{}, nil
+	}
+	return copyBytes(rm.RawMessage), nil
+}
+
+// UnmarshalText as json.RawMessage or sets Valid to false if empty or the JSON
+// NULL value. The text must be valid JSON.
+func (rm *JSONRawMessage) UnmarshalText(text []byte) error {
+	if len(text) == 0 || isNull(text) {
+		rm.SetNull()
+		return nil
+	}
+	if !json.Valid(text) {
+		return errors.New("invalid json")
+	}
+	rm.Valid = true
+	rm.RawMessage = copyBytes(text)
+	return nil
+}
+
+// MarshalYAML marshals the RawMessage as YAML. If not valid, a NULL-value is
+// returned.
+func (rm JSONRawMessage) MarshalYAML() (any, error) {
+	if !rm.Valid {
+		return nil, nil
+	}
+	// JSON is valid YAML, so we can parse it directly. However, we reset the
+	// style for having regular YAML output instead of JSON-style.
+	var node yaml.Node
+	err := yaml.Unmarshal(rm.RawMessage, &node)
+	if err != nil {
+		return nil, err
+	}
+	resetYAMLStyle(&node)
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		return node.Content[0], nil
+	}
+	return &node, nil
+}
+
+// UnmarshalYAML as json.RawMessage or sets Valid to false if null.
+func (rm *JSONRawMessage) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		rm.SetNull()
+		return nil
+	}
+	var v any
+	err := node.Decode(&v)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	rm.Valid = true
+	rm.RawMessage = raw
+	return nil
+}
+
 // Scan to json.RawMessage value or not valid if nil.
 func (rm *JSONRawMessage) Scan(src any) error {
 	if src == nil {
-		rm.Valid = false
-		rm.RawMessage = nil
+		rm.SetNull()
 		return nil
 	}
 	rm.Valid = true
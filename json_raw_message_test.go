@@ -1,9 +1,12 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -89,6 +92,68 @@ func TestJSONRawMessage_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(JSONRawMessageUnmarshalJSONSuite))
 }
 
+// JSONRawMessageMarshalYAMLSuite tests JSONRawMessage.MarshalYAML.
+type JSONRawMessageMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONRawMessageMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(JSONRawMessage{RawMessage: json.RawMessage(`{"a": [1, 2]}`)})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *JSONRawMessageMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewJSONRawMessage(json.RawMessage(`{"a": [1, 2]}`)))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("a:\n    - 1\n    - 2\n", string(raw), "should return correct value")
+}
+
+func TestJSONRawMessage_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(JSONRawMessageMarshalYAMLSuite))
+}
+
+// JSONRawMessageUnmarshalYAMLSuite tests JSONRawMessage.UnmarshalYAML.
+type JSONRawMessageUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONRawMessageUnmarshalYAMLSuite) TestNull() {
+	v := NewJSONRawMessage(json.RawMessage(`{"a": [1, 2]}`))
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *JSONRawMessageUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V JSONRawMessage `yaml:"v"`
+		W JSONRawMessage `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *JSONRawMessageUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v JSONRawMessage
+	err := yaml.Unmarshal([]byte(`!!int meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *JSONRawMessageUnmarshalYAMLSuite) TestOK() {
+	var v JSONRawMessage
+	err := yaml.Unmarshal([]byte(`a: [1, 2]`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.JSONEq(`{"a": [1, 2]}`, string(v.RawMessage), "should unmarshal correct value")
+}
+
+func TestJSONRawMessage_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(JSONRawMessageUnmarshalYAMLSuite))
+}
+
 // JSONRawMessageScanSuite tests JSONRawMessage.Scan.
 type JSONRawMessageScanSuite struct {
 	suite.Suite
@@ -166,3 +231,199 @@ func (suite *JSONRawMessageValueSuite) TestOK() {
 func TestJSONRawMessage_Value(t *testing.T) {
 	suite.Run(t, new(JSONRawMessageValueSuite))
 }
+
+// TestJSONRawMessage_Get tests JSONRawMessage.Get.
+func TestJSONRawMessage_Get(t *testing.T) {
+	v, ok := NewJSONRawMessage(json.RawMessage(`"Hello World!"`)).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, json.RawMessage(`"Hello World!"`), v, "should return correct value")
+	_, ok = JSONRawMessage{RawMessage: json.RawMessage(`"Hello World!"`)}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestJSONRawMessage_IsZero tests JSONRawMessage.IsZero.
+func TestJSONRawMessage_IsZero(t *testing.T) {
+	assert.False(t, NewJSONRawMessage(json.RawMessage(`"Hello World!"`)).IsZero(), "should not be zero")
+	assert.True(t, JSONRawMessage{RawMessage: json.RawMessage(`"Hello World!"`)}.IsZero(), "should be zero")
+}
+
+// TestJSONRawMessage_Equal tests JSONRawMessage.Equal.
+func TestJSONRawMessage_Equal(t *testing.T) {
+	assert.True(t, NewJSONRawMessage(json.RawMessage(`{"a":1}`)).Equal(NewJSONRawMessage(json.RawMessage(`{"a":1}`))), "should be equal")
+	assert.False(t, NewJSONRawMessage(json.RawMessage(`{"a":1}`)).Equal(NewJSONRawMessage(json.RawMessage(`{"a":2}`))), "should not be equal")
+	assert.False(t, NewJSONRawMessage(json.RawMessage(`{"a":1}`)).Equal(JSONRawMessage{RawMessage: json.RawMessage(`{"a":1}`)}), "should not be equal")
+	assert.False(t, JSONRawMessage{RawMessage: json.RawMessage(`{"a":1}`)}.Equal(NewJSONRawMessage(json.RawMessage(`{"a":1}`))), "should not be equal")
+	assert.True(t, JSONRawMessage{RawMessage: json.RawMessage(`{"a":1}`)}.Equal(JSONRawMessage{}), "should be equal")
+}
+
+// TestJSONRawMessage_String tests JSONRawMessage.String.
+func TestJSONRawMessage_String(t *testing.T) {
+	assert.Equal(t, `{"a":1}`, NewJSONRawMessage(json.RawMessage(`{"a":1}`)).String(), "should return correct value")
+	assert.Equal(t, NullToken, JSONRawMessage{RawMessage: json.RawMessage(`{"a":1}`)}.String(), "should return null token")
+}
+
+// JSONRawMessageFormatSuite tests JSONRawMessage.Format.
+type JSONRawMessageFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONRawMessageFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", JSONRawMessage{RawMessage: json.RawMessage(`{"a":1}`)}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", JSONRawMessage{RawMessage: json.RawMessage(`{"a":1}`)}), "should respect width")
+}
+
+func (suite *JSONRawMessageFormatSuite) TestValue() {
+	suite.Equal(`{"a":1}`, fmt.Sprintf("%v", NewJSONRawMessage(json.RawMessage(`{"a":1}`))), "should return correct value")
+}
+
+func (suite *JSONRawMessageFormatSuite) TestVerb() {
+	suite.Equal(`{"a":1}`, fmt.Sprintf("%s", NewJSONRawMessage(json.RawMessage(`{"a":1}`))), "should return correct value")
+}
+
+func (suite *JSONRawMessageFormatSuite) TestGoSyntax() {
+	suite.Equal(NewJSONRawMessage(json.RawMessage(`{"a":1}`)).GoString(), fmt.Sprintf("%#v", NewJSONRawMessage(json.RawMessage(`{"a":1}`))), "should use GoString")
+}
+
+func TestJSONRawMessage_Format(t *testing.T) {
+	suite.Run(t, new(JSONRawMessageFormatSuite))
+}
+
+// TestJSONRawMessage_GoString tests JSONRawMessage.GoString.
+func TestJSONRawMessage_GoString(t *testing.T) {
+	assert.Equal(t, `nulls.NewJSONRawMessage(json.RawMessage("{\"a\":1}"))`, NewJSONRawMessage(json.RawMessage(`{"a":1}`)).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.JSONRawMessage{}", JSONRawMessage{RawMessage: json.RawMessage(`{"a":1}`)}.GoString(), "should return correct value")
+}
+
+// TestJSONRawMessage_LogValue tests JSONRawMessage.LogValue.
+func TestJSONRawMessage_LogValue(t *testing.T) {
+	assert.Equal(t, json.RawMessage(`{"a":1}`), NewJSONRawMessage(json.RawMessage(`{"a":1}`)).LogValue().Any(), "should return correct value")
+	assert.Nil(t, JSONRawMessage{RawMessage: json.RawMessage(`{"a":1}`)}.LogValue().Any(), "should return null value")
+}
+
+// JSONRawMessageFromPtrSuite tests JSONRawMessageFromPtr.
+type JSONRawMessageFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONRawMessageFromPtrSuite) TestNil() {
+	v := JSONRawMessageFromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *JSONRawMessageFromPtrSuite) TestZero() {
+	x := json.RawMessage{}
+	v := JSONRawMessageFromPtr(&x)
+	suite.Equal(NewJSONRawMessage(x), v, "should return correct value")
+}
+
+func (suite *JSONRawMessageFromPtrSuite) TestOK() {
+	x := json.RawMessage(`"Hello World!"`)
+	v := JSONRawMessageFromPtr(&x)
+	suite.Equal(NewJSONRawMessage(x), v, "should return correct value")
+}
+
+func TestJSONRawMessageFromPtr(t *testing.T) {
+	suite.Run(t, new(JSONRawMessageFromPtrSuite))
+}
+
+// JSONRawMessagePtrSuite tests JSONRawMessage.Ptr.
+type JSONRawMessagePtrSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONRawMessagePtrSuite) TestNotValid() {
+	v := JSONRawMessage{RawMessage: json.RawMessage(`"Hello World!"`)}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *JSONRawMessagePtrSuite) TestZero() {
+	p := NewJSONRawMessage(json.RawMessage{}).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(json.RawMessage{}, *p, "should return correct value")
+}
+
+func (suite *JSONRawMessagePtrSuite) TestOK() {
+	v := NewJSONRawMessage(json.RawMessage(`"Hello World!"`))
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(json.RawMessage(`"Hello World!"`), *p, "should return correct value")
+	*p = json.RawMessage{}
+	suite.Equal(json.RawMessage(`"Hello World!"`), v.RawMessage, "should return copy")
+}
+
+func TestJSONRawMessage_Ptr(t *testing.T) {
+	suite.Run(t, new(JSONRawMessagePtrSuite))
+}
+
+// TestJSONRawMessageFromSQLNull tests JSONRawMessageFromSQLNull.
+func TestJSONRawMessageFromSQLNull(t *testing.T) {
+	assert.Equal(t, JSONRawMessage{}, JSONRawMessageFromSQLNull(sql.Null[json.RawMessage]{}), "should return correct value")
+	assert.Equal(t, NewJSONRawMessage(json.RawMessage(`"Hello World!"`)), JSONRawMessageFromSQLNull(sql.Null[json.RawMessage]{V: json.RawMessage(`"Hello World!"`), Valid: true}), "should return correct value")
+}
+
+// TestJSONRawMessage_ToSQLNull tests JSONRawMessage.ToSQLNull.
+func TestJSONRawMessage_ToSQLNull(t *testing.T) {
+	assert.False(t, JSONRawMessage{RawMessage: json.RawMessage(`"Hello World!"`)}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[json.RawMessage]{V: json.RawMessage(`"Hello World!"`), Valid: true}, NewJSONRawMessage(json.RawMessage(`"Hello World!"`)).ToSQLNull(), "should return correct value")
+}
+
+// JSONRawMessageMarshalTextSuite tests JSONRawMessage.MarshalText.
+type JSONRawMessageMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONRawMessageMarshalTextSuite) TestNotValid() {
+	v := JSONRawMessage{RawMessage: json.RawMessage(`{"a":1}`)}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *JSONRawMessageMarshalTextSuite) TestOK() {
+	text, err := NewJSONRawMessage(json.RawMessage(`{"a":1}`)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`{"a":1}`, string(text), "should return correct value")
+}
+
+func TestJSONRawMessage_MarshalText(t *testing.T) {
+	suite.Run(t, new(JSONRawMessageMarshalTextSuite))
+}
+
+// JSONRawMessageUnmarshalTextSuite tests JSONRawMessage.UnmarshalText.
+type JSONRawMessageUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *JSONRawMessageUnmarshalTextSuite) TestEmpty() {
+	v := NewJSONRawMessage(json.RawMessage(`{"a":1}`))
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *JSONRawMessageUnmarshalTextSuite) TestUnmarshalFail() {
+	var v JSONRawMessage
+	err := v.UnmarshalText([]byte(`{meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *JSONRawMessageUnmarshalTextSuite) TestOK() {
+	var v JSONRawMessage
+	err := v.UnmarshalText([]byte(`[1, 2]`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(json.RawMessage(`[1, 2]`), v.RawMessage, "should unmarshal correct value")
+}
+
+func TestJSONRawMessage_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(JSONRawMessageUnmarshalTextSuite))
+}
+
+// TestJSONRawMessage_UnmarshalText_null tests that JSONRawMessage.UnmarshalText
+// treats the JSON NULL value as NULL.
+func TestJSONRawMessage_UnmarshalText_null(t *testing.T) {
+	v := NewJSONRawMessage(json.RawMessage(`{}`))
+	err := v.UnmarshalText([]byte("null"))
+	assert.NoError(t, err, "should not fail")
+	assert.False(t, v.Valid, "should not be valid")
+}
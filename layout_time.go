@@ -0,0 +1,280 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"time"
+)
+
+// TimeLayout provides the layout for LayoutTime as used by time.Parse and
+// time.Time.Format. Implementations are usually empty structs.
+type TimeLayout interface {
+	// Layout returns the layout like time.DateOnly.
+	Layout() string
+}
+
+// DateLayout is a TimeLayout for dates in the format 2006-01-02.
+type DateLayout struct{}
+
+// Layout returns time.DateOnly.
+func (DateLayout) Layout() string {
+	return time.DateOnly
+}
+
+// LayoutTime holds a nullable time.Time that is represented as string with the
+// layout provided by L. Times are parsed in UTC unless the layout contains time
+// zone information. Use LayoutTime[DateLayout] for dates or provide a custom
+// TimeLayout.
+type LayoutTime[L TimeLayout] struct {
+	// Time is the actual value when Valid.
+	Time time.Time `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewLayoutTime returns a valid LayoutTime with the given value.
+func NewLayoutTime[L TimeLayout](t time.Time) LayoutTime[L] {
+	return LayoutTime[L]{
+		Time:  t,
+		Valid: true,
+	}
+}
+
+// LayoutTimeFromPtr returns a LayoutTime that is valid if the given pointer is
+// not nil.
+func LayoutTimeFromPtr[L TimeLayout](v *time.Time) LayoutTime[L] {
+	if v == nil {
+		return LayoutTime[L]{}
+	}
+	return NewLayoutTime[L](*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (t LayoutTime[L]) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
+// LayoutTimeFromSQL returns a LayoutTime from the given sql.NullTime.
+func LayoutTimeFromSQL[L TimeLayout](v sql.NullTime) LayoutTime[L] {
+	return LayoutTime[L]{
+		Time:  v.Time,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullTime representation.
+func (t LayoutTime[L]) ToSQL() sql.NullTime {
+	return sql.NullTime{
+		Time:  t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// LayoutTimeFromSQLNull returns a LayoutTime from the given sql.Null.
+func LayoutTimeFromSQLNull[L TimeLayout](v sql.Null[time.Time]) LayoutTime[L] {
+	return LayoutTime[L]{
+		Time:  v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (t LayoutTime[L]) ToSQLNull() sql.Null[time.Time] {
+	return sql.Null[time.Time]{
+		V:     t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (t LayoutTime[L]) Get() (time.Time, bool) {
+	return t.Time, t.Valid
+}
+
+// IsNull returns true if not valid.
+func (t LayoutTime[L]) IsNull() bool {
+	return !t.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (t *LayoutTime[L]) Set(v time.Time) {
+	*t = NewLayoutTime[L](v)
+}
+
+// SetNull sets a NULL-value.
+func (t *LayoutTime[L]) SetNull() {
+	*t = LayoutTime[L]{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (t LayoutTime[L]) IsZero() bool {
+	return !t.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+// Times are compared using time.Time.Equal.
+func (t LayoutTime[L]) Equal(other LayoutTime[L]) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+	return t.Time.Equal(other.Time)
+}
+
+// layout returns the layout provided by L.
+func (t LayoutTime[L]) layout() string {
+	var l L
+	return l.Layout()
+}
+
+// String returns the value formatted with the layout or NullToken if not valid.
+func (t LayoutTime[L]) String() string {
+	if !t.Valid {
+		return NullToken
+	}
+	return t.Time.Format(t.layout())
+}
+
+// Format implements fmt.Formatter. The value formatted with the layout is
+// formatted using the given verb or NullToken is written if not valid. For %#v,
+// GoString is used.
+func (t LayoutTime[L]) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, t, t.Time.Format(t.layout()), t.Valid)
+}
+
+// GoString returns a Go expression creating the LayoutTime.
+func (t LayoutTime[L]) GoString() string {
+	var l L
+	if !t.Valid {
+		return fmt.Sprintf("nulls.LayoutTime[%T]{}", l)
+	}
+	return fmt.Sprintf("nulls.NewLayoutTime[%T](%#v)", l, t.Time)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (t LayoutTime[L]) LogValue() slog.Value {
+	if !t.Valid {
+		return logNullValue()
+	}
+	return slog.StringValue(t.Time.Format(t.layout()))
+}
+
+// MarshalJSON marshals the time as string with the layout. If not valid, a
+// NULL-value is returned.
+func (t LayoutTime[L]) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(t.Time.Format(t.layout()))
+}
+
+// UnmarshalJSON as string with the layout or sets Valid to false if null.
+func (t *LayoutTime[L]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		t.SetNull()
+		return nil
+	}
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	return t.parse(s)
+}
+
+// MarshalText marshals the time with the layout. If not valid, empty text is
+// returned.
+func (t LayoutTime[L]) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return []byte(t.Time.Format(t.layout())), nil
+}
+
+// UnmarshalText with the layout or sets Valid to false if empty.
+func (t *LayoutTime[L]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		t.SetNull()
+		return nil
+	}
+	return t.parse(string(text))
+}
+
+// MarshalYAML marshals the time as string with the layout. If not valid, a
+// NULL-value is returned.
+func (t LayoutTime[L]) MarshalYAML() (any, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.Format(t.layout()), nil
+}
+
+// UnmarshalYAML as string with the layout or sets Valid to false if null.
+func (t *LayoutTime[L]) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		t.SetNull()
+		return nil
+	}
+	var s string
+	err := node.Decode(&s)
+	if err != nil {
+		return err
+	}
+	return t.parse(s)
+}
+
+// Scan to time.Time value or not valid if nil. Strings are parsed with the
+// layout and time.Time values are accepted as well.
+func (t *LayoutTime[L]) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		t.SetNull()
+		return nil
+	case time.Time:
+		t.Valid = true
+		t.Time = src
+		return nil
+	case string:
+		return t.parse(src)
+	case []byte:
+		return t.parse(string(src))
+	default:
+		return fmt.Errorf("unsupported source value type: %T", src)
+	}
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The time
+// is returned as string with the layout.
+func (t LayoutTime[L]) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.Format(t.layout()), nil
+}
+
+// UTC returns the UTC time.
+func (t LayoutTime[L]) UTC() LayoutTime[L] {
+	return LayoutTime[L]{
+		Time:  t.Time.UTC(),
+		Valid: t.Valid,
+	}
+}
+
+// parse parses the given string with the layout and sets the LayoutTime valid.
+func (t *LayoutTime[L]) parse(s string) error {
+	v, err := time.Parse(t.layout(), s)
+	if err != nil {
+		return err
+	}
+	t.Valid = true
+	t.Time = v
+	return nil
+}
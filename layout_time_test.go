@@ -0,0 +1,334 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+	"time"
+)
+
+// testDate is 2022-07-01.
+var testDate = time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)
+
+// testDateTimeLayout is a TimeLayout with date and time.
+type testDateTimeLayout struct{}
+
+func (testDateTimeLayout) Layout() string {
+	return time.DateTime
+}
+
+// TestDateLayout_Layout tests DateLayout.Layout.
+func TestDateLayout_Layout(t *testing.T) {
+	assert.Equal(t, "2006-01-02", DateLayout{}.Layout(), "should return correct value")
+}
+
+// TestNewLayoutTime tests NewLayoutTime.
+func TestNewLayoutTime(t *testing.T) {
+	tt := NewLayoutTime[DateLayout](testDate)
+	assert.True(t, tt.Valid, "should be valid")
+	assert.Equal(t, testDate, tt.Time, "should have set correct value")
+}
+
+// LayoutTimeMarshalJSONSuite tests LayoutTime.MarshalJSON.
+type LayoutTimeMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *LayoutTimeMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(LayoutTime[DateLayout]{Time: testDate})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *LayoutTimeMarshalJSONSuite) TestOK() {
+	raw, err := json.Marshal(NewLayoutTime[DateLayout](testDate.Add(12 * time.Hour)))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`"2022-07-01"`, string(raw), "should return correct value")
+}
+
+func (suite *LayoutTimeMarshalJSONSuite) TestCustomLayout() {
+	raw, err := json.Marshal(NewLayoutTime[testDateTimeLayout](testDate.Add(12 * time.Hour)))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`"2022-07-01 12:00:00"`, string(raw), "should return correct value")
+}
+
+func TestLayoutTime_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(LayoutTimeMarshalJSONSuite))
+}
+
+// LayoutTimeUnmarshalJSONSuite tests LayoutTime.UnmarshalJSON.
+type LayoutTimeUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *LayoutTimeUnmarshalJSONSuite) TestNull() {
+	tt := NewLayoutTime[DateLayout](testDate)
+	err := json.Unmarshal(jsonNull, &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *LayoutTimeUnmarshalJSONSuite) TestUnmarshalFail() {
+	var tt LayoutTime[DateLayout]
+	err := json.Unmarshal([]byte(`20220701`), &tt)
+	suite.Error(err, "should fail")
+}
+
+func (suite *LayoutTimeUnmarshalJSONSuite) TestParseFail() {
+	var tt LayoutTime[DateLayout]
+	err := json.Unmarshal([]byte(`"2022-07-01T00:00:00Z"`), &tt)
+	suite.Error(err, "should fail")
+}
+
+func (suite *LayoutTimeUnmarshalJSONSuite) TestOK() {
+	var tt LayoutTime[DateLayout]
+	err := json.Unmarshal([]byte(`"2022-07-01"`), &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testDate, tt.Time, "should unmarshal correct value")
+}
+
+func (suite *LayoutTimeUnmarshalJSONSuite) TestCustomLayout() {
+	var tt LayoutTime[testDateTimeLayout]
+	err := json.Unmarshal([]byte(`"2022-07-01 12:00:00"`), &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testDate.Add(12*time.Hour), tt.Time, "should unmarshal correct value")
+}
+
+func TestLayoutTime_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(LayoutTimeUnmarshalJSONSuite))
+}
+
+// LayoutTimeMarshalTextSuite tests LayoutTime.MarshalText.
+type LayoutTimeMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *LayoutTimeMarshalTextSuite) TestNotValid() {
+	text, err := LayoutTime[DateLayout]{Time: testDate}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *LayoutTimeMarshalTextSuite) TestOK() {
+	text, err := NewLayoutTime[DateLayout](testDate).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`2022-07-01`, string(text), "should return correct value")
+}
+
+func TestLayoutTime_MarshalText(t *testing.T) {
+	suite.Run(t, new(LayoutTimeMarshalTextSuite))
+}
+
+// LayoutTimeUnmarshalTextSuite tests LayoutTime.UnmarshalText.
+type LayoutTimeUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *LayoutTimeUnmarshalTextSuite) TestEmpty() {
+	tt := NewLayoutTime[DateLayout](testDate)
+	err := tt.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *LayoutTimeUnmarshalTextSuite) TestUnmarshalFail() {
+	var tt LayoutTime[DateLayout]
+	err := tt.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *LayoutTimeUnmarshalTextSuite) TestOK() {
+	var tt LayoutTime[DateLayout]
+	err := tt.UnmarshalText([]byte(`2022-07-01`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testDate, tt.Time, "should unmarshal correct value")
+}
+
+func TestLayoutTime_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(LayoutTimeUnmarshalTextSuite))
+}
+
+// LayoutTimeMarshalYAMLSuite tests LayoutTime.MarshalYAML.
+type LayoutTimeMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *LayoutTimeMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(LayoutTime[DateLayout]{Time: testDate})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *LayoutTimeMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewLayoutTime[DateLayout](testDate))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("\"2022-07-01\"\n", string(raw), "should return correct value")
+}
+
+func TestLayoutTime_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(LayoutTimeMarshalYAMLSuite))
+}
+
+// LayoutTimeUnmarshalYAMLSuite tests LayoutTime.UnmarshalYAML.
+type LayoutTimeUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *LayoutTimeUnmarshalYAMLSuite) TestNull() {
+	tt := NewLayoutTime[DateLayout](testDate)
+	err := tt.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *LayoutTimeUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var tt LayoutTime[DateLayout]
+	err := yaml.Unmarshal([]byte(`meow`), &tt)
+	suite.Error(err, "should fail")
+}
+
+func (suite *LayoutTimeUnmarshalYAMLSuite) TestOK() {
+	for _, src := range []string{`2022-07-01`, `"2022-07-01"`} {
+		var tt LayoutTime[DateLayout]
+		err := yaml.Unmarshal([]byte(src), &tt)
+		suite.Require().NoErrorf(err, "should not fail for %s", src)
+		suite.Truef(tt.Valid, "should be valid for %s", src)
+		suite.Equalf(testDate, tt.Time, "should unmarshal correct value for %s", src)
+	}
+}
+
+func TestLayoutTime_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(LayoutTimeUnmarshalYAMLSuite))
+}
+
+// LayoutTimeScanSuite tests LayoutTime.Scan.
+type LayoutTimeScanSuite struct {
+	suite.Suite
+}
+
+func (suite *LayoutTimeScanSuite) TestNull() {
+	tt := NewLayoutTime[DateLayout](testDate)
+	err := tt.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *LayoutTimeScanSuite) TestUnsupported() {
+	var tt LayoutTime[DateLayout]
+	err := tt.Scan(16)
+	suite.Error(err, "should fail")
+}
+
+func (suite *LayoutTimeScanSuite) TestParseFail() {
+	var tt LayoutTime[DateLayout]
+	err := tt.Scan("meow")
+	suite.Error(err, "should fail")
+}
+
+func (suite *LayoutTimeScanSuite) TestOK() {
+	for _, src := range []any{"2022-07-01", []byte("2022-07-01"), testDate} {
+		var tt LayoutTime[DateLayout]
+		err := tt.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %T", src)
+		suite.Truef(tt.Valid, "should be valid for %T", src)
+		suite.Equalf(testDate, tt.Time, "should scan correct value for %T", src)
+	}
+}
+
+func TestLayoutTime_Scan(t *testing.T) {
+	suite.Run(t, new(LayoutTimeScanSuite))
+}
+
+// LayoutTimeValueSuite tests LayoutTime.Value.
+type LayoutTimeValueSuite struct {
+	suite.Suite
+}
+
+func (suite *LayoutTimeValueSuite) TestNull() {
+	raw, err := LayoutTime[DateLayout]{Time: testDate}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *LayoutTimeValueSuite) TestOK() {
+	raw, err := NewLayoutTime[DateLayout](testDate).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("2022-07-01", raw, "should return correct value")
+}
+
+func TestLayoutTime_Value(t *testing.T) {
+	suite.Run(t, new(LayoutTimeValueSuite))
+}
+
+// TestLayoutTime_UTC tests LayoutTime.UTC.
+func TestLayoutTime_UTC(t *testing.T) {
+	tt := NewLayoutTime[DateLayout](testDate.In(time.FixedZone("CEST", 2*60*60)))
+	assert.Equal(t, NewLayoutTime[DateLayout](testDate), tt.UTC(), "should return correct value")
+}
+
+// TestLayoutTime_Get tests LayoutTime.Get.
+func TestLayoutTime_Get(t *testing.T) {
+	v, ok := NewLayoutTime[DateLayout](testDate).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, testDate, v, "should return correct value")
+	_, ok = LayoutTime[DateLayout]{Time: testDate}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestLayoutTime_IsZero tests LayoutTime.IsZero.
+func TestLayoutTime_IsZero(t *testing.T) {
+	assert.False(t, NewLayoutTime[DateLayout](testDate).IsZero(), "should not be zero")
+	assert.True(t, LayoutTime[DateLayout]{Time: testDate}.IsZero(), "should be zero")
+}
+
+// TestLayoutTime_Equal tests LayoutTime.Equal.
+func TestLayoutTime_Equal(t *testing.T) {
+	a := NewLayoutTime[DateLayout](testDate)
+	assert.True(t, a.Equal(NewLayoutTime[DateLayout](testDate)), "should be equal")
+	assert.False(t, a.Equal(NewLayoutTime[DateLayout](testDate.AddDate(0, 0, 1))), "should not be equal")
+	assert.False(t, a.Equal(LayoutTime[DateLayout]{Time: testDate}), "should not be equal")
+	assert.True(t, LayoutTime[DateLayout]{Time: testDate}.Equal(LayoutTime[DateLayout]{}), "should be equal")
+}
+
+// TestLayoutTime_Format tests LayoutTime.Format, String and GoString.
+func TestLayoutTime_Format(t *testing.T) {
+	assert.Equal(t, "2022-07-01", NewLayoutTime[DateLayout](testDate).String(), "should return correct value")
+	assert.Equal(t, NullToken, LayoutTime[DateLayout]{}.String(), "should return null token")
+	assert.Equal(t, `"2022-07-01"`, fmt.Sprintf("%q", NewLayoutTime[DateLayout](testDate)), "should return correct value")
+	assert.Equal(t, NullToken, fmt.Sprintf("%v", LayoutTime[DateLayout]{}), "should return null token")
+	assert.Equal(t, "nulls.NewLayoutTime[nulls.DateLayout](time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC))",
+		fmt.Sprintf("%#v", NewLayoutTime[DateLayout](testDate)), "should return correct value")
+	assert.Equal(t, "nulls.LayoutTime[nulls.DateLayout]{}", LayoutTime[DateLayout]{}.GoString(), "should return correct value")
+}
+
+// TestLayoutTime_LogValue tests LayoutTime.LogValue.
+func TestLayoutTime_LogValue(t *testing.T) {
+	assert.Equal(t, "2022-07-01", NewLayoutTime[DateLayout](testDate).LogValue().Any(), "should return correct value")
+	assert.Nil(t, LayoutTime[DateLayout]{Time: testDate}.LogValue().Any(), "should return null value")
+}
+
+// TestLayoutTimeFromPtr tests LayoutTimeFromPtr and LayoutTime.Ptr.
+func TestLayoutTimeFromPtr(t *testing.T) {
+	assert.False(t, LayoutTimeFromPtr[DateLayout](nil).Valid, "should not be valid")
+	assert.Nil(t, LayoutTime[DateLayout]{Time: testDate}.Ptr(), "should return nil")
+	v := testDate
+	p := LayoutTimeFromPtr[DateLayout](&v).Ptr()
+	assert.Equal(t, &v, p, "should return correct value")
+}
+
+// TestLayoutTimeFromSQL tests LayoutTimeFromSQL and LayoutTime.ToSQL.
+func TestLayoutTimeFromSQL(t *testing.T) {
+	v := sql.NullTime{Time: testDate, Valid: true}
+	assert.Equal(t, NewLayoutTime[DateLayout](testDate), LayoutTimeFromSQL[DateLayout](v), "should return correct value")
+	assert.Equal(t, v, NewLayoutTime[DateLayout](testDate).ToSQL(), "should return correct value")
+	n := sql.Null[time.Time]{V: testDate, Valid: true}
+	assert.Equal(t, NewLayoutTime[DateLayout](testDate), LayoutTimeFromSQLNull[DateLayout](n), "should return correct value")
+	assert.Equal(t, n, NewLayoutTime[DateLayout](testDate).ToSQLNull(), "should return correct value")
+}
@@ -0,0 +1,22 @@
+package nulls
+
+import "log/slog"
+
+// RedactLogBytes controls whether LogValue of ByteSlice, Bytes and
+// JSONRawMessage only logs the length instead of the content. This is useful
+// if they may hold sensitive data.
+var RedactLogBytes = false
+
+// logNullValue is the slog.Value for NULL-values.
+func logNullValue() slog.Value {
+	return slog.AnyValue(nil)
+}
+
+// logBytesValue returns the slog.Value for the given bytes with respect to
+// RedactLogBytes.
+func logBytesValue(b []byte) slog.Value {
+	if RedactLogBytes {
+		return slog.GroupValue(slog.Int("len", len(b)))
+	}
+	return slog.AnyValue(b)
+}
@@ -0,0 +1,47 @@
+package nulls
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"log/slog"
+	"testing"
+)
+
+// LogValueSuite tests logging with slog.
+type LogValueSuite struct {
+	suite.Suite
+	buf    bytes.Buffer
+	logger *slog.Logger
+}
+
+func (suite *LogValueSuite) SetupTest() {
+	suite.buf.Reset()
+	suite.logger = slog.New(slog.NewJSONHandler(&suite.buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+}
+
+func (suite *LogValueSuite) TestValues() {
+	suite.logger.Info("", "int", NewInt(16), "null", Int{}, "string", NewString("meow"),
+		"optional", NewOptional(NewBool(true)), "raw", NewJSONRawMessage(json.RawMessage(`{"a":1}`)))
+	suite.JSONEq(`{"int": 16, "null": null, "string": "meow", "optional": true, "raw": {"a": 1}}`,
+		suite.buf.String(), "should log correct values")
+}
+
+func (suite *LogValueSuite) TestRedact() {
+	defer func(redact bool) { RedactLogBytes = redact }(RedactLogBytes)
+	RedactLogBytes = true
+	suite.logger.Info("", "bytes", NewByteSlice([]byte("meow")), "raw", NewJSONRawMessage(json.RawMessage(`{"a":1}`)),
+		"null", Bytes{})
+	suite.JSONEq(`{"bytes": {"len": 4}, "raw": {"len": 7}, "null": null}`, suite.buf.String(), "should log redacted values")
+}
+
+func TestLogValue(t *testing.T) {
+	suite.Run(t, new(LogValueSuite))
+}
@@ -0,0 +1,301 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"reflect"
+)
+
+// Primitive is satisfied by booleans, numbers and strings including named types
+// based on them like `type UserID int64`.
+type Primitive interface {
+	~bool | ~string | Integer | Float
+}
+
+// Named holds a nullable value of a named primitive type like
+// `type UserID int64`. JSON, text, YAML and database support is provided by
+// the type of the underlying kind, for example Number[int64] for int64 or
+// String for string.
+type Named[T Primitive] struct {
+	// V is the actual value when Valid.
+	V T `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewNamed returns a valid Named with the given value.
+func NewNamed[T Primitive](v T) Named[T] {
+	return Named[T]{
+		V:     v,
+		Valid: true,
+	}
+}
+
+// NamedFromPtr returns a Named that is valid if the given pointer is not nil.
+func NamedFromPtr[T Primitive](v *T) Named[T] {
+	if v == nil {
+		return Named[T]{}
+	}
+	return NewNamed(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n Named[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// NamedFromSQLNull returns a Named from the given sql.Null.
+func NamedFromSQLNull[T Primitive](v sql.Null[T]) Named[T] {
+	return Named[T]{
+		V:     v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n Named[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{
+		V:     n.V,
+		Valid: n.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (n Named[T]) Get() (T, bool) {
+	return n.V, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n Named[T]) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *Named[T]) Set(v T) {
+	*n = NewNamed(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *Named[T]) SetNull() {
+	*n = Named[T]{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n Named[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (n Named[T]) Equal(other Named[T]) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return n.V == other.V
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (n Named[T]) String() string {
+	return formatString(n.V, n.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (n Named[T]) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n, n.V, n.Valid)
+}
+
+// GoString returns a Go expression creating the Named.
+func (n Named[T]) GoString() string {
+	if !n.Valid {
+		return fmt.Sprintf("nulls.Named[%T]{}", n.V)
+	}
+	return fmt.Sprintf("nulls.NewNamed[%T](%#v)", n.V, n.V)
+}
+
+// LogValue implements slog.LogValuer. The value is logged like the type of the
+// underlying kind. If not valid, a NULL-value is logged.
+func (n Named[T]) LogValue() slog.Value {
+	return n.codec().LogValue()
+}
+
+// MarshalJSON marshals the value like the type of the underlying kind. If not
+// valid, a NULL-value is returned.
+func (n Named[T]) MarshalJSON() ([]byte, error) {
+	return n.codec().MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the value like the type of the underlying kind or
+// sets Valid to false if null.
+func (n *Named[T]) UnmarshalJSON(data []byte) error {
+	c, apply := n.bind()
+	err := c.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	apply()
+	return nil
+}
+
+// MarshalText marshals the value like the type of the underlying kind. If not
+// valid, empty text is returned.
+func (n Named[T]) MarshalText() ([]byte, error) {
+	return n.codec().MarshalText()
+}
+
+// UnmarshalText unmarshals the value like the type of the underlying kind or
+// sets Valid to false if empty.
+func (n *Named[T]) UnmarshalText(text []byte) error {
+	c, apply := n.bind()
+	err := c.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	apply()
+	return nil
+}
+
+// MarshalYAML marshals the value like the type of the underlying kind. If not
+// valid, a NULL-value is returned.
+func (n Named[T]) MarshalYAML() (any, error) {
+	return n.codec().MarshalYAML()
+}
+
+// UnmarshalYAML unmarshals the value like the type of the underlying kind or
+// sets Valid to false if null.
+func (n *Named[T]) UnmarshalYAML(node *yaml.Node) error {
+	c, apply := n.bind()
+	err := c.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	apply()
+	return nil
+}
+
+// Scan scans the value like the type of the underlying kind or sets Valid to
+// false if nil.
+func (n *Named[T]) Scan(src any) error {
+	c, apply := n.bind()
+	err := c.Scan(src)
+	if err != nil {
+		return err
+	}
+	apply()
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface like the
+// type of the underlying kind.
+func (n Named[T]) Value() (driver.Value, error) {
+	return n.codec().Value()
+}
+
+// namedCodec is implemented by pointers to the types used for the underlying
+// kinds of Named.
+type namedCodec interface {
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+	yaml.Marshaler
+	yaml.Unmarshaler
+	sql.Scanner
+	driver.Valuer
+	slog.LogValuer
+}
+
+// codec returns the type of the underlying kind holding the value of n. Bool
+// and String are used for booleans and strings and Number for all numbers.
+func (n Named[T]) codec() namedCodec {
+	c, _ := n.bind()
+	return c
+}
+
+// bind returns the type of the underlying kind holding the value of n like
+// codec. Calling the returned function writes the value and validity of the
+// codec back to n.
+func (n *Named[T]) bind() (namedCodec, func()) {
+	switch v := n.basePtr().(type) {
+	case *bool:
+		c := &Bool{Bool: *v, Valid: n.Valid}
+		return c, func() { *v, n.Valid = c.Bool, c.Valid }
+	case *string:
+		c := &String{String: *v, Valid: n.Valid}
+		return c, func() { *v, n.Valid = c.String, c.Valid }
+	case *int:
+		return bindNamedNumber(v, &n.Valid)
+	case *int8:
+		return bindNamedNumber(v, &n.Valid)
+	case *int16:
+		return bindNamedNumber(v, &n.Valid)
+	case *int32:
+		return bindNamedNumber(v, &n.Valid)
+	case *int64:
+		return bindNamedNumber(v, &n.Valid)
+	case *uint:
+		return bindNamedNumber(v, &n.Valid)
+	case *uint8:
+		return bindNamedNumber(v, &n.Valid)
+	case *uint16:
+		return bindNamedNumber(v, &n.Valid)
+	case *uint32:
+		return bindNamedNumber(v, &n.Valid)
+	case *uint64:
+		return bindNamedNumber(v, &n.Valid)
+	case *float32:
+		return bindNamedNumber(v, &n.Valid)
+	case *float64:
+		return bindNamedNumber(v, &n.Valid)
+	default:
+		// Not reachable because of the Primitive constraint.
+		panic(fmt.Sprintf("unsupported type %T", n.V))
+	}
+}
+
+// basePtr returns a pointer to V as pointer to the underlying type of T like
+// *int64 for `type UserID int64`. Named types require converting the pointer
+// type, which is not possible for type parameters without reflection.
+func (n *Named[T]) basePtr() any {
+	switch v := any(&n.V).(type) {
+	case *bool, *string, *int, *int8, *int16, *int32, *int64, *uint, *uint8, *uint16, *uint32, *uint64, *float32,
+		*float64:
+		return v
+	}
+	v := reflect.ValueOf(&n.V)
+	return v.Convert(reflect.PointerTo(namedBaseTypes[v.Elem().Kind()])).Interface()
+}
+
+// namedBaseTypes holds the unnamed types for the kinds of Primitive.
+var namedBaseTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.String:  reflect.TypeOf(""),
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+}
+
+// bindNamedNumber returns a Number holding the given value and validity and a
+// function for writing them back.
+func bindNamedNumber[T Integer | Float](v *T, valid *bool) (namedCodec, func()) {
+	c := &Number[T]{V: *v, Valid: *valid}
+	return c, func() { *v, *valid = c.V, c.Valid }
+}
@@ -0,0 +1,344 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// testUserID is a named int64 for testing Named.
+type testUserID int64
+
+// testStatus is a named string for testing Named.
+type testStatus string
+
+// testEnabled is a named bool for testing Named.
+type testEnabled bool
+
+// testScore is a named float32 for testing Named.
+type testScore float32
+
+// testLevel is a named uint8 for testing Named.
+type testLevel uint8
+
+// TestNewNamed tests NewNamed.
+func TestNewNamed(t *testing.T) {
+	n := NewNamed(testUserID(42))
+	assert.True(t, n.Valid, "should be valid")
+	assert.Equal(t, testUserID(42), n.V, "should have set correct value")
+}
+
+// TestNamedFromPtr tests NamedFromPtr and Named.Ptr.
+func TestNamedFromPtr(t *testing.T) {
+	assert.False(t, NamedFromPtr[testUserID](nil).Valid, "should not be valid")
+	assert.Nil(t, Named[testUserID]{V: 42}.Ptr(), "should return nil")
+	v := testUserID(42)
+	assert.Equal(t, &v, NamedFromPtr(&v).Ptr(), "should return correct value")
+}
+
+// TestNamedFromSQLNull tests NamedFromSQLNull and Named.ToSQLNull.
+func TestNamedFromSQLNull(t *testing.T) {
+	v := sql.Null[testStatus]{V: "active", Valid: true}
+	assert.Equal(t, NewNamed[testStatus]("active"), NamedFromSQLNull(v), "should return correct value")
+	assert.Equal(t, v, NewNamed[testStatus]("active").ToSQLNull(), "should return correct value")
+}
+
+// NamedMarshalJSONSuite tests Named.MarshalJSON.
+type NamedMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *NamedMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(Named[testUserID]{V: 42})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *NamedMarshalJSONSuite) TestOK() {
+	for n, expect := range map[json.Marshaler]string{
+		NewNamed[testUserID](42):       `42`,
+		NewNamed[testStatus]("active"): `"active"`,
+		NewNamed[testEnabled](true):    `true`,
+		NewNamed[testScore](0.1):       `0.1`,
+		NewNamed[testLevel](255):       `255`,
+	} {
+		raw, err := json.Marshal(n)
+		suite.Require().NoErrorf(err, "should not fail for %v", n)
+		suite.Equalf(expect, string(raw), "should return correct value for %v", n)
+	}
+}
+
+func TestNamed_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(NamedMarshalJSONSuite))
+}
+
+// NamedUnmarshalJSONSuite tests Named.UnmarshalJSON.
+type NamedUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *NamedUnmarshalJSONSuite) TestNull() {
+	n := NewNamed[testUserID](42)
+	err := json.Unmarshal(jsonNull, &n)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *NamedUnmarshalJSONSuite) TestUnmarshalFail() {
+	var n Named[testUserID]
+	err := json.Unmarshal([]byte(`"meow"`), &n)
+	suite.Error(err, "should fail")
+}
+
+func (suite *NamedUnmarshalJSONSuite) TestOutOfRange() {
+	var n Named[testLevel]
+	err := json.Unmarshal([]byte(`256`), &n)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should return range error")
+}
+
+func (suite *NamedUnmarshalJSONSuite) TestOK() {
+	var id Named[testUserID]
+	err := json.Unmarshal([]byte(`42`), &id)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNamed[testUserID](42), id, "should unmarshal correct value")
+	var status Named[testStatus]
+	err = json.Unmarshal([]byte(`"active"`), &status)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNamed[testStatus]("active"), status, "should unmarshal correct value")
+	var enabled Named[testEnabled]
+	err = json.Unmarshal([]byte(`true`), &enabled)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNamed[testEnabled](true), enabled, "should unmarshal correct value")
+}
+
+func TestNamed_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(NamedUnmarshalJSONSuite))
+}
+
+// NamedMarshalTextSuite tests Named.MarshalText.
+type NamedMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *NamedMarshalTextSuite) TestNotValid() {
+	text, err := Named[testStatus]{V: "active"}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *NamedMarshalTextSuite) TestOK() {
+	text, err := NewNamed[testScore](0.1).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("0.1", string(text), "should return correct value")
+}
+
+func TestNamed_MarshalText(t *testing.T) {
+	suite.Run(t, new(NamedMarshalTextSuite))
+}
+
+// NamedUnmarshalTextSuite tests Named.UnmarshalText.
+type NamedUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *NamedUnmarshalTextSuite) TestEmpty() {
+	n := NewNamed[testUserID](42)
+	err := n.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *NamedUnmarshalTextSuite) TestUnmarshalFail() {
+	var n Named[testEnabled]
+	err := n.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *NamedUnmarshalTextSuite) TestOK() {
+	var n Named[testStatus]
+	err := n.UnmarshalText([]byte(`active`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNamed[testStatus]("active"), n, "should unmarshal correct value")
+}
+
+func TestNamed_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(NamedUnmarshalTextSuite))
+}
+
+// NamedMarshalYAMLSuite tests Named.MarshalYAML.
+type NamedMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *NamedMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Named[testUserID]{V: 42})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *NamedMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewNamed[testUserID](42))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("42\n", string(raw), "should return correct value")
+}
+
+func TestNamed_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(NamedMarshalYAMLSuite))
+}
+
+// NamedUnmarshalYAMLSuite tests Named.UnmarshalYAML.
+type NamedUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *NamedUnmarshalYAMLSuite) TestNull() {
+	n := NewNamed[testUserID](42)
+	err := n.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *NamedUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var n Named[testUserID]
+	err := yaml.Unmarshal([]byte(`meow`), &n)
+	suite.Error(err, "should fail")
+}
+
+func (suite *NamedUnmarshalYAMLSuite) TestOK() {
+	var n Named[testStatus]
+	err := yaml.Unmarshal([]byte(`active`), &n)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNamed[testStatus]("active"), n, "should unmarshal correct value")
+}
+
+func TestNamed_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(NamedUnmarshalYAMLSuite))
+}
+
+// NamedScanSuite tests Named.Scan.
+type NamedScanSuite struct {
+	suite.Suite
+}
+
+func (suite *NamedScanSuite) TestNull() {
+	n := NewNamed[testUserID](42)
+	err := n.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *NamedScanSuite) TestScanFail() {
+	var n Named[testEnabled]
+	err := n.Scan("meow")
+	suite.Error(err, "should fail")
+}
+
+func (suite *NamedScanSuite) TestOutOfRange() {
+	var n Named[testLevel]
+	err := n.Scan(int64(256))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should return range error")
+}
+
+func (suite *NamedScanSuite) TestOK() {
+	var id Named[testUserID]
+	err := id.Scan(int64(42))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNamed[testUserID](42), id, "should scan correct value")
+	var status Named[testStatus]
+	err = status.Scan([]byte("active"))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNamed[testStatus]("active"), status, "should scan correct value")
+	var enabled Named[testEnabled]
+	err = enabled.Scan(true)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNamed[testEnabled](true), enabled, "should scan correct value")
+}
+
+func TestNamed_Scan(t *testing.T) {
+	suite.Run(t, new(NamedScanSuite))
+}
+
+// NamedValueSuite tests Named.Value.
+type NamedValueSuite struct {
+	suite.Suite
+}
+
+func (suite *NamedValueSuite) TestNull() {
+	raw, err := Named[testUserID]{V: 42}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *NamedValueSuite) TestOK() {
+	raw, err := NewNamed[testUserID](42).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(42), raw, "should return correct value")
+	raw, err = NewNamed[testStatus]("active").Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("active", raw, "should return correct value")
+	raw, err = NewNamed[testEnabled](true).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(true, raw, "should return correct value")
+}
+
+func TestNamed_Value(t *testing.T) {
+	suite.Run(t, new(NamedValueSuite))
+}
+
+// TestNamed_Get tests Named.Get.
+func TestNamed_Get(t *testing.T) {
+	v, ok := NewNamed[testUserID](42).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, testUserID(42), v, "should return correct value")
+}
+
+// TestNamed_IsZero tests Named.IsZero.
+func TestNamed_IsZero(t *testing.T) {
+	assert.False(t, NewNamed[testUserID](0).IsZero(), "should not be zero")
+	assert.True(t, Named[testUserID]{V: 42}.IsZero(), "should be zero")
+}
+
+// TestNamed_Equal tests Named.Equal.
+func TestNamed_Equal(t *testing.T) {
+	n := NewNamed[testStatus]("active")
+	assert.True(t, n.Equal(NewNamed[testStatus]("active")), "should be equal")
+	assert.False(t, n.Equal(NewNamed[testStatus]("inactive")), "should not be equal")
+	assert.False(t, n.Equal(Named[testStatus]{V: "active"}), "should not be equal")
+	assert.True(t, Named[testStatus]{V: "active"}.Equal(Named[testStatus]{}), "should be equal")
+}
+
+// TestNamed_String tests Named.String.
+func TestNamed_String(t *testing.T) {
+	assert.Equal(t, "active", NewNamed[testStatus]("active").String(), "should return correct value")
+	assert.Equal(t, NullToken, Named[testStatus]{}.String(), "should return null token")
+}
+
+// TestNamed_Format tests Named.Format.
+func TestNamed_Format(t *testing.T) {
+	assert.Equal(t, "00042", fmt.Sprintf("%05d", NewNamed[testUserID](42)), "should return correct value")
+	assert.Equal(t, `"active"`, fmt.Sprintf("%q", NewNamed[testStatus]("active")), "should return correct value")
+	assert.Equal(t, NullToken, fmt.Sprintf("%d", Named[testUserID]{}), "should return null token")
+}
+
+// TestNamed_GoString tests Named.GoString.
+func TestNamed_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewNamed[nulls.testUserID](42)", fmt.Sprintf("%#v", NewNamed[testUserID](42)),
+		"should return correct value")
+	assert.Equal(t, `nulls.NewNamed[nulls.testStatus]("active")`, NewNamed[testStatus]("active").GoString(),
+		"should return correct value")
+	assert.Equal(t, "nulls.Named[nulls.testUserID]{}", Named[testUserID]{}.GoString(), "should return correct value")
+}
+
+// TestNamed_LogValue tests Named.LogValue.
+func TestNamed_LogValue(t *testing.T) {
+	assert.Equal(t, int64(42), NewNamed[testUserID](42).LogValue().Any(), "should return correct value")
+	assert.Equal(t, "active", NewNamed[testStatus]("active").LogValue().Any(), "should return correct value")
+	assert.Nil(t, Named[testUserID]{V: 42}.LogValue().Any(), "should return null value")
+}
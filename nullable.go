@@ -4,6 +4,9 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // NullableValue are the requirements for values used in Nullable as they need
@@ -13,7 +16,10 @@ type NullableValue interface {
 	driver.Valuer
 }
 
-// Nullable holds a nullable value.
+// Nullable holds a nullable value. T is usually a pointer type like *MyType. If
+// V is a nil pointer, it is allocated when scanning or unmarshalling. If Scan is
+// implemented with pointer receiver on MyType, you can also use NullableByValue
+// in order to use value semantics.
 type Nullable[T NullableValue] struct {
 	// V is the actual value when Valid.
 	V T `exhaustruct:"optional"`
@@ -29,6 +35,104 @@ func NewNullable[T NullableValue](v T) Nullable[T] {
 	}
 }
 
+// NullableFromPtr returns a Nullable that is valid if the given pointer is not
+// nil.
+func NullableFromPtr[T NullableValue](v *T) Nullable[T] {
+	if v == nil {
+		return Nullable[T]{}
+	}
+	return NewNullable(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n Nullable[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// NullableFromSQLNull returns a Nullable from the given sql.Null.
+func NullableFromSQLNull[T NullableValue](v sql.Null[T]) Nullable[T] {
+	return Nullable[T]{
+		V:     v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n Nullable[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{
+		V:     n.V,
+		Valid: n.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.V, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n Nullable[T]) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *Nullable[T]) Set(v T) {
+	*n = NewNullable(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *Nullable[T]) SetNull() {
+	*n = Nullable[T]{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n Nullable[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+// Values are compared using their Equal method if available or
+// reflect.DeepEqual otherwise.
+func (n Nullable[T]) Equal(other Nullable[T]) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return equalValues(n.V, other.V)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (n Nullable[T]) String() string {
+	return formatString(n.V, n.Valid && !isNilPointer(n.V))
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (n Nullable[T]) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n, n.V, n.Valid && !isNilPointer(n.V))
+}
+
+// GoString returns a Go expression creating the Nullable.
+func (n Nullable[T]) GoString() string {
+	if !n.Valid && !isNilPointer(n.V) {
+		return fmt.Sprintf("nulls.Nullable[%T]{}", n.V)
+	}
+	return fmt.Sprintf("nulls.NewNullable[%T](%#v)", n.V, n.V)
+}
+
+// LogValue implements slog.LogValuer. The value is logged like T or as NULL-value
+// if not valid.
+func (n Nullable[T]) LogValue() slog.Value {
+	if !n.Valid || isNilPointer(n.V) {
+		return logNullValue()
+	}
+	return slog.AnyValue(n.V)
+}
+
 // MarshalJSON as value. If not vot valid, a NULL-value is returned.
 func (n Nullable[T]) MarshalJSON() ([]byte, error) {
 	if !n.Valid {
@@ -39,27 +143,68 @@ func (n Nullable[T]) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON as value ro sets Valid o false if null.
 func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
-	if string(data) == "null" {
-		n.Valid = false
+	if isNull(data) {
+		n.SetNull()
 		return nil
 	}
 	n.Valid = true
+	allocNilPointer(&n.V)
 	return json.Unmarshal(data, &n.V)
 }
 
+// MarshalText marshals the value using encoding.TextMarshaler, which T must
+// implement. If not valid, empty text is returned.
+func (n Nullable[T]) MarshalText() ([]byte, error) {
+	if !n.Valid || isNilPointer(n.V) {
+		return []byte{}, nil
+	}
+	return marshalText(n.V)
+}
+
+// UnmarshalText using encoding.TextUnmarshaler, which T must implement, or sets
+// Valid to false if empty.
+func (n *Nullable[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+	n.Valid = true
+	allocNilPointer(&n.V)
+	return unmarshalText(n.V, text)
+}
+
+// MarshalYAML as value. If not valid, a NULL-value is returned.
+func (n Nullable[T]) MarshalYAML() (any, error) {
+	if !n.Valid || isNilPointer(n.V) {
+		return nil, nil
+	}
+	return n.V, nil
+}
+
+// UnmarshalYAML as value or sets Valid to false if null.
+func (n *Nullable[T]) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		n.SetNull()
+		return nil
+	}
+	n.Valid = true
+	return node.Decode(&n.V)
+}
+
 // Scan to value or not valid if nil.
 func (n *Nullable[T]) Scan(src any) error {
 	if src == nil {
-		n.Valid = false
+		n.SetNull()
 		return nil
 	}
 	n.Valid = true
+	allocNilPointer(&n.V)
 	return n.V.Scan(src)
 }
 
 // Value returns the value for satisfying the driver.Valuer interface.
 func (n Nullable[T]) Value() (driver.Value, error) {
-	if !n.Valid {
+	if !n.Valid || isNilPointer(n.V) {
 		return nil, nil
 	}
 	return n.V.Value()
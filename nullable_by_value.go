@@ -0,0 +1,219 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+)
+
+// NullableValuePtr is the constraint for pointers to values used in
+// NullableByValue. The pointer needs to implement sql.Scanner and
+// driver.Valuer, which allows implementing Scan with pointer receiver and Value
+// with either pointer or value receiver on T.
+type NullableValuePtr[T any] interface {
+	*T
+	sql.Scanner
+	driver.Valuer
+}
+
+// NullableByValue holds a nullable value like Nullable. However, T is the value
+// type itself instead of a pointer to it. This means, that the zero value is
+// ready to use and no allocations are required for scanning. Declare it like
+// NullableByValue[MyType, *MyType].
+type NullableByValue[T any, PT NullableValuePtr[T]] struct {
+	// V is the actual value when Valid.
+	V T `exhaustruct:"optional"`
+	// Valid describes whether the NullableByValue does not hold a NULL value.
+	Valid bool
+}
+
+// NewNullableByValue creates a new valid NullableByValue with the given value.
+func NewNullableByValue[T any, PT NullableValuePtr[T]](v T) NullableByValue[T, PT] {
+	return NullableByValue[T, PT]{
+		V:     v,
+		Valid: true,
+	}
+}
+
+// NullableByValueFromPtr returns a NullableByValue that is valid if the given
+// pointer is not nil.
+func NullableByValueFromPtr[T any, PT NullableValuePtr[T]](v *T) NullableByValue[T, PT] {
+	if v == nil {
+		return NullableByValue[T, PT]{}
+	}
+	return NewNullableByValue[T, PT](*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n NullableByValue[T, PT]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// NullableByValueFromSQLNull returns a NullableByValue from the given sql.Null.
+func NullableByValueFromSQLNull[T any, PT NullableValuePtr[T]](v sql.Null[T]) NullableByValue[T, PT] {
+	return NullableByValue[T, PT]{
+		V:     v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n NullableByValue[T, PT]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{
+		V:     n.V,
+		Valid: n.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (n NullableByValue[T, PT]) Get() (T, bool) {
+	return n.V, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n NullableByValue[T, PT]) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *NullableByValue[T, PT]) Set(v T) {
+	*n = NewNullableByValue[T, PT](v)
+}
+
+// SetNull sets a NULL-value.
+func (n *NullableByValue[T, PT]) SetNull() {
+	*n = NullableByValue[T, PT]{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n NullableByValue[T, PT]) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+// Values are compared using their Equal method if available or
+// reflect.DeepEqual otherwise.
+func (n NullableByValue[T, PT]) Equal(other NullableByValue[T, PT]) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return equalValues(n.V, other.V)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (n NullableByValue[T, PT]) String() string {
+	return formatString(n.V, n.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (n NullableByValue[T, PT]) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n, n.V, n.Valid)
+}
+
+// GoString returns a Go expression creating the NullableByValue.
+func (n NullableByValue[T, PT]) GoString() string {
+	if !n.Valid {
+		var pt PT
+		return fmt.Sprintf("nulls.NullableByValue[%T, %T]{}", n.V, pt)
+	}
+	return fmt.Sprintf("nulls.NewNullableByValue[%T](%#v)", n.V, n.V)
+}
+
+// LogValue implements slog.LogValuer. The value is logged like T or as NULL-value
+// if not valid.
+func (n NullableByValue[T, PT]) LogValue() slog.Value {
+	if !n.Valid {
+		return logNullValue()
+	}
+	return slog.AnyValue(n.V)
+}
+
+// MarshalJSON as value. If not valid, a NULL-value is returned.
+func (n NullableByValue[T, PT]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON as value or sets Valid to false if null.
+func (n *NullableByValue[T, PT]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		n.SetNull()
+		return nil
+	}
+	n.Valid = true
+	return json.Unmarshal(data, &n.V)
+}
+
+// MarshalText marshals the value using encoding.TextMarshaler if T implements
+// it or formats strings, booleans and numbers like with strconv. If not valid,
+// empty text is returned.
+func (n NullableByValue[T, PT]) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return marshalText(PT(&n.V))
+}
+
+// UnmarshalText using encoding.TextUnmarshaler if T implements it or parses
+// strings, booleans and numbers like with strconv. If empty, Valid is set to
+// false.
+func (n *NullableByValue[T, PT]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+	var v T
+	err := unmarshalText(PT(&v), text)
+	if err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// MarshalYAML as value. If not valid, a NULL-value is returned.
+func (n NullableByValue[T, PT]) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.V, nil
+}
+
+// UnmarshalYAML as value or sets Valid to false if null.
+func (n *NullableByValue[T, PT]) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		n.SetNull()
+		return nil
+	}
+	n.Valid = true
+	return node.Decode(&n.V)
+}
+
+// Scan to value or not valid if nil.
+func (n *NullableByValue[T, PT]) Scan(src any) error {
+	if src == nil {
+		n.SetNull()
+		return nil
+	}
+	n.Valid = true
+	return PT(&n.V).Scan(src)
+}
+
+// Value returns the value for satisfying the driver.Valuer interface.
+func (n NullableByValue[T, PT]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return PT(&n.V).Value()
+}
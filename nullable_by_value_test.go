@@ -0,0 +1,442 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// byValueScanner implements sql.Scanner with pointer receiver and driver.Valuer
+// with value receiver.
+type byValueScanner struct {
+	A string
+}
+
+func (s *byValueScanner) Scan(src any) error {
+	str, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("unsupported src type: %T", src)
+	}
+	if str == "fail" {
+		return errors.New("sad life")
+	}
+	s.A = str
+	return nil
+}
+
+func (s byValueScanner) Value() (driver.Value, error) {
+	if s.A == "fail" {
+		return nil, errors.New("sad life")
+	}
+	return s.A, nil
+}
+
+func (s byValueScanner) MarshalText() ([]byte, error) {
+	return []byte(s.A), nil
+}
+
+func (s *byValueScanner) UnmarshalText(text []byte) error {
+	if string(text) == "fail" {
+		return errors.New("sad life")
+	}
+	s.A = string(text)
+	return nil
+}
+
+// TestNewNullableByValue tests NewNullableByValue.
+func TestNewNullableByValue(t *testing.T) {
+	n := NewNullableByValue(byValueScanner{A: "Hello World!"})
+	assert.True(t, n.Valid, "should be valid")
+	assert.Equal(t, "Hello World!", n.V.A, "should have set correct value")
+}
+
+// TestNullableByValue_sql tests NullableByValue with types from the sql
+// package.
+func TestNullableByValue_sql(t *testing.T) {
+	var n NullableByValue[sql.NullString, *sql.NullString]
+	err := n.Scan("Hello World!")
+	assert.NoError(t, err, "should not fail")
+	assert.True(t, n.Valid, "should be valid")
+	assert.Equal(t, "Hello World!", n.V.String, "should scan correct value")
+}
+
+// NullableByValueMarshalJSONSuite tests NullableByValue.MarshalJSON.
+type NullableByValueMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValueMarshalJSONSuite) TestNotValid() {
+	n := NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}
+	raw, err := json.Marshal(n)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *NullableByValueMarshalJSONSuite) TestOK() {
+	n := NewNullableByValue(byValueScanner{A: "meow"})
+	raw, err := json.Marshal(n)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(marshalMust(byValueScanner{A: "meow"}), raw, "should return correct value")
+}
+
+func TestNullableByValue_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(NullableByValueMarshalJSONSuite))
+}
+
+// NullableByValueUnmarshalJSONSuite tests NullableByValue.UnmarshalJSON.
+type NullableByValueUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValueUnmarshalJSONSuite) TestNull() {
+	var n NullableByValue[byValueScanner, *byValueScanner]
+	err := json.Unmarshal(jsonNull, &n)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *NullableByValueUnmarshalJSONSuite) TestUnmarshalFail() {
+	var n NullableByValue[byValueScanner, *byValueScanner]
+	err := json.Unmarshal(marshalMust(42), &n)
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableByValueUnmarshalJSONSuite) TestOK() {
+	var n NullableByValue[byValueScanner, *byValueScanner]
+	err := json.Unmarshal(marshalMust(byValueScanner{A: "meow"}), &n)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(n.Valid, "should be valid")
+	suite.Equal("meow", n.V.A, "should unmarshal correct value")
+}
+
+func TestNullableByValue_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(NullableByValueUnmarshalJSONSuite))
+}
+
+// NullableByValueMarshalYAMLSuite tests NullableByValue.MarshalYAML.
+type NullableByValueMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValueMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *NullableByValueMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewNullableByValue(byValueScanner{A: "meow"}))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("meow\n", string(raw), "should return correct value")
+}
+
+func TestNullableByValue_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(NullableByValueMarshalYAMLSuite))
+}
+
+// NullableByValueUnmarshalYAMLSuite tests NullableByValue.UnmarshalYAML.
+type NullableByValueUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValueUnmarshalYAMLSuite) TestNull() {
+	v := NewNullableByValue(byValueScanner{A: "meow"})
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableByValueUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V NullableByValue[byValueScanner, *byValueScanner] `yaml:"v"`
+		W NullableByValue[byValueScanner, *byValueScanner] `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *NullableByValueUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v NullableByValue[byValueScanner, *byValueScanner]
+	err := yaml.Unmarshal([]byte(`fail`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableByValueUnmarshalYAMLSuite) TestOK() {
+	var v NullableByValue[byValueScanner, *byValueScanner]
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal("meow", v.V.A, "should unmarshal correct value")
+}
+
+func TestNullableByValue_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(NullableByValueUnmarshalYAMLSuite))
+}
+
+// NullableByValueScanSuite tests NullableByValue.Scan.
+type NullableByValueScanSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValueScanSuite) TestNull() {
+	var n NullableByValue[byValueScanner, *byValueScanner]
+	err := n.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *NullableByValueScanSuite) TestScanFail() {
+	var n NullableByValue[byValueScanner, *byValueScanner]
+	err := n.Scan("fail")
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableByValueScanSuite) TestOK() {
+	var n NullableByValue[byValueScanner, *byValueScanner]
+	err := n.Scan("meow")
+	suite.Require().NoError(err, "should not fail")
+	suite.True(n.Valid, "should be valid")
+	suite.Equal("meow", n.V.A, "should scan correct value")
+}
+
+func TestNullableByValue_Scan(t *testing.T) {
+	suite.Run(t, new(NullableByValueScanSuite))
+}
+
+// NullableByValueValueSuite tests NullableByValue.Value.
+type NullableByValueValueSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValueValueSuite) TestNull() {
+	n := NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}
+	raw, err := n.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *NullableByValueValueSuite) TestValueFail() {
+	n := NewNullableByValue(byValueScanner{A: "fail"})
+	_, err := n.Value()
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableByValueValueSuite) TestOK() {
+	n := NewNullableByValue(byValueScanner{A: "meow"})
+	raw, err := n.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("meow", raw, "should return correct value")
+}
+
+func TestNullableByValue_Value(t *testing.T) {
+	suite.Run(t, new(NullableByValueValueSuite))
+}
+
+// TestNullableByValue_Get tests NullableByValue.Get.
+func TestNullableByValue_Get(t *testing.T) {
+	v, ok := NewNullableByValue(byValueScanner{A: "Hello World!"}).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, byValueScanner{A: "Hello World!"}, v, "should return correct value")
+	_, ok = NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "Hello World!"}}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestNullableByValue_IsZero tests NullableByValue.IsZero.
+func TestNullableByValue_IsZero(t *testing.T) {
+	assert.False(t, NewNullableByValue(byValueScanner{A: "Hello World!"}).IsZero(), "should not be zero")
+	assert.True(t, NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "Hello World!"}}.IsZero(), "should be zero")
+}
+
+// TestNullableByValue_Equal tests NullableByValue.Equal.
+func TestNullableByValue_Equal(t *testing.T) {
+	assert.True(t, NewNullableByValue(byValueScanner{A: "meow"}).Equal(NewNullableByValue(byValueScanner{A: "meow"})), "should be equal")
+	assert.False(t, NewNullableByValue(byValueScanner{A: "meow"}).Equal(NewNullableByValue(byValueScanner{A: "woof"})), "should not be equal")
+	assert.False(t, NewNullableByValue(byValueScanner{A: "meow"}).Equal(NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}), "should not be equal")
+	assert.False(t, NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}.Equal(NewNullableByValue(byValueScanner{A: "meow"})), "should not be equal")
+	assert.True(t, NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}.Equal(NullableByValue[byValueScanner, *byValueScanner]{}), "should be equal")
+}
+
+// TestNullableByValue_String tests NullableByValue.String.
+func TestNullableByValue_String(t *testing.T) {
+	assert.Equal(t, "{meow}", NewNullableByValue(byValueScanner{A: "meow"}).String(), "should return correct value")
+	assert.Equal(t, NullToken, NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}.String(), "should return null token")
+}
+
+// NullableByValueFormatSuite tests NullableByValue.Format.
+type NullableByValueFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValueFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}), "should respect width")
+}
+
+func (suite *NullableByValueFormatSuite) TestValue() {
+	suite.Equal("{meow}", fmt.Sprintf("%v", NewNullableByValue(byValueScanner{A: "meow"})), "should return correct value")
+}
+
+func (suite *NullableByValueFormatSuite) TestVerb() {
+	suite.Equal("{A:meow}", fmt.Sprintf("%+v", NewNullableByValue(byValueScanner{A: "meow"})), "should return correct value")
+}
+
+func (suite *NullableByValueFormatSuite) TestGoSyntax() {
+	suite.Equal(NewNullableByValue(byValueScanner{A: "meow"}).GoString(), fmt.Sprintf("%#v", NewNullableByValue(byValueScanner{A: "meow"})), "should use GoString")
+}
+
+func TestNullableByValue_Format(t *testing.T) {
+	suite.Run(t, new(NullableByValueFormatSuite))
+}
+
+// TestNullableByValue_GoString tests NullableByValue.GoString.
+func TestNullableByValue_GoString(t *testing.T) {
+	assert.Equal(t, `nulls.NewNullableByValue[nulls.byValueScanner](nulls.byValueScanner{A:"meow"})`, NewNullableByValue(byValueScanner{A: "meow"}).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.NullableByValue[nulls.byValueScanner, *nulls.byValueScanner]{}", NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}.GoString(), "should return correct value")
+}
+
+// TestNullableByValue_LogValue tests NullableByValue.LogValue.
+func TestNullableByValue_LogValue(t *testing.T) {
+	assert.Equal(t, byValueScanner{A: "meow"}, NewNullableByValue(byValueScanner{A: "meow"}).LogValue().Any(), "should return correct value")
+	assert.Nil(t, NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}.LogValue().Any(), "should return null value")
+}
+
+// NullableByValueFromPtrSuite tests NullableByValueFromPtr.
+type NullableByValueFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValueFromPtrSuite) TestNil() {
+	v := NullableByValueFromPtr[byValueScanner, *byValueScanner](nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableByValueFromPtrSuite) TestZero() {
+	x := byValueScanner{}
+	v := NullableByValueFromPtr[byValueScanner, *byValueScanner](&x)
+	suite.Equal(NewNullableByValue(x), v, "should return correct value")
+}
+
+func (suite *NullableByValueFromPtrSuite) TestOK() {
+	x := byValueScanner{A: "Hello World!"}
+	v := NullableByValueFromPtr[byValueScanner, *byValueScanner](&x)
+	suite.Equal(NewNullableByValue(x), v, "should return correct value")
+}
+
+func TestNullableByValueFromPtr(t *testing.T) {
+	suite.Run(t, new(NullableByValueFromPtrSuite))
+}
+
+// NullableByValuePtrSuite tests NullableByValue.Ptr.
+type NullableByValuePtrSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValuePtrSuite) TestNotValid() {
+	v := NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "Hello World!"}}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *NullableByValuePtrSuite) TestZero() {
+	p := NewNullableByValue(byValueScanner{}).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(byValueScanner{}, *p, "should return correct value")
+}
+
+func (suite *NullableByValuePtrSuite) TestOK() {
+	v := NewNullableByValue(byValueScanner{A: "Hello World!"})
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(byValueScanner{A: "Hello World!"}, *p, "should return correct value")
+	*p = byValueScanner{}
+	suite.Equal(byValueScanner{A: "Hello World!"}, v.V, "should return copy")
+}
+
+func TestNullableByValue_Ptr(t *testing.T) {
+	suite.Run(t, new(NullableByValuePtrSuite))
+}
+
+// TestNullableByValueFromSQLNull tests NullableByValueFromSQLNull.
+func TestNullableByValueFromSQLNull(t *testing.T) {
+	assert.Equal(t, NullableByValue[byValueScanner, *byValueScanner]{}, NullableByValueFromSQLNull[byValueScanner, *byValueScanner](sql.Null[byValueScanner]{}), "should return correct value")
+	v := byValueScanner{A: "Hello World!"}
+	assert.Equal(t, NewNullableByValue(v), NullableByValueFromSQLNull[byValueScanner, *byValueScanner](sql.Null[byValueScanner]{V: v, Valid: true}), "should return correct value")
+}
+
+// TestNullableByValue_ToSQLNull tests NullableByValue.ToSQLNull.
+func TestNullableByValue_ToSQLNull(t *testing.T) {
+	assert.False(t, NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "Hello World!"}}.ToSQLNull().Valid, "should not be valid")
+	v := byValueScanner{A: "Hello World!"}
+	assert.Equal(t, sql.Null[byValueScanner]{V: v, Valid: true}, NewNullableByValue(v).ToSQLNull(), "should return correct value")
+}
+
+// NullableByValueMarshalTextSuite tests NullableByValue.MarshalText.
+type NullableByValueMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValueMarshalTextSuite) TestNotValid() {
+	v := NullableByValue[byValueScanner, *byValueScanner]{V: byValueScanner{A: "meow"}}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *NullableByValueMarshalTextSuite) TestUnsupported() {
+	_, err := NewNullableByValue(sql.NullString{String: "meow", Valid: true}).MarshalText()
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableByValueMarshalTextSuite) TestOK() {
+	text, err := NewNullableByValue(byValueScanner{A: "meow"}).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`meow`, string(text), "should return correct value")
+}
+
+func TestNullableByValue_MarshalText(t *testing.T) {
+	suite.Run(t, new(NullableByValueMarshalTextSuite))
+}
+
+// NullableByValueUnmarshalTextSuite tests NullableByValue.UnmarshalText.
+type NullableByValueUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableByValueUnmarshalTextSuite) TestEmpty() {
+	v := NewNullableByValue(byValueScanner{A: "meow"})
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableByValueUnmarshalTextSuite) TestUnsupported() {
+	var v NullableByValue[sql.NullString, *sql.NullString]
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableByValueUnmarshalTextSuite) TestUnmarshalFail() {
+	var v NullableByValue[byValueScanner, *byValueScanner]
+	err := v.UnmarshalText([]byte(`fail`))
+	suite.Error(err, "should fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableByValueUnmarshalTextSuite) TestOK() {
+	var v NullableByValue[byValueScanner, *byValueScanner]
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal("meow", v.V.A, "should unmarshal correct value")
+}
+
+func TestNullableByValue_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(NullableByValueUnmarshalTextSuite))
+}
@@ -1,8 +1,12 @@
 package nulls
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // NullableIntoValue are the requirements for values used in NullableInto as they
@@ -31,6 +35,104 @@ func NewNullableInto[T NullableIntoValue[T]](v T) NullableInto[T] {
 	}
 }
 
+// NullableIntoFromPtr returns a NullableInto that is valid if the given pointer
+// is not nil.
+func NullableIntoFromPtr[T NullableIntoValue[T]](v *T) NullableInto[T] {
+	if v == nil {
+		return NullableInto[T]{}
+	}
+	return NewNullableInto(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n NullableInto[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// NullableIntoFromSQLNull returns a NullableInto from the given sql.Null.
+func NullableIntoFromSQLNull[T NullableIntoValue[T]](v sql.Null[T]) NullableInto[T] {
+	return NullableInto[T]{
+		V:     v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n NullableInto[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{
+		V:     n.V,
+		Valid: n.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (n NullableInto[T]) Get() (T, bool) {
+	return n.V, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n NullableInto[T]) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *NullableInto[T]) Set(v T) {
+	*n = NewNullableInto(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *NullableInto[T]) SetNull() {
+	*n = NullableInto[T]{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n NullableInto[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+// Values are compared using their Equal method if available or
+// reflect.DeepEqual otherwise.
+func (n NullableInto[T]) Equal(other NullableInto[T]) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return equalValues(n.V, other.V)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (n NullableInto[T]) String() string {
+	return formatString(n.V, n.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (n NullableInto[T]) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n, n.V, n.Valid)
+}
+
+// GoString returns a Go expression creating the NullableInto.
+func (n NullableInto[T]) GoString() string {
+	if !n.Valid {
+		return fmt.Sprintf("nulls.NullableInto[%T]{}", n.V)
+	}
+	return fmt.Sprintf("nulls.NewNullableInto[%T](%#v)", n.V, n.V)
+}
+
+// LogValue implements slog.LogValuer. The value is logged like T or as NULL-value
+// if not valid.
+func (n NullableInto[T]) LogValue() slog.Value {
+	if !n.Valid {
+		return logNullValue()
+	}
+	return slog.AnyValue(n.V)
+}
+
 // MarshalJSON as value. If not vot valid, a NULL-value is returned.
 func (n NullableInto[T]) MarshalJSON() ([]byte, error) {
 	if !n.Valid {
@@ -41,18 +143,63 @@ func (n NullableInto[T]) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON as value ro sets Valid o false if null.
 func (n *NullableInto[T]) UnmarshalJSON(data []byte) error {
-	if string(data) == "null" {
-		n.Valid = false
+	if isNull(data) {
+		n.SetNull()
 		return nil
 	}
 	n.Valid = true
 	return json.Unmarshal(data, &n.V)
 }
 
+// MarshalText marshals the value using encoding.TextMarshaler if T implements
+// it or formats strings, booleans and numbers like with strconv. If not valid,
+// empty text is returned.
+func (n NullableInto[T]) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return marshalText(&n.V)
+}
+
+// UnmarshalText using encoding.TextUnmarshaler if T implements it or parses
+// strings, booleans and numbers like with strconv. If empty, Valid is set to
+// false.
+func (n *NullableInto[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+	var v T
+	err := unmarshalText(&v, text)
+	if err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// MarshalYAML as value. If not valid, a NULL-value is returned.
+func (n NullableInto[T]) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.V, nil
+}
+
+// UnmarshalYAML as value or sets Valid to false if null.
+func (n *NullableInto[T]) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		n.SetNull()
+		return nil
+	}
+	n.Valid = true
+	return node.Decode(&n.V)
+}
+
 // Scan to value or not valid if nil.
 func (n *NullableInto[T]) Scan(src any) error {
 	if src == nil {
-		n.Valid = false
+		n.SetNull()
 		return nil
 	}
 	n.Valid = true
@@ -1,6 +1,7 @@
 package nulls
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -30,6 +32,32 @@ func (m myStruct) Value() (driver.Value, error) {
 	return m.A, nil
 }
 
+// textMyStruct is a NullableIntoValue that implements encoding.TextMarshaler
+// and encoding.TextUnmarshaler.
+type textMyStruct struct {
+	A string
+}
+
+func (m textMyStruct) ScanInto(_ any, _ *textMyStruct) error {
+	return errors.New("not implemented")
+}
+
+func (m textMyStruct) Value() (driver.Value, error) {
+	return m.A, nil
+}
+
+func (m textMyStruct) MarshalText() ([]byte, error) {
+	return []byte(m.A), nil
+}
+
+func (m *textMyStruct) UnmarshalText(text []byte) error {
+	if string(text) == "fail" {
+		return errors.New("sad life")
+	}
+	m.A = string(text)
+	return nil
+}
+
 func TestNullableInto2(t *testing.T) {
 	m := myStruct{A: "Hello World!"}
 	myNullable := NewNullableInto(m)
@@ -144,6 +172,68 @@ func TestNullableInto_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(NullableIntoUnmarshalJSONSuite))
 }
 
+// NullableIntoMarshalYAMLSuite tests NullableInto.MarshalYAML.
+type NullableIntoMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableIntoMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(NullableInto[myStruct]{V: myStruct{A: "meow"}})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *NullableIntoMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewNullableInto(myStruct{A: "meow"}))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("a: meow\n", string(raw), "should return correct value")
+}
+
+func TestNullableInto_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(NullableIntoMarshalYAMLSuite))
+}
+
+// NullableIntoUnmarshalYAMLSuite tests NullableInto.UnmarshalYAML.
+type NullableIntoUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableIntoUnmarshalYAMLSuite) TestNull() {
+	v := NewNullableInto(myStruct{A: "meow"})
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableIntoUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V NullableInto[myStruct] `yaml:"v"`
+		W NullableInto[myStruct] `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *NullableIntoUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v NullableInto[myStruct]
+	err := yaml.Unmarshal([]byte(`[1, 2]`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableIntoUnmarshalYAMLSuite) TestOK() {
+	var v NullableInto[myStruct]
+	err := yaml.Unmarshal([]byte(`a: meow`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal("meow", v.V.A, "should unmarshal correct value")
+}
+
+func TestNullableInto_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(NullableIntoUnmarshalYAMLSuite))
+}
+
 // NullableIntoScanSuite tests NullableInto.Scan.
 type NullableIntoScanSuite struct {
 	suite.Suite
@@ -212,3 +302,204 @@ func (suite *NullableIntoValueSuite) TestOK() {
 func TestNullableInto_Value(t *testing.T) {
 	suite.Run(t, new(NullableIntoValueSuite))
 }
+
+// TestNullableInto_Get tests NullableInto.Get.
+func TestNullableInto_Get(t *testing.T) {
+	v, ok := NewNullableInto(myStruct{A: "Hello World!"}).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, myStruct{A: "Hello World!"}, v, "should return correct value")
+	_, ok = NullableInto[myStruct]{V: myStruct{A: "Hello World!"}}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestNullableInto_IsZero tests NullableInto.IsZero.
+func TestNullableInto_IsZero(t *testing.T) {
+	assert.False(t, NewNullableInto(myStruct{A: "Hello World!"}).IsZero(), "should not be zero")
+	assert.True(t, NullableInto[myStruct]{V: myStruct{A: "Hello World!"}}.IsZero(), "should be zero")
+}
+
+// TestNullableInto_Equal tests NullableInto.Equal.
+func TestNullableInto_Equal(t *testing.T) {
+	assert.True(t, NewNullableInto(myStruct{A: "meow"}).Equal(NewNullableInto(myStruct{A: "meow"})), "should be equal")
+	assert.False(t, NewNullableInto(myStruct{A: "meow"}).Equal(NewNullableInto(myStruct{A: "woof"})), "should not be equal")
+	assert.False(t, NewNullableInto(myStruct{A: "meow"}).Equal(NullableInto[myStruct]{V: myStruct{A: "meow"}}), "should not be equal")
+	assert.False(t, NullableInto[myStruct]{V: myStruct{A: "meow"}}.Equal(NewNullableInto(myStruct{A: "meow"})), "should not be equal")
+	assert.True(t, NullableInto[myStruct]{V: myStruct{A: "meow"}}.Equal(NullableInto[myStruct]{}), "should be equal")
+}
+
+// TestNullableInto_String tests NullableInto.String.
+func TestNullableInto_String(t *testing.T) {
+	assert.Equal(t, "{meow}", NewNullableInto(myStruct{A: "meow"}).String(), "should return correct value")
+	assert.Equal(t, NullToken, NullableInto[myStruct]{V: myStruct{A: "meow"}}.String(), "should return null token")
+}
+
+// NullableIntoFormatSuite tests NullableInto.Format.
+type NullableIntoFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableIntoFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", NullableInto[myStruct]{V: myStruct{A: "meow"}}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", NullableInto[myStruct]{V: myStruct{A: "meow"}}), "should respect width")
+}
+
+func (suite *NullableIntoFormatSuite) TestValue() {
+	suite.Equal("{meow}", fmt.Sprintf("%v", NewNullableInto(myStruct{A: "meow"})), "should return correct value")
+}
+
+func (suite *NullableIntoFormatSuite) TestVerb() {
+	suite.Equal("{A:meow}", fmt.Sprintf("%+v", NewNullableInto(myStruct{A: "meow"})), "should return correct value")
+}
+
+func (suite *NullableIntoFormatSuite) TestGoSyntax() {
+	suite.Equal(NewNullableInto(myStruct{A: "meow"}).GoString(), fmt.Sprintf("%#v", NewNullableInto(myStruct{A: "meow"})), "should use GoString")
+}
+
+func TestNullableInto_Format(t *testing.T) {
+	suite.Run(t, new(NullableIntoFormatSuite))
+}
+
+// TestNullableInto_GoString tests NullableInto.GoString.
+func TestNullableInto_GoString(t *testing.T) {
+	assert.Equal(t, `nulls.NewNullableInto[nulls.myStruct](nulls.myStruct{A:"meow"})`, NewNullableInto(myStruct{A: "meow"}).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.NullableInto[nulls.myStruct]{}", NullableInto[myStruct]{V: myStruct{A: "meow"}}.GoString(), "should return correct value")
+}
+
+// TestNullableInto_LogValue tests NullableInto.LogValue.
+func TestNullableInto_LogValue(t *testing.T) {
+	assert.Equal(t, myStruct{A: "meow"}, NewNullableInto(myStruct{A: "meow"}).LogValue().Any(), "should return correct value")
+	assert.Nil(t, NullableInto[myStruct]{V: myStruct{A: "meow"}}.LogValue().Any(), "should return null value")
+}
+
+// NullableIntoFromPtrSuite tests NullableIntoFromPtr.
+type NullableIntoFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableIntoFromPtrSuite) TestNil() {
+	v := NullableIntoFromPtr[myStruct](nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableIntoFromPtrSuite) TestZero() {
+	x := myStruct{}
+	v := NullableIntoFromPtr[myStruct](&x)
+	suite.Equal(NewNullableInto(x), v, "should return correct value")
+}
+
+func (suite *NullableIntoFromPtrSuite) TestOK() {
+	x := myStruct{A: "Hello World!"}
+	v := NullableIntoFromPtr[myStruct](&x)
+	suite.Equal(NewNullableInto(x), v, "should return correct value")
+}
+
+func TestNullableIntoFromPtr(t *testing.T) {
+	suite.Run(t, new(NullableIntoFromPtrSuite))
+}
+
+// NullableIntoPtrSuite tests NullableInto.Ptr.
+type NullableIntoPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableIntoPtrSuite) TestNotValid() {
+	v := NullableInto[myStruct]{V: myStruct{A: "Hello World!"}}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *NullableIntoPtrSuite) TestZero() {
+	p := NewNullableInto(myStruct{}).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(myStruct{}, *p, "should return correct value")
+}
+
+func (suite *NullableIntoPtrSuite) TestOK() {
+	v := NewNullableInto(myStruct{A: "Hello World!"})
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(myStruct{A: "Hello World!"}, *p, "should return correct value")
+	*p = myStruct{}
+	suite.Equal(myStruct{A: "Hello World!"}, v.V, "should return copy")
+}
+
+func TestNullableInto_Ptr(t *testing.T) {
+	suite.Run(t, new(NullableIntoPtrSuite))
+}
+
+// TestNullableIntoFromSQLNull tests NullableIntoFromSQLNull.
+func TestNullableIntoFromSQLNull(t *testing.T) {
+	assert.Equal(t, NullableInto[myStruct]{}, NullableIntoFromSQLNull[myStruct](sql.Null[myStruct]{}), "should return correct value")
+	v := myStruct{A: "Hello World!"}
+	assert.Equal(t, NewNullableInto(v), NullableIntoFromSQLNull[myStruct](sql.Null[myStruct]{V: v, Valid: true}), "should return correct value")
+}
+
+// TestNullableInto_ToSQLNull tests NullableInto.ToSQLNull.
+func TestNullableInto_ToSQLNull(t *testing.T) {
+	assert.False(t, NullableInto[myStruct]{V: myStruct{A: "Hello World!"}}.ToSQLNull().Valid, "should not be valid")
+	v := myStruct{A: "Hello World!"}
+	assert.Equal(t, sql.Null[myStruct]{V: v, Valid: true}, NewNullableInto(v).ToSQLNull(), "should return correct value")
+}
+
+// NullableIntoMarshalTextSuite tests NullableInto.MarshalText.
+type NullableIntoMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableIntoMarshalTextSuite) TestNotValid() {
+	v := NullableInto[textMyStruct]{V: textMyStruct{A: "meow"}}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *NullableIntoMarshalTextSuite) TestUnsupported() {
+	_, err := NewNullableInto(myStruct{A: "meow"}).MarshalText()
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableIntoMarshalTextSuite) TestOK() {
+	text, err := NewNullableInto(textMyStruct{A: "meow"}).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`meow`, string(text), "should return correct value")
+}
+
+func TestNullableInto_MarshalText(t *testing.T) {
+	suite.Run(t, new(NullableIntoMarshalTextSuite))
+}
+
+// NullableIntoUnmarshalTextSuite tests NullableInto.UnmarshalText.
+type NullableIntoUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableIntoUnmarshalTextSuite) TestEmpty() {
+	v := NewNullableInto(textMyStruct{A: "meow"})
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableIntoUnmarshalTextSuite) TestUnsupported() {
+	var v NullableInto[myStruct]
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableIntoUnmarshalTextSuite) TestUnmarshalFail() {
+	var v NullableInto[textMyStruct]
+	err := v.UnmarshalText([]byte(`fail`))
+	suite.Error(err, "should fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableIntoUnmarshalTextSuite) TestOK() {
+	var v NullableInto[textMyStruct]
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal("meow", v.V.A, "should unmarshal correct value")
+}
+
+func TestNullableInto_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(NullableIntoUnmarshalTextSuite))
+}
@@ -5,9 +5,11 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -110,10 +112,82 @@ func (suite *NullableUnmarshalJSONSuite) TestOK() {
 	suite.True(n.Valid, "should be valid")
 }
 
+func (suite *NullableUnmarshalJSONSuite) TestNilPointer() {
+	var n Nullable[*sql.NullString]
+	err := json.Unmarshal([]byte(`{"String": "meow", "Valid": true}`), &n)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(n.Valid, "should be valid")
+	suite.Require().NotNil(n.V, "should allocate value")
+	suite.Equal("meow", n.V.String, "should unmarshal correct value")
+}
+
 func TestNullable_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(NullableUnmarshalJSONSuite))
 }
 
+// NullableMarshalYAMLSuite tests Nullable.MarshalYAML.
+type NullableMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Nullable[*byValueScanner]{V: &byValueScanner{A: "meow"}})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *NullableMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewNullable(&byValueScanner{A: "meow"}))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("meow\n", string(raw), "should return correct value")
+}
+
+func TestNullable_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(NullableMarshalYAMLSuite))
+}
+
+// NullableUnmarshalYAMLSuite tests Nullable.UnmarshalYAML.
+type NullableUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableUnmarshalYAMLSuite) TestNull() {
+	v := NewNullable(&byValueScanner{A: "meow"})
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Nullable[*byValueScanner] `yaml:"v"`
+		W Nullable[*byValueScanner] `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *NullableUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Nullable[*byValueScanner]
+	err := yaml.Unmarshal([]byte(`fail`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableUnmarshalYAMLSuite) TestOK() {
+	var v Nullable[*byValueScanner]
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Require().NotNil(v.V, "should allocate value")
+	suite.Equal("meow", v.V.A, "should unmarshal correct value")
+}
+
+func TestNullable_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(NullableUnmarshalYAMLSuite))
+}
+
 // NullableScanSuite tests Nullable.Scan.
 type NullableScanSuite struct {
 	suite.Suite
@@ -145,6 +219,15 @@ func (suite *NullableScanSuite) TestOK() {
 	suite.True(n.Valid, "should be valid")
 }
 
+func (suite *NullableScanSuite) TestNilPointer() {
+	var n Nullable[*sql.NullString]
+	err := n.Scan("meow")
+	suite.Require().NoError(err, "should not fail")
+	suite.True(n.Valid, "should be valid")
+	suite.Require().NotNil(n.V, "should allocate value")
+	suite.Equal("meow", n.V.String, "should scan correct value")
+}
+
 func TestNullable_Scan(t *testing.T) {
 	suite.Run(t, new(NullableScanSuite))
 }
@@ -179,6 +262,208 @@ func (suite *NullableValueSuite) TestOK() {
 	suite.Equal(expectRaw, raw, "should return correct value")
 }
 
+func (suite *NullableValueSuite) TestNilPointer() {
+	n := Nullable[*sql.NullString]{Valid: true}
+	raw, err := n.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
 func TestNullable_Value(t *testing.T) {
 	suite.Run(t, new(NullableValueSuite))
 }
+
+// TestNullable_Get tests Nullable.Get.
+func TestNullable_Get(t *testing.T) {
+	v, ok := NewNullable(&sql.NullBool{Bool: true}).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, &sql.NullBool{Bool: true}, v, "should return correct value")
+	_, ok = Nullable[*sql.NullBool]{V: &sql.NullBool{Bool: true}}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestNullable_IsZero tests Nullable.IsZero.
+func TestNullable_IsZero(t *testing.T) {
+	assert.False(t, NewNullable(&sql.NullBool{Bool: true}).IsZero(), "should not be zero")
+	assert.True(t, Nullable[*sql.NullBool]{V: &sql.NullBool{Bool: true}}.IsZero(), "should be zero")
+}
+
+// TestNullable_Equal tests Nullable.Equal.
+func TestNullable_Equal(t *testing.T) {
+	assert.True(t, NewNullable(&byValueScanner{A: "meow"}).Equal(NewNullable(&byValueScanner{A: "meow"})), "should be equal")
+	assert.False(t, NewNullable(&byValueScanner{A: "meow"}).Equal(NewNullable(&byValueScanner{A: "woof"})), "should not be equal")
+	assert.False(t, NewNullable(&byValueScanner{A: "meow"}).Equal(Nullable[*byValueScanner]{V: &byValueScanner{A: "meow"}}), "should not be equal")
+	assert.False(t, Nullable[*byValueScanner]{V: &byValueScanner{A: "meow"}}.Equal(NewNullable(&byValueScanner{A: "meow"})), "should not be equal")
+	assert.True(t, Nullable[*byValueScanner]{V: &byValueScanner{A: "meow"}}.Equal(Nullable[*byValueScanner]{}), "should be equal")
+}
+
+// TestNullable_String tests Nullable.String.
+func TestNullable_String(t *testing.T) {
+	assert.Equal(t, "&{meow}", NewNullable(&byValueScanner{A: "meow"}).String(), "should return correct value")
+	assert.Equal(t, NullToken, Nullable[*byValueScanner]{V: &byValueScanner{A: "meow"}}.String(), "should return null token")
+}
+
+// NullableFormatSuite tests Nullable.Format.
+type NullableFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Nullable[*byValueScanner]{V: &byValueScanner{A: "meow"}}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Nullable[*byValueScanner]{V: &byValueScanner{A: "meow"}}), "should respect width")
+}
+
+func (suite *NullableFormatSuite) TestValue() {
+	suite.Equal("&{meow}", fmt.Sprintf("%v", NewNullable(&byValueScanner{A: "meow"})), "should return correct value")
+}
+
+func (suite *NullableFormatSuite) TestVerb() {
+	suite.Equal("&{A:meow}", fmt.Sprintf("%+v", NewNullable(&byValueScanner{A: "meow"})), "should return correct value")
+}
+
+func (suite *NullableFormatSuite) TestGoSyntax() {
+	suite.Equal(NewNullable(&byValueScanner{A: "meow"}).GoString(), fmt.Sprintf("%#v", NewNullable(&byValueScanner{A: "meow"})), "should use GoString")
+}
+
+func TestNullable_Format(t *testing.T) {
+	suite.Run(t, new(NullableFormatSuite))
+}
+
+// TestNullable_GoString tests Nullable.GoString.
+func TestNullable_GoString(t *testing.T) {
+	assert.Equal(t, `nulls.NewNullable[*nulls.byValueScanner](&nulls.byValueScanner{A:"meow"})`, NewNullable(&byValueScanner{A: "meow"}).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Nullable[*nulls.byValueScanner]{}", Nullable[*byValueScanner]{V: &byValueScanner{A: "meow"}}.GoString(), "should return correct value")
+}
+
+// TestNullable_LogValue tests Nullable.LogValue.
+func TestNullable_LogValue(t *testing.T) {
+	assert.Equal(t, &byValueScanner{A: "meow"}, NewNullable(&byValueScanner{A: "meow"}).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Nullable[*byValueScanner]{V: &byValueScanner{A: "meow"}}.LogValue().Any(), "should return null value")
+}
+
+// NullableFromPtrSuite tests NullableFromPtr.
+type NullableFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableFromPtrSuite) TestNil() {
+	v := NullableFromPtr[*sql.NullBool](nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableFromPtrSuite) TestZero() {
+	var x *sql.NullBool
+	v := NullableFromPtr(&x)
+	suite.True(v.Valid, "should be valid")
+	suite.Nil(v.V, "should return correct value")
+}
+
+func (suite *NullableFromPtrSuite) TestOK() {
+	x := &sql.NullBool{Bool: true}
+	v := NullableFromPtr(&x)
+	suite.Equal(NewNullable(x), v, "should return correct value")
+}
+
+func TestNullableFromPtr(t *testing.T) {
+	suite.Run(t, new(NullableFromPtrSuite))
+}
+
+// NullablePtrSuite tests Nullable.Ptr.
+type NullablePtrSuite struct {
+	suite.Suite
+}
+
+func (suite *NullablePtrSuite) TestNotValid() {
+	v := Nullable[*sql.NullBool]{V: &sql.NullBool{Bool: true}}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *NullablePtrSuite) TestOK() {
+	x := &sql.NullBool{Bool: true}
+	v := NewNullable(x)
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Same(x, *p, "should return correct value")
+}
+
+func TestNullable_Ptr(t *testing.T) {
+	suite.Run(t, new(NullablePtrSuite))
+}
+
+// TestNullableFromSQLNull tests NullableFromSQLNull.
+func TestNullableFromSQLNull(t *testing.T) {
+	assert.Equal(t, Nullable[*sql.NullBool]{}, NullableFromSQLNull[*sql.NullBool](sql.Null[*sql.NullBool]{}), "should return correct value")
+	v := &sql.NullBool{Bool: true}
+	assert.Equal(t, NewNullable(v), NullableFromSQLNull[*sql.NullBool](sql.Null[*sql.NullBool]{V: v, Valid: true}), "should return correct value")
+}
+
+// TestNullable_ToSQLNull tests Nullable.ToSQLNull.
+func TestNullable_ToSQLNull(t *testing.T) {
+	assert.False(t, Nullable[*sql.NullBool]{V: &sql.NullBool{Bool: true}}.ToSQLNull().Valid, "should not be valid")
+	v := &sql.NullBool{Bool: true}
+	assert.Equal(t, sql.Null[*sql.NullBool]{V: v, Valid: true}, NewNullable(v).ToSQLNull(), "should return correct value")
+}
+
+// NullableMarshalTextSuite tests Nullable.MarshalText.
+type NullableMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableMarshalTextSuite) TestNotValid() {
+	v := Nullable[*byValueScanner]{V: &byValueScanner{A: "meow"}}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *NullableMarshalTextSuite) TestUnsupported() {
+	_, err := NewNullable(&sql.NullString{String: "meow", Valid: true}).MarshalText()
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableMarshalTextSuite) TestOK() {
+	text, err := NewNullable(&byValueScanner{A: "meow"}).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`meow`, string(text), "should return correct value")
+}
+
+func TestNullable_MarshalText(t *testing.T) {
+	suite.Run(t, new(NullableMarshalTextSuite))
+}
+
+// NullableUnmarshalTextSuite tests Nullable.UnmarshalText.
+type NullableUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *NullableUnmarshalTextSuite) TestEmpty() {
+	v := NewNullable(&byValueScanner{A: "meow"})
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *NullableUnmarshalTextSuite) TestUnsupported() {
+	var v Nullable[*sql.NullString]
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableUnmarshalTextSuite) TestUnmarshalFail() {
+	var v Nullable[*byValueScanner]
+	err := v.UnmarshalText([]byte(`fail`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *NullableUnmarshalTextSuite) TestOK() {
+	var v Nullable[*byValueScanner]
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Require().NotNil(v.V, "should allocate value")
+	suite.Equal("meow", v.V.A, "should unmarshal correct value")
+}
+
+func TestNullable_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(NullableUnmarshalTextSuite))
+}
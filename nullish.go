@@ -0,0 +1,29 @@
+package nulls
+
+// Nullish is implemented by all types of this package. It allows generic code
+// to access values regardless of the name of the value field.
+//
+// Keep in mind that for Patch, IsNull only returns true for explicit
+// NULL-values, so an unset Patch is neither NULL nor valid.
+type Nullish[T any] interface {
+	Getter[T]
+	// IsNull returns true if a NULL-value is represented.
+	IsNull() bool
+}
+
+// Setter allows setting nullable values. It is implemented by pointers to all
+// types of this package.
+type Setter[T any] interface {
+	// Set sets the given value and makes it valid.
+	Set(v T)
+	// SetNull sets a NULL-value.
+	SetNull()
+}
+
+// SettableNullish is implemented by pointers to all types of this package. It
+// allows generic code to read and write values regardless of the name of the
+// value field.
+type SettableNullish[T any] interface {
+	Nullish[T]
+	Setter[T]
+}
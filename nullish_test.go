@@ -0,0 +1,99 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// testNullish tests the Nullish and SettableNullish implementation of N by
+// setting the given value and a NULL-value.
+func testNullish[T any, N Nullish[T], PN interface {
+	*N
+	SettableNullish[T]
+}](t *testing.T, v T) {
+	var n N
+	assert.True(t, n.IsNull(), "zero value should be null")
+	PN(&n).Set(v)
+	assert.False(t, n.IsNull(), "should not be null after setting value")
+	got, ok := n.Get()
+	assert.True(t, ok, "should be valid after setting value")
+	assert.Equal(t, v, got, "should return set value")
+	PN(&n).SetNull()
+	assert.True(t, n.IsNull(), "should be null after setting null")
+	_, ok = n.Get()
+	assert.False(t, ok, "should not be valid after setting null")
+}
+
+// TestNullish tests the Nullish and SettableNullish implementations of all
+// types.
+func TestNullish(t *testing.T) {
+	now := time.Date(2024, 2, 29, 13, 37, 42, 0, time.UTC)
+	midnight := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	clock := time.Date(0, 1, 1, 13, 37, 42, 0, time.UTC)
+	tests := map[string]func(t *testing.T){
+		"BigInt":         func(t *testing.T) { testNullish[*big.Int, BigInt](t, big.NewInt(42)) },
+		"BigIntString":   func(t *testing.T) { testNullish[*big.Int, BigIntString](t, big.NewInt(42)) },
+		"Bool":           func(t *testing.T) { testNullish[bool, Bool](t, true) },
+		"ByteSlice":      func(t *testing.T) { testNullish[[]byte, ByteSlice](t, []byte("meow")) },
+		"Bytes":          func(t *testing.T) { testNullish[[]byte, Bytes](t, []byte("meow")) },
+		"Date":           func(t *testing.T) { testNullish[time.Time, Date](t, midnight) },
+		"Duration":       func(t *testing.T) { testNullish[time.Duration, Duration](t, time.Minute) },
+		"Float32":        func(t *testing.T) { testNullish[float32, Float32](t, 0.1) },
+		"Float64":        func(t *testing.T) { testNullish[float64, Float64](t, 0.1) },
+		"Int":            func(t *testing.T) { testNullish[int, Int](t, 42) },
+		"Int8":           func(t *testing.T) { testNullish[int8, Int8](t, 42) },
+		"Int16":          func(t *testing.T) { testNullish[int16, Int16](t, 42) },
+		"Int32":          func(t *testing.T) { testNullish[int32, Int32](t, 42) },
+		"Int64":          func(t *testing.T) { testNullish[int64, Int64](t, 42) },
+		"JSONNullable":   func(t *testing.T) { testNullish[myStruct, JSONNullable[myStruct]](t, myStruct{A: "meow"}) },
+		"JSONRawMessage": func(t *testing.T) { testNullish[json.RawMessage, JSONRawMessage](t, json.RawMessage(`{}`)) },
+		"LayoutTime":     func(t *testing.T) { testNullish[time.Time, LayoutTime[DateLayout]](t, now) },
+		"Named":          func(t *testing.T) { testNullish[testUserID, Named[testUserID]](t, 42) },
+		"Nullable":       func(t *testing.T) { testNullish[*sql.NullBool, Nullable[*sql.NullBool]](t, &sql.NullBool{}) },
+		"NullableByValue": func(t *testing.T) {
+			testNullish[byValueScanner, NullableByValue[byValueScanner, *byValueScanner]](t, byValueScanner{A: "meow"})
+		},
+		"NullableInto":  func(t *testing.T) { testNullish[myStruct, NullableInto[myStruct]](t, myStruct{A: "meow"}) },
+		"Number":        func(t *testing.T) { testNullish[uint16, Number[uint16]](t, 42) },
+		"Optional":      func(t *testing.T) { testNullish[string, Optional[string]](t, "meow") },
+		"Rat":           func(t *testing.T) { testNullish[*big.Rat, Rat](t, big.NewRat(1, 3)) },
+		"RatString":     func(t *testing.T) { testNullish[*big.Rat, RatString](t, big.NewRat(1, 3)) },
+		"String":        func(t *testing.T) { testNullish[string, String](t, "meow") },
+		"Time":          func(t *testing.T) { testNullish[time.Time, Time](t, now) },
+		"TimeOfDay":     func(t *testing.T) { testNullish[time.Time, TimeOfDay](t, clock) },
+		"Uint":          func(t *testing.T) { testNullish[uint, Uint](t, 42) },
+		"Uint8":         func(t *testing.T) { testNullish[uint8, Uint8](t, 42) },
+		"Uint16":        func(t *testing.T) { testNullish[uint16, Uint16](t, 42) },
+		"Uint32":        func(t *testing.T) { testNullish[uint32, Uint32](t, 42) },
+		"Uint64":        func(t *testing.T) { testNullish[uint64, Uint64](t, 42) },
+		"UnixMilliTime": func(t *testing.T) { testNullish[time.Time, UnixMilliTime](t, now) },
+		"UnixTime":      func(t *testing.T) { testNullish[time.Time, UnixTime](t, now) },
+		"UUID":          func(t *testing.T) { testNullish[uuid.UUID, UUID](t, uuid.Must(uuid.NewV4())) },
+	}
+	for name, test := range tests {
+		t.Run(name, test)
+	}
+}
+
+// TestDate_Set tests Date.Set.
+func TestDate_Set(t *testing.T) {
+	var d Date
+	d.Set(time.Date(2024, 2, 29, 23, 30, 0, 0, time.FixedZone("", -3600)))
+	assert.Equal(t, NewDate(2024, 2, 29), d, "should set date in location of time")
+}
+
+// TestPatch_Set tests Patch.Set and Patch.SetNull.
+func TestPatch_Set(t *testing.T) {
+	var p Patch[string]
+	p.Set("meow")
+	assert.True(t, p.IsSet(), "should be set")
+	assert.Equal(t, "meow", p.V, "should have set correct value")
+	p.SetNull()
+	assert.True(t, p.IsNull(), "should be null")
+	assert.True(t, p.Present, "should still be present")
+}
@@ -1,14 +1,133 @@
 // Package nulls provides nullable types like the ones from sql package.
 // However, (un)marshalling support is available as well. Keep in mind, that
-// NULL-values and "undefined"-values (JS-style) are treated the same.
+// NULL-values and "undefined"-values (JS-style) are treated the same. If they
+// need to be distinguished, for example in PATCH requests, use Patch.
+//
+// All types implement encoding.TextMarshaler and encoding.TextUnmarshaler. A
+// NULL-value is represented as empty text. Keep in mind, that this means that
+// empty strings and byte slices cannot be distinguished from NULL-values in
+// text form. Generic types delegate to T if it implements the text interfaces.
+// Otherwise, strings, booleans and numbers are supported like with strconv.
+//
+// YAML is supported via yaml.v3 with NULL-values being represented as null.
+// Keep in mind, that yaml.v3 does not call UnmarshalYAML for null in mappings
+// or for a null document. In these cases, the value is left unchanged.
+//
+// When a NULL-value is unmarshalled from JSON or text, unmarshalled in
+// UnmarshalYAML or scanned from the database, the value field is reset to its
+// zero value as well. NULL-values therefore always equal the zero value of the
+// type, regardless of what was held before. For Patch, the only difference is
+// that it is marked as present.
+//
+// For printing, all types implement fmt.Formatter and fmt.GoStringer and all
+// except String implement fmt.Stringer. NULL-values are printed as NullToken.
+// For logging with log/slog, all types implement slog.LogValuer.
 package nulls
 
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"reflect"
+	"strconv"
+)
+
 // isNull checks if the given byte slice represents NULL-value or "nothing" (in
 // JS: undefined; here: nil).
 func isNull(b []byte) bool {
 	return b == nil || string(b) == "null"
 }
 
+// isYAMLNull checks if the given node represents a NULL-value.
+func isYAMLNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null"
+}
+
+// resetYAMLStyle resets the style of the given node and all of its children.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+// marshalText marshals the given value if it implements
+// encoding.TextMarshaler. Otherwise, strings, booleans and numbers, including
+// types with them as underlying type, are formatted like with strconv. For all
+// other values, an error is returned.
+func marshalText(v any) ([]byte, error) {
+	if m, ok := v.(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	default:
+		return nil, fmt.Errorf("%T does not implement encoding.TextMarshaler", v)
+	}
+}
+
+// unmarshalText unmarshals the given text into v if it implements
+// encoding.TextUnmarshaler. Otherwise, strings, booleans and numbers, including
+// types with them as underlying type, are parsed like with strconv. If a number
+// does not fit, a RangeError is returned. For all other values, an error is
+// returned.
+func unmarshalText(v any, text []byte) error {
+	if u, ok := v.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText(text)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("%T does not implement encoding.TextUnmarshaler", v)
+	}
+	rv = rv.Elem()
+	s := string(text)
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := parseInt(s, rv.Type().Bits(), rv.Type().String())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := parseUint(s, rv.Type().Bits(), rv.Type().String())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return &RangeError{Value: s, Type: rv.Type().String()}
+			}
+			return err
+		}
+		rv.SetFloat(f)
+	default:
+		return fmt.Errorf("%T does not implement encoding.TextUnmarshaler", v)
+	}
+	return nil
+}
+
 // copyBytes returns a copy of the given byte slice.
 func copyBytes(src []byte) []byte {
 	if src == nil {
@@ -18,3 +137,19 @@ func copyBytes(src []byte) []byte {
 	copy(b, src)
 	return b
 }
+
+// allocNilPointer allocates a new value if v points to a nil pointer. This
+// avoids nil-panics when calling pointer receiver methods on zero values of
+// generic types with pointer type parameters.
+func allocNilPointer[T any](v *T) {
+	rv := reflect.ValueOf(v).Elem()
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		rv.Set(reflect.New(rv.Type().Elem()))
+	}
+}
+
+// isNilPointer checks if the given value is a nil pointer.
+func isNilPointer(v any) bool {
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
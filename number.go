@@ -0,0 +1,510 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Integer is a constraint for integer types like in golang.org/x/exp/constraints.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Float is a constraint for floating-point types like in
+// golang.org/x/exp/constraints.
+type Float interface {
+	~float32 | ~float64
+}
+
+// PrecisionError is returned when scanning or unmarshalling a value that cannot
+// be represented by the target type without losing precision like 1.5 for an
+// integer type.
+type PrecisionError struct {
+	// Value is the string representation of the value.
+	Value string
+	// Type is the name of the target type like float32.
+	Type string
+}
+
+// Error returns the error message.
+func (err *PrecisionError) Error() string {
+	return fmt.Sprintf("value %s cannot be represented by %s without losing precision", err.Value, err.Type)
+}
+
+// Number holds a nullable integer or floating-point number. Values are checked
+// for range and precision when scanning or unmarshalling. If a value does not
+// fit into T, a RangeError is returned. If it fits, but would lose precision, a
+// PrecisionError is returned. The predefined numeric types like Int16 or
+// Float32 behave the same.
+type Number[T Integer | Float] struct {
+	// V is the actual value when Valid.
+	V T `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewNumber returns a valid Number with the given value.
+func NewNumber[T Integer | Float](v T) Number[T] {
+	return Number[T]{
+		V:     v,
+		Valid: true,
+	}
+}
+
+// NumberFromPtr returns a Number that is valid if the given pointer is not nil.
+func NumberFromPtr[T Integer | Float](v *T) Number[T] {
+	if v == nil {
+		return Number[T]{}
+	}
+	return NewNumber(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n Number[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// NumberFromSQLNull returns a Number from the given sql.Null.
+func NumberFromSQLNull[T Integer | Float](v sql.Null[T]) Number[T] {
+	return Number[T]{
+		V:     v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n Number[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{
+		V:     n.V,
+		Valid: n.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (n Number[T]) Get() (T, bool) {
+	return n.V, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n Number[T]) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *Number[T]) Set(v T) {
+	*n = NewNumber(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *Number[T]) SetNull() {
+	*n = Number[T]{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n Number[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (n Number[T]) Equal(other Number[T]) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return n.V == other.V
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (n Number[T]) String() string {
+	return formatString(n.V, n.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (n Number[T]) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n, n.V, n.Valid)
+}
+
+// GoString returns a Go expression creating the Number.
+func (n Number[T]) GoString() string {
+	if !n.Valid {
+		return fmt.Sprintf("nulls.Number[%T]{}", n.V)
+	}
+	return fmt.Sprintf("nulls.NewNumber[%T](%s)", n.V, formatNumber(n.V))
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (n Number[T]) LogValue() slog.Value {
+	if !n.Valid {
+		return logNullValue()
+	}
+	return logNumberValue(n.V)
+}
+
+// MarshalJSON marshals the number. If not valid, a NULL-value is returned.
+func (n Number[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON as number or sets Valid to false if null. If the value does not
+// fit into T, a RangeError or PrecisionError is returned.
+func (n *Number[T]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		n.SetNull()
+		return nil
+	}
+	v, err := unmarshalJSONNumber[T](data)
+	if err != nil {
+		return err
+	}
+	n.Valid = true
+	n.V = v
+	return nil
+}
+
+// MarshalText marshals the number as decimal text. If not valid, empty text is
+// returned.
+func (n Number[T]) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return []byte(formatNumber(n.V)), nil
+}
+
+// UnmarshalText as decimal number or sets Valid to false if empty. If the value
+// does not fit into T, a RangeError is returned.
+func (n *Number[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+	v, err := parseNumber[T](string(text))
+	if err != nil {
+		return err
+	}
+	n.Valid = true
+	n.V = v
+	return nil
+}
+
+// MarshalYAML marshals the number. If not valid, a NULL-value is returned.
+func (n Number[T]) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.V, nil
+}
+
+// UnmarshalYAML as number or sets Valid to false if null. If the value does not
+// fit into T, a RangeError or PrecisionError is returned.
+func (n *Number[T]) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		n.SetNull()
+		return nil
+	}
+	v, err := unmarshalYAMLNumber[T](node)
+	if err != nil {
+		return err
+	}
+	n.Valid = true
+	n.V = v
+	return nil
+}
+
+// Scan to number value or not valid if nil. If the value does not fit into T, a
+// RangeError or PrecisionError is returned.
+func (n *Number[T]) Scan(src any) error {
+	v, valid, err := scanNumber[T](src)
+	if err != nil {
+		return err
+	}
+	n.Valid = valid
+	n.V = v
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. Integers
+// are returned as int64 and floats as float64. Unsigned integers exceeding
+// int64 result in a RangeError.
+func (n Number[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return numberValue(n.V)
+}
+
+// numberKind describes the kind of numbers of type T.
+type numberKind struct {
+	// kind is the reflect.Kind of T.
+	kind reflect.Kind
+	// bits is the bit size of T.
+	bits int
+	// name is the name of T used in errors.
+	name string
+}
+
+// numberKindOf returns the numberKind of T.
+func numberKindOf[T Integer | Float]() numberKind {
+	t := reflect.TypeOf(T(0))
+	return numberKind{
+		kind: t.Kind(),
+		bits: t.Bits(),
+		name: t.String(),
+	}
+}
+
+// signed reports whether the kind is a signed integer.
+func (k numberKind) signed() bool {
+	switch k.kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// float reports whether the kind is a floating-point number.
+func (k numberKind) float() bool {
+	return k.kind == reflect.Float32 || k.kind == reflect.Float64
+}
+
+// numberFromInt64 converts the given signed integer to T. If the value does not
+// fit, a RangeError or PrecisionError is returned.
+func numberFromInt64[T Integer | Float](v int64) (T, error) {
+	k := numberKindOf[T]()
+	switch {
+	case k.float():
+		f := T(v)
+		if !isExactInt64(float64(f), v) {
+			return 0, &PrecisionError{Value: strconv.FormatInt(v, 10), Type: k.name}
+		}
+		return f, nil
+	case k.signed():
+		return T(v), checkInt(v, k.bits, k.name)
+	default:
+		if v < 0 {
+			return 0, &RangeError{Value: strconv.FormatInt(v, 10), Type: k.name}
+		}
+		return T(v), checkUint(uint64(v), k.bits, k.name)
+	}
+}
+
+// numberFromUint64 converts the given unsigned integer to T. If the value does
+// not fit, a RangeError or PrecisionError is returned.
+func numberFromUint64[T Integer | Float](v uint64) (T, error) {
+	k := numberKindOf[T]()
+	switch {
+	case k.float():
+		f := T(v)
+		if !isExactUint64(float64(f), v) {
+			return 0, &PrecisionError{Value: strconv.FormatUint(v, 10), Type: k.name}
+		}
+		return f, nil
+	case k.signed():
+		if v > math.MaxInt64 {
+			return 0, &RangeError{Value: strconv.FormatUint(v, 10), Type: k.name}
+		}
+		return T(v), checkInt(int64(v), k.bits, k.name)
+	default:
+		return T(v), checkUint(v, k.bits, k.name)
+	}
+}
+
+// numberFromFloat64 converts the given float to T. Integer types only accept
+// whole numbers. For float32, values are accepted if they are exactly
+// representable or have the same shortest decimal representation like 0.1.
+// Otherwise, a RangeError or PrecisionError is returned.
+func numberFromFloat64[T Integer | Float](v float64) (T, error) {
+	k := numberKindOf[T]()
+	text := strconv.FormatFloat(v, 'g', -1, 64)
+	switch {
+	case k.kind == reflect.Float64:
+		return T(v), nil
+	case k.float():
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return T(v), nil
+		}
+		if math.Abs(v) > math.MaxFloat32 {
+			return 0, &RangeError{Value: text, Type: k.name}
+		}
+		f := float32(v)
+		if float64(f) != v && strconv.FormatFloat(float64(f), 'g', -1, 32) != text {
+			return 0, &PrecisionError{Value: text, Type: k.name}
+		}
+		return T(f), nil
+	case math.IsNaN(v) || math.IsInf(v, 0):
+		return 0, &RangeError{Value: text, Type: k.name}
+	case v != math.Trunc(v):
+		return 0, &PrecisionError{Value: text, Type: k.name}
+	case k.signed():
+		if v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, &RangeError{Value: text, Type: k.name}
+		}
+		return numberFromInt64[T](int64(v))
+	default:
+		if v < 0 || v >= math.MaxUint64 {
+			return 0, &RangeError{Value: text, Type: k.name}
+		}
+		return numberFromUint64[T](uint64(v))
+	}
+}
+
+// isExactInt64 reports whether f equals the given signed integer exactly.
+func isExactInt64(f float64, v int64) bool {
+	return f >= math.MinInt64 && f < math.MaxInt64 && int64(f) == v
+}
+
+// isExactUint64 reports whether f equals the given unsigned integer exactly.
+func isExactUint64(f float64, v uint64) bool {
+	return f < math.MaxUint64 && uint64(f) == v
+}
+
+// parseNumber parses the given decimal text as T. If the value does not fit, a
+// RangeError or PrecisionError is returned.
+func parseNumber[T Integer | Float](s string) (T, error) {
+	k := numberKindOf[T]()
+	switch {
+	case k.float():
+		// Parse with 64 bits and convert afterward for applying the same precision
+		// checks for float32 as when scanning numbers.
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return 0, &RangeError{Value: s, Type: k.name}
+			}
+			return 0, err
+		}
+		return numberFromFloat64[T](v)
+	case k.signed():
+		v, err := parseInt(s, k.bits, k.name)
+		return T(v), err
+	default:
+		v, err := parseUint(s, k.bits, k.name)
+		return T(v), err
+	}
+}
+
+// formatNumber formats the given number as decimal text. Floats use the
+// shortest representation for their bit size.
+func formatNumber[T Integer | Float](v T) string {
+	k := numberKindOf[T]()
+	switch {
+	case k.float():
+		return strconv.FormatFloat(float64(v), 'g', -1, k.bits)
+	case k.signed():
+		return strconv.FormatInt(int64(v), 10)
+	default:
+		return strconv.FormatUint(uint64(v), 10)
+	}
+}
+
+// logNumberValue returns the slog.Value for the given number.
+func logNumberValue[T Integer | Float](v T) slog.Value {
+	k := numberKindOf[T]()
+	switch {
+	case k.float():
+		return slog.Float64Value(float64(v))
+	case k.signed():
+		return slog.Int64Value(int64(v))
+	default:
+		return slog.Uint64Value(uint64(v))
+	}
+}
+
+// numberValue returns the driver.Value for the given number. Integers are
+// returned as int64 and floats as float64.
+func numberValue[T Integer | Float](v T) (driver.Value, error) {
+	k := numberKindOf[T]()
+	switch {
+	case k.float():
+		return float64(v), nil
+	case k.signed():
+		return int64(v), nil
+	default:
+		return uint64Value(uint64(v))
+	}
+}
+
+// unmarshalJSONNumber unmarshals the given JSON number as T. If the value does
+// not fit, a RangeError or PrecisionError is returned.
+func unmarshalJSONNumber[T Integer | Float](data []byte) (T, error) {
+	v, err := parseNumber[T](string(data))
+	var rangeErr *RangeError
+	var precisionErr *PrecisionError
+	if err == nil || errors.As(err, &rangeErr) || errors.As(err, &precisionErr) {
+		return v, err
+	}
+	// Let the json package handle everything else for consistent errors.
+	err = json.Unmarshal(data, &v)
+	if err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+// unmarshalYAMLNumber unmarshals the given YAML node as T. If the value does
+// not fit, a RangeError or PrecisionError is returned.
+func unmarshalYAMLNumber[T Integer | Float](node *yaml.Node) (T, error) {
+	k := numberKindOf[T]()
+	switch {
+	case k.float():
+		var v float64
+		err := node.Decode(&v)
+		if err != nil {
+			return 0, err
+		}
+		return numberFromFloat64[T](v)
+	case k.signed():
+		v, err := unmarshalYAMLInt(node, k.bits, k.name)
+		return T(v), err
+	default:
+		v, err := unmarshalYAMLUint(node, k.bits, k.name)
+		return T(v), err
+	}
+}
+
+// scanNumber scans the given source as T. Strings are parsed as decimal text
+// and numbers are converted with range and precision checks. The returned
+// boolean is false if the source is nil.
+func scanNumber[T Integer | Float](src any) (T, bool, error) {
+	switch src := src.(type) {
+	case nil:
+		return 0, false, nil
+	case string:
+		v, err := parseNumber[T](src)
+		return v, true, err
+	case []byte:
+		v, err := parseNumber[T](string(src))
+		return v, true, err
+	}
+	rv := reflect.ValueOf(src)
+	var v T
+	var err error
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err = numberFromInt64[T](rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, err = numberFromUint64[T](rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		v, err = numberFromFloat64[T](rv.Float())
+	default:
+		var sqlNumber sql.Null[T]
+		err = sqlNumber.Scan(src)
+		return sqlNumber.V, sqlNumber.Valid, err
+	}
+	return v, true, err
+}
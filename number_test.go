@@ -0,0 +1,525 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"math"
+	"testing"
+)
+
+// testNamedNumber is a named type for testing Number with underlying types.
+type testNamedNumber int16
+
+// TestPrecisionError_Error tests PrecisionError.Error.
+func TestPrecisionError_Error(t *testing.T) {
+	err := &PrecisionError{Value: "1.5", Type: "int16"}
+	assert.Equal(t, "value 1.5 cannot be represented by int16 without losing precision", err.Error(),
+		"should return correct message")
+}
+
+// TestNewNumber tests NewNumber.
+func TestNewNumber(t *testing.T) {
+	n := NewNumber[int8](16)
+	assert.True(t, n.Valid, "should be valid")
+	assert.Equal(t, int8(16), n.V, "should have set correct value")
+}
+
+// numberFromInt64Suite tests numberFromInt64.
+type numberFromInt64Suite struct {
+	suite.Suite
+}
+
+func (suite *numberFromInt64Suite) TestSigned() {
+	v, err := numberFromInt64[int8](-128)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int8(-128), v, "should return correct value")
+	_, err = numberFromInt64[int8](128)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should return range error")
+}
+
+func (suite *numberFromInt64Suite) TestUnsigned() {
+	v, err := numberFromInt64[uint8](255)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(uint8(255), v, "should return correct value")
+	for _, src := range []int64{-1, 256} {
+		_, err = numberFromInt64[uint8](src)
+		var rangeErr *RangeError
+		suite.Truef(errors.As(err, &rangeErr), "should return range error for %d", src)
+	}
+}
+
+func (suite *numberFromInt64Suite) TestFloat() {
+	v, err := numberFromInt64[float64](1 << 53)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(float64(1<<53), v, "should return correct value")
+	for _, src := range []int64{1<<53 + 1, math.MaxInt64} {
+		_, err = numberFromInt64[float64](src)
+		var precisionErr *PrecisionError
+		suite.Truef(errors.As(err, &precisionErr), "should return precision error for %d", src)
+	}
+}
+
+func TestNumberFromInt64(t *testing.T) {
+	suite.Run(t, new(numberFromInt64Suite))
+}
+
+// numberFromUint64Suite tests numberFromUint64.
+type numberFromUint64Suite struct {
+	suite.Suite
+}
+
+func (suite *numberFromUint64Suite) TestSigned() {
+	v, err := numberFromUint64[int64](math.MaxInt64)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(math.MaxInt64), v, "should return correct value")
+	for _, src := range []uint64{math.MaxInt64 + 1, math.MaxUint64} {
+		_, err = numberFromUint64[int64](src)
+		var rangeErr *RangeError
+		suite.Truef(errors.As(err, &rangeErr), "should return range error for %d", src)
+	}
+}
+
+func (suite *numberFromUint64Suite) TestUnsigned() {
+	v, err := numberFromUint64[uint64](math.MaxUint64)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(uint64(math.MaxUint64), v, "should return correct value")
+	_, err = numberFromUint64[uint16](math.MaxUint16 + 1)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should return range error")
+}
+
+func (suite *numberFromUint64Suite) TestFloat() {
+	_, err := numberFromUint64[float64](math.MaxUint64)
+	var precisionErr *PrecisionError
+	suite.True(errors.As(err, &precisionErr), "should return precision error")
+}
+
+func TestNumberFromUint64(t *testing.T) {
+	suite.Run(t, new(numberFromUint64Suite))
+}
+
+// numberFromFloat64Suite tests numberFromFloat64.
+type numberFromFloat64Suite struct {
+	suite.Suite
+}
+
+func (suite *numberFromFloat64Suite) TestInteger() {
+	v, err := numberFromFloat64[int16](-32768)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int16(-32768), v, "should return correct value")
+	u, err := numberFromFloat64[uint64](1 << 63)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(uint64(1<<63), u, "should return correct value")
+}
+
+func (suite *numberFromFloat64Suite) TestIntegerPrecisionLoss() {
+	_, err := numberFromFloat64[int](1.5)
+	var precisionErr *PrecisionError
+	suite.True(errors.As(err, &precisionErr), "should return precision error")
+}
+
+func (suite *numberFromFloat64Suite) TestIntegerOutOfRange() {
+	for _, src := range []float64{32768, math.Inf(1), math.NaN(), 1 << 63} {
+		_, err := numberFromFloat64[int16](src)
+		var rangeErr *RangeError
+		suite.Truef(errors.As(err, &rangeErr), "should return range error for %v", src)
+	}
+	for _, src := range []float64{-1, 1 << 64} {
+		_, err := numberFromFloat64[uint64](src)
+		var rangeErr *RangeError
+		suite.Truef(errors.As(err, &rangeErr), "should return range error for %v", src)
+	}
+}
+
+func (suite *numberFromFloat64Suite) TestFloat32() {
+	for _, src := range []float64{0.1, float64(float32(0.1)), math.MaxFloat32, math.Inf(-1)} {
+		v, err := numberFromFloat64[float32](src)
+		suite.Require().NoErrorf(err, "should not fail for %v", src)
+		suite.Equalf(float32(src), v, "should return correct value for %v", src)
+	}
+	v, err := numberFromFloat64[float32](math.NaN())
+	suite.Require().NoError(err, "should not fail")
+	suite.True(math.IsNaN(float64(v)), "should return NaN")
+}
+
+func (suite *numberFromFloat64Suite) TestFloat32PrecisionLoss() {
+	_, err := numberFromFloat64[float32](0.123456789012)
+	var precisionErr *PrecisionError
+	suite.True(errors.As(err, &precisionErr), "should return precision error")
+}
+
+func (suite *numberFromFloat64Suite) TestFloat32OutOfRange() {
+	_, err := numberFromFloat64[float32](math.MaxFloat64)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should return range error")
+}
+
+func TestNumberFromFloat64(t *testing.T) {
+	suite.Run(t, new(numberFromFloat64Suite))
+}
+
+// NumberMarshalJSONSuite tests Number.MarshalJSON.
+type NumberMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *NumberMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(Number[int8]{V: 16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *NumberMarshalJSONSuite) TestOK() {
+	raw, err := json.Marshal(NewNumber[float32](0.1))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`0.1`, string(raw), "should return correct value")
+}
+
+func TestNumber_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(NumberMarshalJSONSuite))
+}
+
+// NumberUnmarshalJSONSuite tests Number.UnmarshalJSON.
+type NumberUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *NumberUnmarshalJSONSuite) TestNull() {
+	n := NewNumber[int8](16)
+	err := json.Unmarshal(jsonNull, &n)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *NumberUnmarshalJSONSuite) TestUnmarshalFail() {
+	for _, data := range []string{`"16"`, `1.0`, `true`} {
+		var n Number[int8]
+		err := json.Unmarshal([]byte(data), &n)
+		suite.Errorf(err, "should fail for %s", data)
+	}
+}
+
+func (suite *NumberUnmarshalJSONSuite) TestOutOfRange() {
+	var n Number[int8]
+	err := json.Unmarshal([]byte(`128`), &n)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should return range error")
+}
+
+func (suite *NumberUnmarshalJSONSuite) TestPrecisionLoss() {
+	var n Number[float32]
+	err := json.Unmarshal([]byte(`0.123456789012`), &n)
+	var precisionErr *PrecisionError
+	suite.True(errors.As(err, &precisionErr), "should return precision error")
+}
+
+func (suite *NumberUnmarshalJSONSuite) TestOK() {
+	var n Number[int8]
+	err := json.Unmarshal([]byte(`-16`), &n)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNumber[int8](-16), n, "should unmarshal correct value")
+}
+
+func (suite *NumberUnmarshalJSONSuite) TestNamed() {
+	var n Number[testNamedNumber]
+	err := json.Unmarshal([]byte(`16`), &n)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNumber[testNamedNumber](16), n, "should unmarshal correct value")
+}
+
+func TestNumber_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(NumberUnmarshalJSONSuite))
+}
+
+// NumberMarshalTextSuite tests Number.MarshalText.
+type NumberMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *NumberMarshalTextSuite) TestNotValid() {
+	text, err := Number[int8]{V: 16}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *NumberMarshalTextSuite) TestOK() {
+	for n, expect := range map[fmt.Stringer]string{
+		NewNumber[int8](-16):              "-16",
+		NewNumber[uint64](math.MaxUint64): "18446744073709551615",
+		NewNumber[float32](0.1):           "0.1",
+		NewNumber[float64](1e21):          "1e+21",
+	} {
+		text, err := n.(encoding.TextMarshaler).MarshalText()
+		suite.Require().NoErrorf(err, "should not fail for %v", n)
+		suite.Equalf(expect, string(text), "should return correct value for %v", n)
+	}
+}
+
+func TestNumber_MarshalText(t *testing.T) {
+	suite.Run(t, new(NumberMarshalTextSuite))
+}
+
+// NumberUnmarshalTextSuite tests Number.UnmarshalText.
+type NumberUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *NumberUnmarshalTextSuite) TestEmpty() {
+	n := NewNumber[int8](16)
+	err := n.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *NumberUnmarshalTextSuite) TestUnmarshalFail() {
+	var n Number[float64]
+	err := n.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *NumberUnmarshalTextSuite) TestOutOfRange() {
+	for _, text := range []string{`-1`, `256`} {
+		var n Number[uint8]
+		err := n.UnmarshalText([]byte(text))
+		var rangeErr *RangeError
+		suite.Truef(errors.As(err, &rangeErr), "should return range error for %s", text)
+	}
+	var f Number[float64]
+	err := f.UnmarshalText([]byte(`1e309`))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should return range error")
+}
+
+func (suite *NumberUnmarshalTextSuite) TestOK() {
+	var n Number[float32]
+	err := n.UnmarshalText([]byte(`0.1`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNumber[float32](0.1), n, "should unmarshal correct value")
+}
+
+func TestNumber_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(NumberUnmarshalTextSuite))
+}
+
+// NumberMarshalYAMLSuite tests Number.MarshalYAML.
+type NumberMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *NumberMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Number[int8]{V: 16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *NumberMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewNumber[int8](-16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("-16\n", string(raw), "should return correct value")
+}
+
+func TestNumber_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(NumberMarshalYAMLSuite))
+}
+
+// NumberUnmarshalYAMLSuite tests Number.UnmarshalYAML.
+type NumberUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *NumberUnmarshalYAMLSuite) TestNull() {
+	n := NewNumber[int8](16)
+	err := n.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *NumberUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var n Number[float64]
+	err := yaml.Unmarshal([]byte(`meow`), &n)
+	suite.Error(err, "should fail")
+}
+
+func (suite *NumberUnmarshalYAMLSuite) TestOutOfRange() {
+	var n Number[uint8]
+	err := yaml.Unmarshal([]byte(`-1`), &n)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should return range error")
+}
+
+func (suite *NumberUnmarshalYAMLSuite) TestPrecisionLoss() {
+	var n Number[float32]
+	err := yaml.Unmarshal([]byte(`0.123456789012`), &n)
+	var precisionErr *PrecisionError
+	suite.True(errors.As(err, &precisionErr), "should return precision error")
+}
+
+func (suite *NumberUnmarshalYAMLSuite) TestOK() {
+	var n Number[float32]
+	err := yaml.Unmarshal([]byte(`-0.25`), &n)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewNumber[float32](-0.25), n, "should unmarshal correct value")
+}
+
+func TestNumber_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(NumberUnmarshalYAMLSuite))
+}
+
+// NumberScanSuite tests Number.Scan.
+type NumberScanSuite struct {
+	suite.Suite
+}
+
+func (suite *NumberScanSuite) TestNull() {
+	n := NewNumber[int8](16)
+	err := n.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *NumberScanSuite) TestUnsupported() {
+	var n Number[int8]
+	err := n.Scan(true)
+	suite.Error(err, "should fail")
+}
+
+func (suite *NumberScanSuite) TestOutOfRange() {
+	for _, src := range []any{int64(128), uint64(128), float64(128), "128", []byte("128")} {
+		var n Number[int8]
+		err := n.Scan(src)
+		var rangeErr *RangeError
+		suite.Truef(errors.As(err, &rangeErr), "should return range error for %v", src)
+	}
+}
+
+func (suite *NumberScanSuite) TestPrecisionLoss() {
+	var n Number[int8]
+	err := n.Scan(1.5)
+	var precisionErr *PrecisionError
+	suite.True(errors.As(err, &precisionErr), "should return precision error")
+}
+
+func (suite *NumberScanSuite) TestOK() {
+	for _, src := range []any{int64(16), uint8(16), float32(16), float64(16), "16", []byte("16")} {
+		var n Number[int8]
+		err := n.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %v", src)
+		suite.Equalf(NewNumber[int8](16), n, "should scan correct value for %v", src)
+	}
+}
+
+func TestNumber_Scan(t *testing.T) {
+	suite.Run(t, new(NumberScanSuite))
+}
+
+// NumberValueSuite tests Number.Value.
+type NumberValueSuite struct {
+	suite.Suite
+}
+
+func (suite *NumberValueSuite) TestNull() {
+	raw, err := Number[int8]{V: 16}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *NumberValueSuite) TestOK() {
+	for n, expect := range map[any]any{
+		NewNumber[int8](-16):             int64(-16),
+		NewNumber[uint32](16):            int64(16),
+		NewNumber[float32](0.25):         float64(0.25),
+		NewNumber[testNamedNumber](16):   int64(16),
+		NewNumber[uint64](math.MaxInt64): int64(math.MaxInt64),
+	} {
+		raw, err := n.(driver.Valuer).Value()
+		suite.Require().NoErrorf(err, "should not fail for %v", n)
+		suite.Equalf(expect, raw, "should return correct value for %v", n)
+	}
+}
+
+func (suite *NumberValueSuite) TestOutOfRange() {
+	_, err := NewNumber[uint64](math.MaxUint64).Value()
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should return range error")
+}
+
+func TestNumber_Value(t *testing.T) {
+	suite.Run(t, new(NumberValueSuite))
+}
+
+// TestNumber_Get tests Number.Get.
+func TestNumber_Get(t *testing.T) {
+	v, ok := NewNumber[int8](16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, int8(16), v, "should return correct value")
+	_, ok = Number[int8]{V: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestNumber_IsZero tests Number.IsZero.
+func TestNumber_IsZero(t *testing.T) {
+	assert.False(t, NewNumber[int8](0).IsZero(), "should not be zero")
+	assert.True(t, Number[int8]{V: 16}.IsZero(), "should be zero")
+}
+
+// TestNumber_Equal tests Number.Equal.
+func TestNumber_Equal(t *testing.T) {
+	n := NewNumber[int8](16)
+	assert.True(t, n.Equal(NewNumber[int8](16)), "should be equal")
+	assert.False(t, n.Equal(NewNumber[int8](17)), "should not be equal")
+	assert.False(t, n.Equal(Number[int8]{V: 16}), "should not be equal")
+	assert.True(t, Number[int8]{V: 16}.Equal(Number[int8]{}), "should be equal")
+}
+
+// TestNumber_String tests Number.String.
+func TestNumber_String(t *testing.T) {
+	assert.Equal(t, "16", NewNumber[int8](16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Number[int8]{}.String(), "should return null token")
+}
+
+// TestNumber_Format tests Number.Format.
+func TestNumber_Format(t *testing.T) {
+	assert.Equal(t, "00016", fmt.Sprintf("%05d", NewNumber[int8](16)), "should return correct value")
+	assert.Equal(t, "0.25", fmt.Sprintf("%.2f", NewNumber[float32](0.25)), "should return correct value")
+	assert.Equal(t, "<null>", fmt.Sprintf("%d", Number[int8]{}), "should return null token")
+}
+
+// TestNumber_GoString tests Number.GoString.
+func TestNumber_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewNumber[int8](-16)", fmt.Sprintf("%#v", NewNumber[int8](-16)), "should return correct value")
+	assert.Equal(t, "nulls.NewNumber[uint16](16)", NewNumber[uint16](16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.NewNumber[float32](0.1)", NewNumber[float32](0.1).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Number[int8]{}", Number[int8]{}.GoString(), "should return correct value")
+}
+
+// TestNumber_LogValue tests Number.LogValue.
+func TestNumber_LogValue(t *testing.T) {
+	assert.Equal(t, int64(-16), NewNumber[int8](-16).LogValue().Any(), "should return correct value")
+	assert.Equal(t, uint64(16), NewNumber[uint8](16).LogValue().Any(), "should return correct value")
+	assert.Equal(t, 0.25, NewNumber[float32](0.25).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Number[int8]{V: 16}.LogValue().Any(), "should return null value")
+}
+
+// TestNumberFromPtr tests NumberFromPtr and Number.Ptr.
+func TestNumberFromPtr(t *testing.T) {
+	assert.False(t, NumberFromPtr[int8](nil).Valid, "should not be valid")
+	assert.Nil(t, Number[int8]{V: 16}.Ptr(), "should return nil")
+	v := int8(16)
+	p := NumberFromPtr(&v).Ptr()
+	assert.Equal(t, &v, p, "should return correct value")
+}
+
+// TestNumberFromSQLNull tests NumberFromSQLNull and Number.ToSQLNull.
+func TestNumberFromSQLNull(t *testing.T) {
+	n := sql.Null[int8]{V: 16, Valid: true}
+	assert.Equal(t, NewNumber[int8](16), NumberFromSQLNull(n), "should return correct value")
+	assert.Equal(t, n, NewNumber[int8](16).ToSQLNull(), "should return correct value")
+}
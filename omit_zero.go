@@ -0,0 +1,330 @@
+package nulls
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// isZeroer is implemented by all types of this package.
+type isZeroer interface {
+	IsZero() bool
+}
+
+// jsonMarshalerType and textMarshalerType are used for checking whether values
+// provide their own JSON representation.
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// MarshalJSONOmitZero marshals the given struct like json.Marshal but omits
+// fields with the omitempty or omitzero option, whose IsZero method returns
+// true. This allows omitting NULL-values with Go versions prior to 1.24 that do
+// not support omitzero. Other fields with the omitempty option are omitted if
+// empty as usual.
+//
+// Only fields of the given struct and embedded structs are considered. Nested
+// structs in fields are marshalled using json.Marshal. If v is not a struct or
+// a pointer to one, or if it implements json.Marshaler or
+// encoding.TextMarshaler, it is marshalled using json.Marshal as well.
+func MarshalJSONOmitZero(v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for {
+		if !rv.IsValid() || implementsJSONMarshaler(rv) {
+			return json.Marshal(v)
+		}
+		if rv.Kind() != reflect.Pointer || rv.IsNil() {
+			break
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return json.Marshal(v)
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for _, field := range jsonFields(rv.Type()) {
+		fieldValue, ok := fieldByIndex(rv, field.index)
+		if !ok {
+			continue
+		}
+		written, err := writeJSONFieldOmitZero(&buf, field, fieldValue, first)
+		if err != nil {
+			return nil, err
+		}
+		first = first && !written
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// implementsJSONMarshaler reports whether json.Marshal uses json.Marshaler or
+// encoding.TextMarshaler for the given value.
+func implementsJSONMarshaler(rv reflect.Value) bool {
+	t := rv.Type()
+	if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) {
+		return true
+	}
+	if !rv.CanAddr() {
+		return false
+	}
+	pt := reflect.PointerTo(t)
+	return pt.Implements(jsonMarshalerType) || pt.Implements(textMarshalerType)
+}
+
+// jsonField is a field of a struct as marshalled by encoding/json.
+type jsonField struct {
+	// name is the name of the field in JSON.
+	name string
+	// tagged is true if the name was given in the json tag.
+	tagged bool
+	// index is the index sequence for reflect.Value.FieldByIndex.
+	index []int
+	// typ is the type of the field or, for embedded structs, the struct type.
+	typ reflect.Type
+	// options are the options of the json tag.
+	options string
+	// goName is the name of the field in Go.
+	goName string
+}
+
+// jsonFields returns the fields of the given struct type as marshalled by
+// encoding/json in the same order. Fields of embedded structs are included, and
+// conflicting names are resolved using the same rules: Fields at a shallower
+// depth win over deeper ones, and tagged fields win over untagged ones at the
+// same depth. Otherwise, all fields with this name are omitted.
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	current := []jsonField{}
+	next := []jsonField{{typ: t}}
+	var count, nextCount map[reflect.Type]int
+	visited := map[reflect.Type]bool{}
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, map[reflect.Type]int{}
+		for _, f := range current {
+			if visited[f.typ] {
+				continue
+			}
+			visited[f.typ] = true
+			for i := 0; i < f.typ.NumField(); i++ {
+				sf := f.typ.Field(i)
+				if sf.Anonymous {
+					ft := sf.Type
+					if ft.Kind() == reflect.Pointer {
+						ft = ft.Elem()
+					}
+					if !sf.IsExported() && ft.Kind() != reflect.Struct {
+						continue
+					}
+				} else if !sf.IsExported() {
+					continue
+				}
+				tag := sf.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+				name, options, _ := strings.Cut(tag, ",")
+				index := make([]int, len(f.index)+1)
+				copy(index, f.index)
+				index[len(f.index)] = i
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Pointer {
+					ft = ft.Elem()
+				}
+				if name != "" || !sf.Anonymous || ft.Kind() != reflect.Struct {
+					field := jsonField{
+						name:    name,
+						tagged:  name != "",
+						index:   index,
+						typ:     ft,
+						options: options,
+						goName:  sf.Name,
+					}
+					if field.name == "" {
+						field.name = sf.Name
+					}
+					fields = append(fields, field)
+					if count[f.typ] > 1 {
+						// The struct was embedded multiple times at the same depth, so
+						// add the field twice for omitting it because of the conflict.
+						fields = append(fields, fields[len(fields)-1])
+					}
+					continue
+				}
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, jsonField{name: ft.Name(), index: index, typ: ft})
+				}
+			}
+		}
+	}
+	// Sort by name, depth and tagged first for resolving conflicts.
+	sort.SliceStable(fields, func(i, j int) bool {
+		if fields[i].name != fields[j].name {
+			return fields[i].name < fields[j].name
+		}
+		if len(fields[i].index) != len(fields[j].index) {
+			return len(fields[i].index) < len(fields[j].index)
+		}
+		if fields[i].tagged != fields[j].tagged {
+			return fields[i].tagged
+		}
+		return compareIndex(fields[i].index, fields[j].index) < 0
+	})
+	dominant := fields[:0]
+	for start := 0; start < len(fields); {
+		end := start + 1
+		for end < len(fields) && fields[end].name == fields[start].name {
+			end++
+		}
+		group := fields[start:end]
+		if len(group) == 1 || len(group[0].index) != len(group[1].index) || group[0].tagged != group[1].tagged {
+			dominant = append(dominant, group[0])
+		}
+		start = end
+	}
+	fields = dominant
+	sort.Slice(fields, func(i, j int) bool {
+		return compareIndex(fields[i].index, fields[j].index) < 0
+	})
+	return fields
+}
+
+// compareIndex compares the given index sequences of struct fields.
+func compareIndex(a []int, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+	return len(a) - len(b)
+}
+
+// fieldByIndex returns the field of the given struct value with the given index
+// sequence. If an embedded pointer on the way is nil, false is returned.
+func fieldByIndex(rv reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				return reflect.Value{}, false
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(x)
+	}
+	return rv, true
+}
+
+// writeJSONFieldOmitZero writes the given field with the given value to buf
+// unless it is omitted. first specifies whether no field was written so far.
+// It returns whether the field was written.
+func writeJSONFieldOmitZero(buf *bytes.Buffer, field jsonField, fieldValue reflect.Value, first bool) (bool, error) {
+	omitEmpty := hasJSONOption(field.options, "omitempty")
+	omitZero := hasJSONOption(field.options, "omitzero")
+	if (omitEmpty || omitZero) && isZeroerValue(fieldValue) {
+		return false, nil
+	}
+	if (omitEmpty && isEmptyJSONValue(fieldValue)) || (omitZero && fieldValue.IsZero()) {
+		return false, nil
+	}
+	rawName, err := json.Marshal(field.name)
+	if err != nil {
+		return false, fmt.Errorf("marshal field name %s: %w", field.name, err)
+	}
+	// Marshal addressable fields via pointer, so that marshallers with pointer
+	// receivers are used like in json.Marshal.
+	marshal := fieldValue
+	if marshal.CanAddr() {
+		marshal = marshal.Addr()
+	}
+	rawValue, err := json.Marshal(marshal.Interface())
+	if err != nil {
+		return false, fmt.Errorf("marshal field %s: %w", field.goName, err)
+	}
+	if hasJSONOption(field.options, "string") && quotesJSONStringOption(fieldValue) {
+		rawValue, err = json.Marshal(string(rawValue))
+		if err != nil {
+			return false, fmt.Errorf("marshal field %s as string: %w", field.goName, err)
+		}
+	}
+	if !first {
+		buf.WriteByte(',')
+	}
+	buf.Write(rawName)
+	buf.WriteByte(':')
+	buf.Write(rawValue)
+	return true, nil
+}
+
+// hasJSONOption checks if the given comma-separated options of a json tag
+// contain the given one.
+func hasJSONOption(options string, option string) bool {
+	for options != "" {
+		var current string
+		current, options, _ = strings.Cut(options, ",")
+		if current == option {
+			return true
+		}
+	}
+	return false
+}
+
+// isZeroerValue reports whether the given value implements IsZero and it returns
+// true. Nil pointers are not considered as they are handled like in
+// encoding/json.
+func isZeroerValue(v reflect.Value) bool {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return false
+	}
+	zeroer, ok := v.Interface().(isZeroer)
+	return ok && zeroer.IsZero()
+}
+
+// quotesJSONStringOption reports whether the string option of encoding/json
+// quotes the given field value. Like in encoding/json, pointers of unnamed type
+// are dereferenced, nil pointers stay null and marshallers are not quoted.
+func quotesJSONStringOption(v reflect.Value) bool {
+	if v.Kind() == reflect.Pointer && v.Type().Name() == "" {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	return isJSONStringOptionKind(v.Kind()) && !implementsJSONMarshaler(v)
+}
+
+// isJSONStringOptionKind reports whether the string option of encoding/json
+// applies to the given kind.
+func isJSONStringOptionKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	}
+	return false
+}
+
+// isEmptyJSONValue reports whether the given value is empty as defined by the
+// omitempty option of encoding/json.
+func isEmptyJSONValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
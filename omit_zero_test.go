@@ -0,0 +1,203 @@
+package nulls
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+// MarshalJSONOmitZeroSuite tests MarshalJSONOmitZero.
+type MarshalJSONOmitZeroSuite struct {
+	suite.Suite
+}
+
+type omitZeroEmbedded struct {
+	E String `json:"e,omitempty"`
+	F int    `json:"f"`
+}
+
+type omitZeroStruct struct {
+	omitZeroEmbedded
+	A       Int            `json:"a,omitempty"`
+	B       Int            `json:"b,omitzero"`
+	C       Int            `json:"c"`
+	D       Optional[bool] `json:"d,omitempty"`
+	P       Patch[string]  `json:"p,omitzero"`
+	N       *Int           `json:"n,omitempty"`
+	S       string         `json:"s,omitempty"`
+	Z       int            `json:"z,omitzero"`
+	Q       int            `json:"q,string"`
+	Skipped string         `json:"-"`
+	Plain   string
+	private string
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestNotStruct() {
+	raw, err := MarshalJSONOmitZero([]Int{{}, NewInt(16)})
+	suite.Require().NoError(err, "should not fail")
+	suite.JSONEq(`[null, 16]`, string(raw), "should return correct value")
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestOmit() {
+	v := omitZeroStruct{
+		Skipped: "meow",
+		private: "meow",
+	}
+	raw, err := MarshalJSONOmitZero(v)
+	suite.Require().NoError(err, "should not fail")
+	suite.JSONEq(`{"f": 0, "c": null, "q": "0", "Plain": ""}`, string(raw), "should return correct value")
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestValid() {
+	n := NewInt(4)
+	v := &omitZeroStruct{
+		omitZeroEmbedded: omitZeroEmbedded{E: NewString("meow"), F: 1},
+		A:                NewInt(1),
+		B:                NewInt(2),
+		C:                NewInt(3),
+		D:                NewOptional(false),
+		P:                NewNullPatch[string](),
+		N:                &n,
+		S:                "meow",
+		Z:                5,
+		Q:                6,
+		Plain:            "woof",
+	}
+	raw, err := MarshalJSONOmitZero(v)
+	suite.Require().NoError(err, "should not fail")
+	expected, err := json.Marshal(v)
+	suite.Require().NoError(err, "should not fail")
+	suite.JSONEq(string(expected), string(raw), "should marshal like json.Marshal")
+	suite.JSONEq(`{"e": "meow", "f": 1, "a": 1, "b": 2, "c": 3, "d": false, "p": null, "n": 4, "s": "meow",
+		"z": 5, "q": "6", "Plain": "woof"}`, string(raw), "should return correct value")
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestOrder() {
+	raw, err := MarshalJSONOmitZero(struct {
+		B Int `json:"b"`
+		A Int `json:"a"`
+	}{B: NewInt(1), A: NewInt(2)})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`{"b":1,"a":2}`, string(raw), "should keep field order")
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestMarshaler() {
+	raw, err := MarshalJSONOmitZero(NewInt(5))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`5`, string(raw), "should use MarshalJSON of root")
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestMarshalerPtr() {
+	n := NewInt(5)
+	raw, err := MarshalJSONOmitZero(&n)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`5`, string(raw), "should use MarshalJSON of root")
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestPromotedMarshaler() {
+	v := struct {
+		Int
+	}{Int: NewInt(5)}
+	raw, err := MarshalJSONOmitZero(v)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`5`, string(raw), "should use promoted MarshalJSON")
+}
+
+type omitZeroConflictA struct {
+	X Int    `json:"x,omitzero"`
+	Y string `json:"y"`
+	Z string
+}
+
+type omitZeroConflictB struct {
+	X Int    `json:"x,omitzero"`
+	Y string `json:"Z"`
+	W string `json:"w"`
+}
+
+type omitZeroConflictStruct struct {
+	omitZeroConflictA
+	*omitZeroConflictB
+	W Int `json:"w,omitzero"`
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestEmbeddedConflict() {
+	v := omitZeroConflictStruct{
+		omitZeroConflictA: omitZeroConflictA{X: NewInt(1), Y: "a", Z: "a"},
+		omitZeroConflictB: &omitZeroConflictB{X: NewInt(2), Y: "b", W: "b"},
+		W:                 NewInt(3),
+	}
+	raw, err := MarshalJSONOmitZero(v)
+	suite.Require().NoError(err, "should not fail")
+	expected, err := json.Marshal(v)
+	suite.Require().NoError(err, "should not fail")
+	suite.JSONEq(string(expected), string(raw), "should marshal like json.Marshal")
+	suite.Equal(`{"y":"a","Z":"b","w":3}`, string(raw), "should return correct value")
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestEmbeddedNilPtr() {
+	v := omitZeroConflictStruct{
+		omitZeroConflictA: omitZeroConflictA{Y: "a", Z: "a"},
+	}
+	raw, err := MarshalJSONOmitZero(v)
+	suite.Require().NoError(err, "should not fail")
+	expected, err := json.Marshal(v)
+	suite.Require().NoError(err, "should not fail")
+	suite.JSONEq(string(expected), string(raw), "should marshal like json.Marshal")
+	suite.Equal(`{"y":"a"}`, string(raw), "should skip fields of nil embedded pointer")
+}
+
+// omitZeroText is a string kind implementing encoding.TextMarshaler, which is
+// not quoted by the string option of encoding/json.
+type omitZeroText string
+
+func (t omitZeroText) MarshalText() ([]byte, error) {
+	return []byte("text:" + t), nil
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestStringOption() {
+	i := 5
+	str := "meow"
+	b := true
+	d := time.Second
+	v := struct {
+		I     int            `json:"i,string"`
+		IPtr  *int           `json:"iPtr,string"`
+		INil  *int           `json:"iNil,string"`
+		S     string         `json:"s,string"`
+		SPtr  *string        `json:"sPtr,string"`
+		BPtr  *bool          `json:"bPtr,string"`
+		DPtr  *time.Duration `json:"dPtr,string"`
+		Text  omitZeroText   `json:"text,string"`
+		Int   Int            `json:"int,string"`
+		Slice []int          `json:"slice,string"`
+	}{
+		I:     i,
+		IPtr:  &i,
+		S:     str,
+		SPtr:  &str,
+		BPtr:  &b,
+		DPtr:  &d,
+		Text:  "woof",
+		Int:   NewInt(7),
+		Slice: []int{1},
+	}
+	raw, err := MarshalJSONOmitZero(v)
+	suite.Require().NoError(err, "should not fail")
+	expected, err := json.Marshal(v)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(string(expected), string(raw), "should marshal like json.Marshal")
+	suite.Contains(string(raw), `"iPtr":"5"`, "should quote pointer to int")
+}
+
+func (suite *MarshalJSONOmitZeroSuite) TestMarshalFail() {
+	_, err := MarshalJSONOmitZero(struct {
+		A Optional[chan int] `json:"a"`
+	}{A: NewOptional(make(chan int))})
+	suite.Error(err, "should fail")
+}
+
+func TestMarshalJSONOmitZero(t *testing.T) {
+	suite.Run(t, new(MarshalJSONOmitZeroSuite))
+}
@@ -1,13 +1,17 @@
 package nulls
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
-// Optional holds a nullable value. This can be used instead of Nullable or
-// NullableInto if database support is not required.
+// Optional holds a nullable value. Database support is available for T
+// implementing sql.Scanner and driver.Valuer as well as for basic kinds
+// supported by the sql package. Otherwise, use Nullable or NullableInto.
 type Optional[T any] struct {
 	// V is the actual value when Valid.
 	V T `exhaustruct:"optional"`
@@ -23,6 +27,104 @@ func NewOptional[T any](v T) Optional[T] {
 	}
 }
 
+// OptionalFromPtr returns a Optional that is valid if the given pointer is not
+// nil.
+func OptionalFromPtr[T any](v *T) Optional[T] {
+	if v == nil {
+		return Optional[T]{}
+	}
+	return NewOptional(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (n Optional[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// OptionalFromSQLNull returns a Optional from the given sql.Null.
+func OptionalFromSQLNull[T any](v sql.Null[T]) Optional[T] {
+	return Optional[T]{
+		V:     v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (n Optional[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{
+		V:     n.V,
+		Valid: n.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (n Optional[T]) Get() (T, bool) {
+	return n.V, n.Valid
+}
+
+// IsNull returns true if not valid.
+func (n Optional[T]) IsNull() bool {
+	return !n.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (n *Optional[T]) Set(v T) {
+	*n = NewOptional(v)
+}
+
+// SetNull sets a NULL-value.
+func (n *Optional[T]) SetNull() {
+	*n = Optional[T]{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (n Optional[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+// Values are compared using their Equal method if available or
+// reflect.DeepEqual otherwise.
+func (n Optional[T]) Equal(other Optional[T]) bool {
+	if !n.Valid || !other.Valid {
+		return n.Valid == other.Valid
+	}
+	return equalValues(n.V, other.V)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (n Optional[T]) String() string {
+	return formatString(n.V, n.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (n Optional[T]) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n, n.V, n.Valid)
+}
+
+// GoString returns a Go expression creating the Optional.
+func (n Optional[T]) GoString() string {
+	if !n.Valid {
+		return fmt.Sprintf("nulls.Optional[%T]{}", n.V)
+	}
+	return fmt.Sprintf("nulls.NewOptional[%T](%#v)", n.V, n.V)
+}
+
+// LogValue implements slog.LogValuer. The value is logged like T or as NULL-value
+// if not valid.
+func (n Optional[T]) LogValue() slog.Value {
+	if !n.Valid {
+		return logNullValue()
+	}
+	return slog.AnyValue(n.V)
+}
+
 // MarshalJSON as value. If not vot valid, a NULL-value is returned.
 func (n Optional[T]) MarshalJSON() ([]byte, error) {
 	if !n.Valid {
@@ -33,22 +135,94 @@ func (n Optional[T]) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON as value or sets Valid or false if null.
 func (n *Optional[T]) UnmarshalJSON(data []byte) error {
-	if string(data) == "null" {
-		n.Valid = false
+	if isNull(data) {
+		n.SetNull()
 		return nil
 	}
 	n.Valid = true
 	return json.Unmarshal(data, &n.V)
 }
 
-// Scan returns an error as this is currently not supported on Optional. Use
-// Nullable or NullableInto instead.
-func (n *Optional[T]) Scan(_ any) error {
-	return fmt.Errorf("cannot scan optional")
+// MarshalText marshals the value using encoding.TextMarshaler if T implements
+// it or formats strings, booleans and numbers like with strconv. If not valid,
+// empty text is returned.
+func (n Optional[T]) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return marshalText(&n.V)
 }
 
-// Value returns an error as this is currently not supported on Optional. Use
-// Nullable or NullableInto instead.
+// UnmarshalText using encoding.TextUnmarshaler if T implements it or parses
+// strings, booleans and numbers like with strconv. If empty, Valid is set to
+// false.
+func (n *Optional[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+	var v T
+	err := unmarshalText(&v, text)
+	if err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// MarshalYAML as value. If not valid, a NULL-value is returned.
+func (n Optional[T]) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.V, nil
+}
+
+// UnmarshalYAML as value or sets Valid to false if null.
+func (n *Optional[T]) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		n.SetNull()
+		return nil
+	}
+	n.Valid = true
+	return node.Decode(&n.V)
+}
+
+// Scan to value or not valid if nil. If T implements sql.Scanner, it is used.
+// Otherwise, the conversion rules of the sql package are applied like for
+// sql.Null.
+func (n *Optional[T]) Scan(src any) error {
+	if src == nil {
+		n.SetNull()
+		return nil
+	}
+	if scanner, ok := any(&n.V).(sql.Scanner); ok {
+		n.Valid = true
+		return scanner.Scan(src)
+	}
+	var v sql.Null[T]
+	err := v.Scan(src)
+	if err != nil {
+		return fmt.Errorf("scan optional of type %T: %w", n.V, err)
+	}
+	n.V = v.V
+	n.Valid = v.Valid
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. If T
+// implements driver.Valuer, it is used. Otherwise, the value is converted using
+// driver.DefaultParameterConverter.
 func (n Optional[T]) Value() (driver.Value, error) {
-	return nil, fmt.Errorf("cannot value optional")
+	if !n.Valid {
+		return nil, nil
+	}
+	if valuer, ok := any(n.V).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	v, err := driver.DefaultParameterConverter.ConvertValue(n.V)
+	if err != nil {
+		return nil, fmt.Errorf("value optional of type %T: %w", n.V, err)
+	}
+	return v, nil
 }
@@ -1,13 +1,17 @@
 package nulls
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
+	"time"
 )
 
 func TestOptional(t *testing.T) {
@@ -120,26 +124,127 @@ func TestOptional_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(OptionalUnmarshalJSONSuite))
 }
 
+// OptionalMarshalYAMLSuite tests Optional.MarshalYAML.
+type OptionalMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *OptionalMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Optional[int]{V: 16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *OptionalMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewOptional(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16\n", string(raw), "should return correct value")
+}
+
+func TestOptional_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(OptionalMarshalYAMLSuite))
+}
+
+// OptionalUnmarshalYAMLSuite tests Optional.UnmarshalYAML.
+type OptionalUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *OptionalUnmarshalYAMLSuite) TestNull() {
+	v := NewOptional(16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *OptionalUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Optional[int] `yaml:"v"`
+		W Optional[int] `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *OptionalUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Optional[int]
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *OptionalUnmarshalYAMLSuite) TestOK() {
+	var v Optional[int]
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(42, v.V, "should unmarshal correct value")
+}
+
+func TestOptional_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(OptionalUnmarshalYAMLSuite))
+}
+
 // OptionalScanSuite tests Optional.Scan.
 type OptionalScanSuite struct {
 	suite.Suite
 }
 
 func (suite *OptionalScanSuite) TestNull() {
-	n := Optional[OptionalValueMock]{V: OptionalValueMock{}}
+	n := NewOptional(16)
 	err := n.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
+func (suite *OptionalScanSuite) TestScannerFail() {
+	src := "Hello World!"
+	n := Optional[OptionalValueMock]{V: OptionalValueMock{}}
+	n.V.On("Scan", src).Return(errors.New("sad life"))
+	defer n.V.AssertExpectations(suite.T())
+	err := n.Scan(src)
 	suite.Error(err, "should fail")
 }
 
-func (suite *OptionalScanSuite) TestScan() {
+func (suite *OptionalScanSuite) TestScanner() {
 	src := "Hello World!"
 	n := Optional[OptionalValueMock]{V: OptionalValueMock{}}
-	n.V.On("Scan", src).Return(nil).Maybe()
+	n.V.On("Scan", src).Return(nil)
 	defer n.V.AssertExpectations(suite.T())
 	err := n.Scan(src)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(n.Valid, "should be valid")
+}
+
+func (suite *OptionalScanSuite) TestConvert() {
+	var i Optional[int]
+	err := i.Scan(int64(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewOptional(16), i, "should scan correct value")
+	var s Optional[string]
+	err = s.Scan([]byte("meow"))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewOptional("meow"), s, "should scan correct value")
+	var tt Optional[time.Time]
+	err = tt.Scan(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewOptional(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)), tt, "should scan correct value")
+}
+
+func (suite *OptionalScanSuite) TestConvertFail() {
+	var n Optional[int]
+	err := n.Scan("meow")
 	suite.Error(err, "should fail")
 }
 
+func (suite *OptionalScanSuite) TestUnsupported() {
+	var n Optional[myStruct]
+	err := n.Scan("meow")
+	suite.Error(err, "should fail")
+	suite.False(n.Valid, "should not be valid")
+}
+
 func TestOptional_Scan(t *testing.T) {
 	suite.Run(t, new(OptionalScanSuite))
 }
@@ -151,18 +256,304 @@ type OptionalValueSuite struct {
 
 func (suite *OptionalValueSuite) TestNull() {
 	n := Optional[OptionalValueMock]{V: OptionalValueMock{}}
+	v, err := n.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(v, "should return correct value")
+}
+
+func (suite *OptionalValueSuite) TestValuerFail() {
+	n := NewOptional(OptionalValueMock{})
+	n.V.On("Value").Return(nil, errors.New("sad life"))
+	defer n.V.AssertExpectations(suite.T())
 	_, err := n.Value()
 	suite.Error(err, "should fail")
 }
 
-func (suite *OptionalValueSuite) TestValue() {
+func (suite *OptionalValueSuite) TestValuer() {
 	n := NewOptional(OptionalValueMock{})
-	n.V.On("Value").Return("Hello", nil).Maybe()
+	n.V.On("Value").Return("Hello", nil)
 	defer n.V.AssertExpectations(suite.T())
-	_, err := n.Value()
+	v, err := n.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("Hello", v, "should return correct value")
+}
+
+func (suite *OptionalValueSuite) TestConvert() {
+	v, err := NewOptional(16).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(16), v, "should return correct value")
+	v, err = NewOptional(uint8(16)).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(16), v, "should return correct value")
+	type myString string
+	v, err = NewOptional(myString("meow")).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("meow", v, "should return correct value")
+}
+
+func (suite *OptionalValueSuite) TestUnsupported() {
+	_, err := NewOptional(struct{ A string }{A: "meow"}).Value()
 	suite.Error(err, "should fail")
 }
 
 func TestOptional_Value(t *testing.T) {
 	suite.Run(t, new(OptionalValueSuite))
 }
+
+// TestOptional_Get tests Optional.Get.
+func TestOptional_Get(t *testing.T) {
+	v, ok := NewOptional(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, 16, v, "should return correct value")
+	_, ok = Optional[int]{V: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestOptional_IsZero tests Optional.IsZero.
+func TestOptional_IsZero(t *testing.T) {
+	assert.False(t, NewOptional(16).IsZero(), "should not be zero")
+	assert.True(t, Optional[int]{V: 16}.IsZero(), "should be zero")
+}
+
+// TestOptional_Equal tests Optional.Equal.
+func TestOptional_Equal(t *testing.T) {
+	assert.True(t, NewOptional(16).Equal(NewOptional(16)), "should be equal")
+	assert.False(t, NewOptional(16).Equal(NewOptional(17)), "should not be equal")
+	assert.False(t, NewOptional(16).Equal(Optional[int]{V: 16}), "should not be equal")
+	assert.False(t, Optional[int]{V: 16}.Equal(NewOptional(16)), "should not be equal")
+	assert.True(t, Optional[int]{V: 16}.Equal(Optional[int]{}), "should be equal")
+}
+
+// TestOptional_String tests Optional.String.
+func TestOptional_String(t *testing.T) {
+	assert.Equal(t, "16", NewOptional(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Optional[int]{V: 16}.String(), "should return null token")
+}
+
+// OptionalFormatSuite tests Optional.Format.
+type OptionalFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *OptionalFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Optional[int]{V: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Optional[int]{V: 16}), "should respect width")
+}
+
+func (suite *OptionalFormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewOptional(16)), "should return correct value")
+}
+
+func (suite *OptionalFormatSuite) TestVerb() {
+	suite.Equal("016", fmt.Sprintf("%03d", NewOptional(16)), "should return correct value")
+}
+
+func (suite *OptionalFormatSuite) TestGoSyntax() {
+	suite.Equal(NewOptional(16).GoString(), fmt.Sprintf("%#v", NewOptional(16)), "should use GoString")
+}
+
+func TestOptional_Format(t *testing.T) {
+	suite.Run(t, new(OptionalFormatSuite))
+}
+
+// TestOptional_GoString tests Optional.GoString.
+func TestOptional_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewOptional[int](16)", NewOptional(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Optional[int]{}", Optional[int]{V: 16}.GoString(), "should return correct value")
+}
+
+// TestOptional_LogValue tests Optional.LogValue.
+func TestOptional_LogValue(t *testing.T) {
+	assert.Equal(t, int64(16), NewOptional(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Optional[int]{V: 16}.LogValue().Any(), "should return null value")
+}
+
+// OptionalFromPtrSuite tests OptionalFromPtr.
+type OptionalFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *OptionalFromPtrSuite) TestNil() {
+	v := OptionalFromPtr[int](nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *OptionalFromPtrSuite) TestZero() {
+	x := 0
+	v := OptionalFromPtr[int](&x)
+	suite.Equal(NewOptional(x), v, "should return correct value")
+}
+
+func (suite *OptionalFromPtrSuite) TestOK() {
+	x := 16
+	v := OptionalFromPtr[int](&x)
+	suite.Equal(NewOptional(x), v, "should return correct value")
+}
+
+func TestOptionalFromPtr(t *testing.T) {
+	suite.Run(t, new(OptionalFromPtrSuite))
+}
+
+// OptionalPtrSuite tests Optional.Ptr.
+type OptionalPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *OptionalPtrSuite) TestNotValid() {
+	v := Optional[int]{V: 16}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *OptionalPtrSuite) TestZero() {
+	p := NewOptional(0).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(0, *p, "should return correct value")
+}
+
+func (suite *OptionalPtrSuite) TestOK() {
+	v := NewOptional(16)
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(16, *p, "should return correct value")
+	*p = 0
+	suite.Equal(16, v.V, "should return copy")
+}
+
+func TestOptional_Ptr(t *testing.T) {
+	suite.Run(t, new(OptionalPtrSuite))
+}
+
+// TestOptionalFromSQLNull tests OptionalFromSQLNull.
+func TestOptionalFromSQLNull(t *testing.T) {
+	assert.Equal(t, Optional[int]{}, OptionalFromSQLNull[int](sql.Null[int]{}), "should return correct value")
+	v := 16
+	assert.Equal(t, NewOptional(v), OptionalFromSQLNull[int](sql.Null[int]{V: v, Valid: true}), "should return correct value")
+}
+
+// TestOptional_ToSQLNull tests Optional.ToSQLNull.
+func TestOptional_ToSQLNull(t *testing.T) {
+	assert.False(t, Optional[int]{V: 16}.ToSQLNull().Valid, "should not be valid")
+	v := 16
+	assert.Equal(t, sql.Null[int]{V: v, Valid: true}, NewOptional(v).ToSQLNull(), "should return correct value")
+}
+
+// OptionalMarshalTextSuite tests Optional.MarshalText.
+type OptionalMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *OptionalMarshalTextSuite) TestNotValid() {
+	v := Optional[time.Time]{V: time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *OptionalMarshalTextSuite) TestUnsupported() {
+	_, err := NewOptional([]int{42}).MarshalText()
+	suite.Error(err, "should fail")
+}
+
+func (suite *OptionalMarshalTextSuite) TestBasicKinds() {
+	text, err := NewOptional("meow").MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`meow`, string(text), "should return correct value for string")
+	text, err = NewOptional(-42).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`-42`, string(text), "should return correct value for int")
+	text, err = NewOptional(uint8(42)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`42`, string(text), "should return correct value for uint8")
+	text, err = NewOptional(float32(0.1)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`0.1`, string(text), "should return correct value for float32")
+	text, err = NewOptional(true).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`true`, string(text), "should return correct value for bool")
+}
+
+func (suite *OptionalMarshalTextSuite) TestOK() {
+	text, err := NewOptional(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`2022-07-01T12:30:00Z`, string(text), "should return correct value")
+}
+
+func TestOptional_MarshalText(t *testing.T) {
+	suite.Run(t, new(OptionalMarshalTextSuite))
+}
+
+// OptionalUnmarshalTextSuite tests Optional.UnmarshalText.
+type OptionalUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *OptionalUnmarshalTextSuite) TestEmpty() {
+	v := NewOptional(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC))
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *OptionalUnmarshalTextSuite) TestUnsupported() {
+	var v Optional[[]int]
+	err := v.UnmarshalText([]byte(`42`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *OptionalUnmarshalTextSuite) TestBasicKinds() {
+	var s Optional[string]
+	err := s.UnmarshalText([]byte(`meow`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewOptional("meow"), s, "should unmarshal correct value for string")
+	var i Optional[int]
+	err = i.UnmarshalText([]byte(`-42`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewOptional(-42), i, "should unmarshal correct value for int")
+	var f Optional[float64]
+	err = f.UnmarshalText([]byte(`0.5`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewOptional(0.5), f, "should unmarshal correct value for float64")
+	var b Optional[bool]
+	err = b.UnmarshalText([]byte(`true`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewOptional(true), b, "should unmarshal correct value for bool")
+}
+
+func (suite *OptionalUnmarshalTextSuite) TestOutOfRange() {
+	var v Optional[uint8]
+	err := v.UnmarshalText([]byte(`256`))
+	var rangeErr *RangeError
+	suite.ErrorAs(err, &rangeErr, "should return RangeError")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *OptionalUnmarshalTextSuite) TestUnmarshalFail() {
+	var v Optional[time.Time]
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *OptionalUnmarshalTextSuite) TestOK() {
+	var v Optional[time.Time]
+	err := v.UnmarshalText([]byte(`2022-07-01T12:30:00Z`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.True(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC).Equal(v.V), "should unmarshal correct value")
+}
+
+func TestOptional_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(OptionalUnmarshalTextSuite))
+}
+
+// TestOptional_mapKey tests using Optional as JSON map key.
+func TestOptional_mapKey(t *testing.T) {
+	m := map[Optional[string]]int{NewOptional("meow"): 1}
+	raw, err := json.Marshal(m)
+	assert.NoError(t, err, "marshal should not fail")
+	assert.Equal(t, `{"meow":1}`, string(raw), "should marshal correct value")
+	var got map[Optional[string]]int
+	err = json.Unmarshal(raw, &got)
+	assert.NoError(t, err, "unmarshal should not fail")
+	assert.Equal(t, m, got, "should unmarshal correct value")
+}
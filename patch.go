@@ -0,0 +1,349 @@
+package nulls
+
+import (
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"reflect"
+)
+
+// Patch holds a value that may be absent, NULL or set. Unlike the other types in
+// this package, it distinguishes a field that was not present in the input from
+// a field that was explicitly set to NULL. This makes it suitable for PATCH
+// requests where NULL means "clear this value" and absence means "leave it
+// alone".
+//
+// Keep in mind, that absence can only be detected if the Patch is part of a
+// struct being unmarshalled, as UnmarshalJSON is not called for missing keys.
+type Patch[T any] struct {
+	// V is the actual value when Present and Valid.
+	V T `exhaustruct:"optional"`
+	// Present describes whether a value (including NULL) was provided.
+	Present bool
+	// Valid describes whether the Patch does not hold a NULL value.
+	Valid bool
+}
+
+// NewPatch creates a new Patch that sets the given value.
+func NewPatch[T any](v T) Patch[T] {
+	return Patch[T]{
+		V:       v,
+		Present: true,
+		Valid:   true,
+	}
+}
+
+// NewNullPatch creates a new Patch that sets a NULL value.
+func NewNullPatch[T any]() Patch[T] {
+	return Patch[T]{
+		Present: true,
+		Valid:   false,
+	}
+}
+
+// IsUnset returns true if no value was provided.
+func (p Patch[T]) IsUnset() bool {
+	return !p.Present
+}
+
+// IsNull returns true if an explicit NULL value was provided.
+func (p Patch[T]) IsNull() bool {
+	return p.Present && !p.Valid
+}
+
+// IsSet returns true if a non-NULL value was provided.
+func (p Patch[T]) IsSet() bool {
+	return p.Present && p.Valid
+}
+
+// Get returns the value and whether it is set to a non-NULL value.
+func (p Patch[T]) Get() (T, bool) {
+	return p.V, p.IsSet()
+}
+
+// Set sets the given value, so that the Patch is present and valid.
+func (p *Patch[T]) Set(v T) {
+	*p = NewPatch(v)
+}
+
+// SetNull sets an explicit NULL value, so that the Patch is present but not
+// valid.
+func (p *Patch[T]) SetNull() {
+	*p = NewNullPatch[T]()
+}
+
+// IsZero returns true if unset. This allows omitting unset values using the
+// omitzero option of encoding/json while still marshalling NULL-values.
+func (p Patch[T]) IsZero() bool {
+	return p.IsUnset()
+}
+
+// Equal returns true if both are unset, both are NULL or both are set with
+// equal values. Values are compared using their Equal method if available or
+// reflect.DeepEqual otherwise.
+func (p Patch[T]) Equal(other Patch[T]) bool {
+	if p.IsUnset() || other.IsUnset() {
+		return p.IsUnset() == other.IsUnset()
+	}
+	if p.IsNull() || other.IsNull() {
+		return p.IsNull() == other.IsNull()
+	}
+	return equalValues(p.V, other.V)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (p Patch[T]) String() string {
+	return formatString(p.V, p.IsSet())
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (p Patch[T]) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, p, p.V, p.IsSet())
+}
+
+// GoString returns a Go expression creating the Patch.
+func (p Patch[T]) GoString() string {
+	switch {
+	case p.IsSet():
+		return fmt.Sprintf("nulls.NewPatch[%T](%#v)", p.V, p.V)
+	case p.IsNull():
+		return fmt.Sprintf("nulls.NewNullPatch[%T]()", p.V)
+	default:
+		return fmt.Sprintf("nulls.Patch[%T]{}", p.V)
+	}
+}
+
+// LogValue implements slog.LogValuer. The value is logged like T or as NULL-value
+// if not set.
+func (p Patch[T]) LogValue() slog.Value {
+	if !p.IsSet() {
+		return logNullValue()
+	}
+	return slog.AnyValue(p.V)
+}
+
+// MarshalJSON as value. If not set, a NULL-value is returned. Use the omitzero
+// option of a containing struct or MarshalJSONOmitZero, if unset values should
+// be omitted.
+func (p Patch[T]) MarshalJSON() ([]byte, error) {
+	if !p.IsSet() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(p.V)
+}
+
+// UnmarshalJSON marks the Patch as present and unmarshals the value or sets
+// Valid to false if null.
+func (p *Patch[T]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		p.SetNull()
+		return nil
+	}
+	var v T
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+	p.Set(v)
+	return nil
+}
+
+// MarshalText marshals the value using encoding.TextMarshaler if T implements
+// it or formats strings, booleans and numbers like with strconv. If not set,
+// empty text is returned.
+func (p Patch[T]) MarshalText() ([]byte, error) {
+	if !p.IsSet() {
+		return []byte{}, nil
+	}
+	return marshalText(&p.V)
+}
+
+// UnmarshalText marks the Patch as present and unmarshals the value using
+// encoding.TextUnmarshaler if T implements it or parses strings, booleans and
+// numbers like with strconv. If empty, Valid is set to false.
+func (p *Patch[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		p.SetNull()
+		return nil
+	}
+	var v T
+	err := unmarshalText(&v, text)
+	if err != nil {
+		return err
+	}
+	p.Set(v)
+	return nil
+}
+
+// MarshalYAML as value. If not set, a NULL-value is returned. Use the omitempty
+// option of a containing struct, if unset values should be omitted.
+func (p Patch[T]) MarshalYAML() (any, error) {
+	if !p.IsSet() {
+		return nil, nil
+	}
+	return p.V, nil
+}
+
+// UnmarshalYAML marks the Patch as present and unmarshals the value or sets
+// Valid to false if null.
+//
+// Note that yaml.v3 does not call UnmarshalYAML for null values in mappings. An
+// explicit null is therefore indistinguishable from an absent key in YAML.
+func (p *Patch[T]) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		p.SetNull()
+		return nil
+	}
+	var v T
+	err := node.Decode(&v)
+	if err != nil {
+		return err
+	}
+	p.Set(v)
+	return nil
+}
+
+// patchField is implemented by Patch and allows ApplyPatch to handle patches
+// without knowing the type parameter.
+type patchField interface {
+	patchState() (present bool, valid bool)
+	patchValue() any
+}
+
+func (p Patch[T]) patchState() (bool, bool) {
+	return p.Present, p.Valid
+}
+
+func (p Patch[T]) patchValue() any {
+	return p.V
+}
+
+// ApplyPatch applies all present Patch fields of the given patch struct to the
+// fields with the same name in the struct dst points to. Unset patches are
+// skipped. A NULL patch uses the SetNull method of the destination field if
+// available or sets it to its zero value, which represents NULL for pointers and
+// the types of the sql package. A set patch assigns the value either directly,
+// using the Set method of the destination field like for Setter or, if the
+// destination is a struct with a Valid field, to the value field and sets Valid
+// to true. Fields in patch that are no Patch are ignored.
+func ApplyPatch(dst any, patch any) error {
+	dstValue := reflect.ValueOf(dst)
+	if dstValue.Kind() != reflect.Pointer || dstValue.IsNil() || dstValue.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("destination must be a non-nil pointer to a struct but was %T", dst)
+	}
+	dstValue = dstValue.Elem()
+	patchValue := reflect.Indirect(reflect.ValueOf(patch))
+	if patchValue.Kind() != reflect.Struct {
+		return fmt.Errorf("patch must be a struct but was %T", patch)
+	}
+	patchType := patchValue.Type()
+	for i := 0; i < patchType.NumField(); i++ {
+		fieldType := patchType.Field(i)
+		if !fieldType.IsExported() {
+			continue
+		}
+		field, ok := patchValue.Field(i).Interface().(patchField)
+		if !ok {
+			continue
+		}
+		present, valid := field.patchState()
+		if !present {
+			continue
+		}
+		dstField := dstValue.FieldByName(fieldType.Name)
+		if !dstField.IsValid() {
+			return fmt.Errorf("destination %s has no field %s", dstValue.Type(), fieldType.Name)
+		}
+		if !dstField.CanSet() {
+			return fmt.Errorf("destination field %s is not settable", fieldType.Name)
+		}
+		var err error
+		if valid {
+			err = applyPatchValue(dstField, reflect.ValueOf(field.patchValue()))
+		} else {
+			err = applyPatchNull(dstField)
+		}
+		if err != nil {
+			return fmt.Errorf("apply patch for field %s: %w", fieldType.Name, err)
+		}
+	}
+	return nil
+}
+
+// applyPatchNull sets the given field to NULL. If the field provides a SetNull
+// method, it is used.
+func applyPatchNull(dst reflect.Value) error {
+	if dst.CanAddr() {
+		if setter, ok := dst.Addr().Interface().(interface{ SetNull() }); ok {
+			setter.SetNull()
+			return nil
+		}
+	}
+	switch dst.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+	case reflect.Struct:
+		if _, ok := nullableValueField(dst); !ok {
+			return fmt.Errorf("cannot set NULL on %s", dst.Type())
+		}
+	default:
+		return fmt.Errorf("cannot set NULL on %s", dst.Type())
+	}
+	dst.Set(reflect.Zero(dst.Type()))
+	return nil
+}
+
+// applyPatchValue sets the given field to the given value. If the value is not
+// assignable, but the field provides a Set method accepting it like Setter, it
+// is used.
+func applyPatchValue(dst reflect.Value, v reflect.Value) error {
+	if !v.IsValid() {
+		return applyPatchNull(dst)
+	}
+	if v.Type().AssignableTo(dst.Type()) {
+		dst.Set(v)
+		return nil
+	}
+	if dst.CanAddr() {
+		set := dst.Addr().MethodByName("Set")
+		if set.IsValid() && set.Type().NumIn() == 1 && set.Type().NumOut() == 0 &&
+			v.Type().AssignableTo(set.Type().In(0)) {
+			set.Call([]reflect.Value{v})
+			return nil
+		}
+	}
+	if dst.Kind() == reflect.Pointer && v.Type().AssignableTo(dst.Type().Elem()) {
+		ptr := reflect.New(dst.Type().Elem())
+		ptr.Elem().Set(v)
+		dst.Set(ptr)
+		return nil
+	}
+	if dst.Kind() == reflect.Struct {
+		valueField, ok := nullableValueField(dst)
+		if ok && valueField.CanSet() && v.Type().AssignableTo(valueField.Type()) {
+			dst.Set(reflect.Zero(dst.Type()))
+			valueField.Set(v)
+			dst.FieldByName("Valid").SetBool(true)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot assign %s to %s", v.Type(), dst.Type())
+}
+
+// nullableValueField returns the value field of structs that have exactly two
+// fields where one is a boolean field named Valid. This is the layout used by
+// all types of this package as well as the ones from the sql package.
+func nullableValueField(v reflect.Value) (reflect.Value, bool) {
+	if v.Kind() != reflect.Struct || v.NumField() != 2 {
+		return reflect.Value{}, false
+	}
+	validField, ok := v.Type().FieldByName("Valid")
+	if !ok || len(validField.Index) != 1 || validField.Type.Kind() != reflect.Bool {
+		return reflect.Value{}, false
+	}
+	if validField.Index[0] == 0 {
+		return v.Field(1), true
+	}
+	return v.Field(0), true
+}
@@ -0,0 +1,507 @@
+package nulls
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+	"time"
+)
+
+// TestNewPatch tests NewPatch.
+func TestNewPatch(t *testing.T) {
+	p := NewPatch("Hello World!")
+	assert.True(t, p.IsSet(), "should be set")
+	assert.False(t, p.IsNull(), "should not be null")
+	assert.False(t, p.IsUnset(), "should not be unset")
+	assert.Equal(t, "Hello World!", p.V, "should have set correct value")
+}
+
+// TestNewNullPatch tests NewNullPatch.
+func TestNewNullPatch(t *testing.T) {
+	p := NewNullPatch[string]()
+	assert.False(t, p.IsSet(), "should not be set")
+	assert.True(t, p.IsNull(), "should be null")
+	assert.False(t, p.IsUnset(), "should not be unset")
+}
+
+// PatchMarshalJSONSuite tests Patch.MarshalJSON.
+type PatchMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *PatchMarshalJSONSuite) TestUnset() {
+	p := Patch[string]{V: "Hello World!"}
+	raw, err := json.Marshal(p)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *PatchMarshalJSONSuite) TestNull() {
+	p := NewNullPatch[string]()
+	raw, err := json.Marshal(p)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *PatchMarshalJSONSuite) TestOK() {
+	p := NewPatch("Hello World!")
+	raw, err := json.Marshal(p)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(marshalMust("Hello World!"), raw, "should return correct value")
+}
+
+func TestPatch_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(PatchMarshalJSONSuite))
+}
+
+// PatchUnmarshalJSONSuite tests Patch.UnmarshalJSON.
+type PatchUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+type patchUnmarshalJSONStruct struct {
+	A Patch[string] `json:"a"`
+	B Patch[int]    `json:"b"`
+	C Patch[bool]   `json:"c"`
+}
+
+func (suite *PatchUnmarshalJSONSuite) TestStates() {
+	var s patchUnmarshalJSONStruct
+	err := json.Unmarshal([]byte(`{"a": null, "b": 42}`), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(s.A.IsNull(), "a should be null")
+	suite.True(s.B.IsSet(), "b should be set")
+	suite.Equal(42, s.B.V, "b should have correct value")
+	suite.True(s.C.IsUnset(), "c should be unset")
+}
+
+func (suite *PatchUnmarshalJSONSuite) TestNullClearsValue() {
+	p := NewPatch("Hello World!")
+	err := json.Unmarshal(jsonNull, &p)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(p.IsNull(), "should be null")
+	suite.Empty(p.V, "should clear value")
+}
+
+func (suite *PatchUnmarshalJSONSuite) TestUnmarshalFail() {
+	var p Patch[int]
+	err := json.Unmarshal(marshalMust("meow"), &p)
+	suite.Error(err, "should fail")
+	suite.True(p.IsUnset(), "should stay unset")
+}
+
+func TestPatch_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(PatchUnmarshalJSONSuite))
+}
+
+// ApplyPatchSuite tests ApplyPatch.
+type ApplyPatchSuite struct {
+	suite.Suite
+}
+
+type applyPatchTarget struct {
+	Name     String
+	Age      Int
+	Nickname *string
+	Note     string
+	Custom   Optional[int]
+}
+
+type applyPatchPatch struct {
+	Name     Patch[string]
+	Age      Patch[int]
+	Nickname Patch[string]
+	Note     Patch[string]
+	Custom   Patch[int]
+	Ignored  string
+}
+
+func (suite *ApplyPatchSuite) original() applyPatchTarget {
+	nickname := "meow"
+	return applyPatchTarget{
+		Name:     NewString("Hello"),
+		Age:      NewInt(42),
+		Nickname: &nickname,
+		Note:     "World",
+		Custom:   NewOptional(1),
+	}
+}
+
+func (suite *ApplyPatchSuite) TestInvalidDestination() {
+	err := ApplyPatch(applyPatchTarget{}, applyPatchPatch{})
+	suite.Error(err, "should fail")
+}
+
+func (suite *ApplyPatchSuite) TestInvalidPatch() {
+	dst := suite.original()
+	err := ApplyPatch(&dst, 42)
+	suite.Error(err, "should fail")
+}
+
+func (suite *ApplyPatchSuite) TestUnknownField() {
+	dst := struct{ A String }{}
+	err := ApplyPatch(&dst, struct{ B Patch[string] }{B: NewPatch("meow")})
+	suite.Error(err, "should fail")
+}
+
+func (suite *ApplyPatchSuite) TestUnset() {
+	dst := suite.original()
+	err := ApplyPatch(&dst, applyPatchPatch{Ignored: "meow"})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(suite.original(), dst, "should not change anything")
+}
+
+func (suite *ApplyPatchSuite) TestNull() {
+	dst := suite.original()
+	err := ApplyPatch(&dst, applyPatchPatch{
+		Name:     NewNullPatch[string](),
+		Age:      NewNullPatch[int](),
+		Nickname: NewNullPatch[string](),
+		Custom:   NewNullPatch[int](),
+	})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(dst.Name.Valid, "should set name to null")
+	suite.False(dst.Age.Valid, "should set age to null")
+	suite.Nil(dst.Nickname, "should set nickname to null")
+	suite.Equal("World", dst.Note, "should not change note")
+	suite.False(dst.Custom.Valid, "should set custom to null")
+}
+
+func (suite *ApplyPatchSuite) TestNullOnNonNullable() {
+	dst := suite.original()
+	err := ApplyPatch(&dst, applyPatchPatch{Note: NewNullPatch[string]()})
+	suite.Error(err, "should fail")
+}
+
+func (suite *ApplyPatchSuite) TestSet() {
+	dst := suite.original()
+	err := ApplyPatch(&dst, &applyPatchPatch{
+		Name:     NewPatch("Ola"),
+		Age:      NewPatch(12),
+		Nickname: NewPatch("woof"),
+		Note:     NewPatch("Mundo"),
+		Custom:   NewPatch(2),
+	})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewString("Ola"), dst.Name, "should set name")
+	suite.Equal(NewInt(12), dst.Age, "should set age")
+	suite.Require().NotNil(dst.Nickname, "should set nickname")
+	suite.Equal("woof", *dst.Nickname, "should set nickname")
+	suite.Equal("Mundo", dst.Note, "should set note")
+	suite.Equal(NewOptional(2), dst.Custom, "should set custom")
+}
+
+func (suite *ApplyPatchSuite) TestSetFromNull() {
+	dst := applyPatchTarget{}
+	err := ApplyPatch(&dst, applyPatchPatch{Name: NewPatch("Ola")})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewString("Ola"), dst.Name, "should set name")
+}
+
+func (suite *ApplyPatchSuite) TestTypeMismatch() {
+	dst := suite.original()
+	err := ApplyPatch(&dst, struct{ Age Patch[string] }{Age: NewPatch("meow")})
+	suite.Error(err, "should fail")
+}
+
+func (suite *ApplyPatchSuite) TestFromJSON() {
+	dst := suite.original()
+	var p applyPatchPatch
+	err := json.Unmarshal([]byte(`{"Name": "Ola", "Age": null}`), &p)
+	suite.Require().NoError(err, "unmarshal should not fail")
+	err = ApplyPatch(&dst, p)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewString("Ola"), dst.Name, "should set name")
+	suite.False(dst.Age.Valid, "should set age to null")
+	suite.Equal("World", dst.Note, "should not change note")
+}
+
+func (suite *ApplyPatchSuite) TestSetDateAndTimeOfDay() {
+	dst := struct {
+		Day   Date
+		Start TimeOfDay
+	}{}
+	err := ApplyPatch(&dst, struct {
+		Day   Patch[time.Time]
+		Start Patch[time.Time]
+	}{
+		Day:   NewPatch(time.Date(2024, time.March, 5, 10, 0, 0, 0, time.UTC)),
+		Start: NewPatch(time.Date(0, 1, 1, 12, 30, 0, 0, time.UTC)),
+	})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewDate(2024, time.March, 5), dst.Day, "should set date")
+	suite.Equal(NewTimeOfDay(12, 30, 0, 0), dst.Start, "should set time of day")
+}
+
+func (suite *ApplyPatchSuite) TestNullDateAndTimeOfDay() {
+	dst := struct {
+		Day   Date
+		Start TimeOfDay
+	}{
+		Day:   NewDate(2024, time.March, 5),
+		Start: NewTimeOfDay(12, 30, 0, 0),
+	}
+	err := ApplyPatch(&dst, struct {
+		Day   Patch[time.Time]
+		Start Patch[time.Time]
+	}{
+		Day:   NewNullPatch[time.Time](),
+		Start: NewNullPatch[time.Time](),
+	})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(Date{}, dst.Day, "should set date to null")
+	suite.Equal(TimeOfDay{}, dst.Start, "should set time of day to null")
+}
+
+func TestApplyPatch(t *testing.T) {
+	suite.Run(t, new(ApplyPatchSuite))
+}
+
+// TestPatch_Get tests Patch.Get.
+func TestPatch_Get(t *testing.T) {
+	v, ok := NewPatch(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, 16, v, "should return correct value")
+	_, ok = Patch[int]{V: 16, Present: true}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestPatch_IsZero tests Patch.IsZero.
+func TestPatch_IsZero(t *testing.T) {
+	assert.False(t, NewPatch(16).IsZero(), "should not be zero")
+	assert.False(t, NewNullPatch[int]().IsZero(), "should not be zero")
+	assert.True(t, Patch[int]{V: 16}.IsZero(), "should be zero")
+}
+
+// TestPatch_Equal tests Patch.Equal.
+func TestPatch_Equal(t *testing.T) {
+	assert.True(t, NewPatch(16).Equal(NewPatch(16)), "should be equal")
+	assert.False(t, NewPatch(16).Equal(NewPatch(17)), "should not be equal")
+	assert.True(t, NewNullPatch[int]().Equal(Patch[int]{V: 16, Present: true}), "should be equal")
+	assert.True(t, Patch[int]{}.Equal(Patch[int]{V: 16, Valid: true}), "should be equal")
+	assert.False(t, NewPatch(16).Equal(NewNullPatch[int]()), "should not be equal")
+	assert.False(t, NewNullPatch[int]().Equal(NewPatch(16)), "should not be equal")
+	assert.False(t, NewNullPatch[int]().Equal(Patch[int]{}), "should not be equal")
+	assert.False(t, Patch[int]{}.Equal(NewPatch(0)), "should not be equal")
+}
+
+// TestPatch_String tests Patch.String.
+func TestPatch_String(t *testing.T) {
+	assert.Equal(t, "16", NewPatch(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, NewNullPatch[int]().String(), "should return null token")
+}
+
+// PatchFormatSuite tests Patch.Format.
+type PatchFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *PatchFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", NewNullPatch[int]()), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", NewNullPatch[int]()), "should respect width")
+}
+
+func (suite *PatchFormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewPatch(16)), "should return correct value")
+}
+
+func (suite *PatchFormatSuite) TestVerb() {
+	suite.Equal("016", fmt.Sprintf("%03d", NewPatch(16)), "should return correct value")
+}
+
+func (suite *PatchFormatSuite) TestGoSyntax() {
+	suite.Equal(NewPatch(16).GoString(), fmt.Sprintf("%#v", NewPatch(16)), "should use GoString")
+}
+
+func TestPatch_Format(t *testing.T) {
+	suite.Run(t, new(PatchFormatSuite))
+}
+
+// TestPatch_GoString tests Patch.GoString.
+func TestPatch_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewPatch[int](16)", NewPatch(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.NewNullPatch[int]()", NewNullPatch[int]().GoString(), "should return correct value")
+}
+
+// TestPatch_LogValue tests Patch.LogValue.
+func TestPatch_LogValue(t *testing.T) {
+	assert.Equal(t, int64(16), NewPatch(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, NewNullPatch[int]().LogValue().Any(), "should return null value")
+}
+
+// PatchMarshalTextSuite tests Patch.MarshalText.
+type PatchMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *PatchMarshalTextSuite) TestNotValid() {
+	v := Patch[time.Time]{V: time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *PatchMarshalTextSuite) TestUnsupported() {
+	_, err := NewPatch([]int{42}).MarshalText()
+	suite.Error(err, "should fail")
+}
+
+func (suite *PatchMarshalTextSuite) TestBasicKinds() {
+	text, err := NewPatch("meow").MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`meow`, string(text), "should return correct value for string")
+	text, err = NewPatch(-42).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`-42`, string(text), "should return correct value for int")
+	text, err = NewPatch(uint8(42)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`42`, string(text), "should return correct value for uint8")
+	text, err = NewPatch(float32(0.1)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`0.1`, string(text), "should return correct value for float32")
+	text, err = NewPatch(true).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`true`, string(text), "should return correct value for bool")
+}
+
+func (suite *PatchMarshalTextSuite) TestOK() {
+	text, err := NewPatch(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`2022-07-01T12:30:00Z`, string(text), "should return correct value")
+}
+
+func TestPatch_MarshalText(t *testing.T) {
+	suite.Run(t, new(PatchMarshalTextSuite))
+}
+
+// PatchUnmarshalTextSuite tests Patch.UnmarshalText.
+type PatchUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *PatchUnmarshalTextSuite) TestEmpty() {
+	v := NewPatch(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC))
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *PatchUnmarshalTextSuite) TestUnsupported() {
+	var v Patch[[]int]
+	err := v.UnmarshalText([]byte(`42`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *PatchUnmarshalTextSuite) TestBasicKinds() {
+	var s Patch[string]
+	err := s.UnmarshalText([]byte(`meow`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewPatch("meow"), s, "should unmarshal correct value for string")
+	var i Patch[int]
+	err = i.UnmarshalText([]byte(`-42`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewPatch(-42), i, "should unmarshal correct value for int")
+	var f Patch[float64]
+	err = f.UnmarshalText([]byte(`0.5`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewPatch(0.5), f, "should unmarshal correct value for float64")
+	var b Patch[bool]
+	err = b.UnmarshalText([]byte(`true`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewPatch(true), b, "should unmarshal correct value for bool")
+}
+
+func (suite *PatchUnmarshalTextSuite) TestOutOfRange() {
+	var v Patch[uint8]
+	err := v.UnmarshalText([]byte(`256`))
+	var rangeErr *RangeError
+	suite.ErrorAs(err, &rangeErr, "should return RangeError")
+	suite.False(v.IsSet(), "should not be set")
+}
+
+func (suite *PatchUnmarshalTextSuite) TestUnmarshalFail() {
+	var v Patch[time.Time]
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+	suite.False(v.IsSet(), "should not be set")
+	suite.True(v.IsUnset(), "should stay unset")
+}
+
+func (suite *PatchUnmarshalTextSuite) TestOK() {
+	var v Patch[time.Time]
+	err := v.UnmarshalText([]byte(`2022-07-01T12:30:00Z`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.True(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC).Equal(v.V), "should unmarshal correct value")
+	suite.True(v.Present, "should be present")
+}
+
+func TestPatch_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(PatchUnmarshalTextSuite))
+}
+
+// PatchMarshalYAMLSuite tests Patch.MarshalYAML.
+type PatchMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *PatchMarshalYAMLSuite) TestUnset() {
+	raw, err := yaml.Marshal(Patch[string]{V: "Hello World!"})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *PatchMarshalYAMLSuite) TestNull() {
+	raw, err := yaml.Marshal(NewNullPatch[string]())
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *PatchMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewPatch("Hello World!"))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("Hello World!\n", string(raw), "should return correct value")
+}
+
+func TestPatch_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(PatchMarshalYAMLSuite))
+}
+
+// PatchUnmarshalYAMLSuite tests Patch.UnmarshalYAML.
+type PatchUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *PatchUnmarshalYAMLSuite) TestStates() {
+	var s struct {
+		A Patch[string] `yaml:"a"`
+		B Patch[int]    `yaml:"b"`
+	}
+	err := yaml.Unmarshal([]byte("b: 42\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(s.A.IsUnset(), "a should be unset")
+	suite.True(s.B.IsSet(), "b should be set")
+	suite.Equal(42, s.B.V, "b should have correct value")
+}
+
+func (suite *PatchUnmarshalYAMLSuite) TestNullClearsValue() {
+	p := NewPatch("Hello World!")
+	err := p.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.True(p.IsNull(), "should be null")
+	suite.Empty(p.V, "should clear value")
+}
+
+func (suite *PatchUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var p Patch[int]
+	err := yaml.Unmarshal([]byte(`meow`), &p)
+	suite.Error(err, "should fail")
+	suite.True(p.IsUnset(), "should stay unset")
+}
+
+func TestPatch_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(PatchUnmarshalYAMLSuite))
+}
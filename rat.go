@@ -0,0 +1,310 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"log/slog"
+	"math/big"
+	"strings"
+)
+
+// Rat holds a nullable *big.Rat for exact decimals like in NUMERIC columns. It
+// is represented as exact decimal like 12.34. Marshalling fails for values
+// without finite decimal representation like 1/3. A Rat with nil Rat is
+// handled like a NULL-value.
+type Rat struct {
+	// Rat is the actual value when Valid.
+	Rat *big.Rat `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewRat returns a valid Rat with the given value. The value is not copied.
+func NewRat(v *big.Rat) Rat {
+	return Rat{
+		Rat:   v,
+		Valid: true,
+	}
+}
+
+// ParseRat returns a valid Rat with the given decimal or fraction text like
+// "12.34" or "1/3".
+func ParseRat(s string) (Rat, error) {
+	v, err := parseRat(s)
+	if err != nil {
+		return Rat{}, err
+	}
+	return NewRat(v), nil
+}
+
+// MustParseRat is like ParseRat but panics if the text is invalid.
+func MustParseRat(s string) Rat {
+	r, err := ParseRat(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// RatFromPtr returns a Rat that is valid if the given pointer is not nil.
+func RatFromPtr(v *big.Rat) Rat {
+	if v == nil {
+		return Rat{}
+	}
+	return NewRat(v)
+}
+
+// Ptr returns a copy of the value if valid or nil otherwise.
+func (r Rat) Ptr() *big.Rat {
+	if !r.isValid() {
+		return nil
+	}
+	return new(big.Rat).Set(r.Rat)
+}
+
+// Get returns the value and whether it is valid.
+func (r Rat) Get() (*big.Rat, bool) {
+	return r.Rat, r.isValid()
+}
+
+// IsNull returns true if not valid.
+func (r Rat) IsNull() bool {
+	return !r.isValid()
+}
+
+// Set sets the given value and makes it valid. The value is not copied.
+func (r *Rat) Set(v *big.Rat) {
+	*r = NewRat(v)
+}
+
+// SetNull sets a NULL-value.
+func (r *Rat) SetNull() {
+	*r = Rat{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (r Rat) IsZero() bool {
+	return !r.isValid()
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (r Rat) Equal(other Rat) bool {
+	if !r.isValid() || !other.isValid() {
+		return r.isValid() == other.isValid()
+	}
+	return r.Rat.Cmp(other.Rat) == 0
+}
+
+// String returns the value as exact decimal or NullToken if not valid. Values
+// without finite decimal representation are returned as fraction like 1/3.
+func (r Rat) String() string {
+	if !r.isValid() {
+		return NullToken
+	}
+	return ratString(r.Rat)
+}
+
+// Format implements fmt.Formatter. For the verbs e, E, f, F, g and G, the value
+// is formatted like a float with respect to precision, width and flags. Other
+// verbs format the value like in String. NullToken is written if not valid. For
+// %#v, GoString is used.
+func (r Rat) Format(f fmt.State, verb rune) {
+	if !r.isValid() {
+		formatNullable(f, verb, r, nil, false)
+		return
+	}
+	switch verb {
+	case 'f', 'F':
+		formatRatFixed(f, r.Rat)
+	case 'e', 'E', 'g', 'G':
+		// Use enough precision for keeping the digits of exact decimals, so that
+		// the shortest representation for %g without precision is the decimal
+		// itself.
+		prec := 64 + 2*uint(r.Rat.Num().BitLen()+r.Rat.Denom().BitLen())
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), new(big.Float).SetPrec(prec).SetRat(r.Rat))
+	default:
+		formatNullable(f, verb, r, ratString(r.Rat), true)
+	}
+}
+
+// formatRatFixed writes the given big.Rat in fixed-point notation with exact
+// rounding using big.Rat.FloatString. The precision defaults to 6 like for
+// floats. Width and the flags +, space, - and 0 are respected.
+func formatRatFixed(f fmt.State, r *big.Rat) {
+	prec, ok := f.Precision()
+	if !ok {
+		prec = 6
+	}
+	s := r.FloatString(prec)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	} else if f.Flag('+') {
+		sign = "+"
+	} else if f.Flag(' ') {
+		sign = " "
+	}
+	width, _ := f.Width()
+	if f.Flag('0') && !f.Flag('-') && len(sign)+len(s) < width {
+		s = strings.Repeat("0", width-len(sign)-len(s)) + s
+	}
+	s = sign + s
+	if pad := width - len(s); pad > 0 {
+		if f.Flag('-') {
+			s += strings.Repeat(" ", pad)
+		} else {
+			s = strings.Repeat(" ", pad) + s
+		}
+	}
+	_, _ = io.WriteString(f, s)
+}
+
+// GoString returns a Go expression creating the Rat.
+func (r Rat) GoString() string {
+	if !r.isValid() {
+		return "nulls.Rat{}"
+	}
+	return fmt.Sprintf("nulls.MustParseRat(%q)", ratString(r.Rat))
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+// The value is logged as string like in String in order to avoid precision
+// loss.
+func (r Rat) LogValue() slog.Value {
+	if !r.isValid() {
+		return logNullValue()
+	}
+	return slog.StringValue(ratString(r.Rat))
+}
+
+// MarshalJSON marshals the value as exact decimal number literal. Use RatString
+// for marshalling as string. If not valid, a NULL-value is returned.
+func (r Rat) MarshalJSON() ([]byte, error) {
+	if !r.isValid() {
+		return json.Marshal(nil)
+	}
+	s, err := ratDecimal(r.Rat)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalJSON as number literal or string holding a decimal or fraction or
+// sets Valid to false if null.
+func (r *Rat) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		r.SetNull()
+		return nil
+	}
+	s, err := unmarshalJSONBig(data)
+	if err != nil {
+		return err
+	}
+	return r.parse(s)
+}
+
+// MarshalText marshals the value as exact decimal. If not valid, empty text is
+// returned.
+func (r Rat) MarshalText() ([]byte, error) {
+	if !r.isValid() {
+		return []byte{}, nil
+	}
+	s, err := ratDecimal(r.Rat)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText as decimal or fraction or sets Valid to false if empty.
+func (r *Rat) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		r.SetNull()
+		return nil
+	}
+	return r.parse(string(text))
+}
+
+// MarshalYAML marshals the value as exact decimal number. If not valid, a
+// NULL-value is returned.
+func (r Rat) MarshalYAML() (any, error) {
+	if !r.isValid() {
+		return nil, nil
+	}
+	s, err := ratDecimal(r.Rat)
+	if err != nil {
+		return nil, err
+	}
+	// Leave the tag empty, so that it is resolved from the value instead of being
+	// written explicitly if not matching.
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Value: s,
+	}, nil
+}
+
+// UnmarshalYAML as number or string holding a decimal or fraction or sets
+// Valid to false if null.
+func (r *Rat) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		r.SetNull()
+		return nil
+	}
+	var s string
+	err := node.Decode(&s)
+	if err != nil {
+		return err
+	}
+	return r.parse(s)
+}
+
+// Scan to *big.Rat value or not valid if nil. Strings are parsed as decimals
+// like returned by drivers for NUMERIC columns. Integers and floats are
+// accepted as well, where floats are converted using their shortest decimal
+// representation.
+func (r *Rat) Scan(src any) error {
+	s, valid, err := scanBigText(src)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		r.SetNull()
+		return nil
+	}
+	return r.parse(s)
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The
+// value is returned as exact decimal string.
+func (r Rat) Value() (driver.Value, error) {
+	if !r.isValid() {
+		return nil, nil
+	}
+	s, err := ratDecimal(r.Rat)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// isValid reports whether r is valid and Rat is not nil.
+func (r Rat) isValid() bool {
+	return r.Valid && r.Rat != nil
+}
+
+// parse parses the given decimal or fraction text into a newly allocated
+// *big.Rat and sets the Rat valid.
+func (r *Rat) parse(s string) error {
+	v, err := parseRat(s)
+	if err != nil {
+		return err
+	}
+	r.Valid = true
+	r.Rat = v
+	return nil
+}
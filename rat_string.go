@@ -0,0 +1,175 @@
+package nulls
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"math/big"
+)
+
+// RatString is like Rat but is marshalled to JSON as quoted string instead of
+// number literal. This avoids precision loss in JSON decoders parsing numbers
+// as floating point. A RatString with nil Rat is handled like a NULL-value.
+type RatString struct {
+	// Rat is the actual value when Valid.
+	Rat *big.Rat `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewRatString returns a valid RatString with the given value. The value is
+// not copied.
+func NewRatString(v *big.Rat) RatString {
+	return RatString{
+		Rat:   v,
+		Valid: true,
+	}
+}
+
+// ParseRatString returns a valid RatString with the given decimal or fraction
+// text like "12.34" or "1/3".
+func ParseRatString(s string) (RatString, error) {
+	r, err := ParseRat(s)
+	return RatString(r), err
+}
+
+// MustParseRatString is like ParseRatString but panics if the text is invalid.
+func MustParseRatString(s string) RatString {
+	return RatString(MustParseRat(s))
+}
+
+// RatStringFromPtr returns a RatString that is valid if the given pointer is
+// not nil.
+func RatStringFromPtr(v *big.Rat) RatString {
+	return RatString(RatFromPtr(v))
+}
+
+// RatStringFromRat returns a RatString from the given Rat.
+func RatStringFromRat(v Rat) RatString {
+	return RatString(v)
+}
+
+// ToRat returns the Rat representation.
+func (r RatString) ToRat() Rat {
+	return Rat(r)
+}
+
+// Ptr returns a copy of the value if valid or nil otherwise.
+func (r RatString) Ptr() *big.Rat {
+	return r.ToRat().Ptr()
+}
+
+// Get returns the value and whether it is valid.
+func (r RatString) Get() (*big.Rat, bool) {
+	return r.ToRat().Get()
+}
+
+// IsNull returns true if not valid.
+func (r RatString) IsNull() bool {
+	return r.ToRat().IsNull()
+}
+
+// Set sets the given value and makes it valid. The value is not copied.
+func (r *RatString) Set(v *big.Rat) {
+	*r = NewRatString(v)
+}
+
+// SetNull sets a NULL-value.
+func (r *RatString) SetNull() {
+	*r = RatString{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (r RatString) IsZero() bool {
+	return r.ToRat().IsZero()
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (r RatString) Equal(other RatString) bool {
+	return r.ToRat().Equal(other.ToRat())
+}
+
+// String returns the value like Rat.String or NullToken if not valid.
+func (r RatString) String() string {
+	return r.ToRat().String()
+}
+
+// Format implements fmt.Formatter like Rat.Format. For %#v, GoString is used.
+func (r RatString) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		formatNullable(f, verb, r, nil, !r.IsNull())
+		return
+	}
+	r.ToRat().Format(f, verb)
+}
+
+// GoString returns a Go expression creating the RatString.
+func (r RatString) GoString() string {
+	if r.IsNull() {
+		return "nulls.RatString{}"
+	}
+	return fmt.Sprintf("nulls.MustParseRatString(%q)", ratString(r.Rat))
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+// The value is logged as string like in String in order to avoid precision
+// loss.
+func (r RatString) LogValue() slog.Value {
+	return r.ToRat().LogValue()
+}
+
+// MarshalJSON marshals the value as string holding the exact decimal. If not
+// valid, a NULL-value is returned.
+func (r RatString) MarshalJSON() ([]byte, error) {
+	if r.IsNull() {
+		return json.Marshal(nil)
+	}
+	s, err := ratDecimal(r.Rat)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON as number literal or string holding a decimal or fraction or
+// sets Valid to false if null.
+func (r *RatString) UnmarshalJSON(data []byte) error {
+	return (*Rat)(r).UnmarshalJSON(data)
+}
+
+// MarshalText marshals the value as exact decimal. If not valid, empty text is
+// returned.
+func (r RatString) MarshalText() ([]byte, error) {
+	return r.ToRat().MarshalText()
+}
+
+// UnmarshalText as decimal or fraction or sets Valid to false if empty.
+func (r *RatString) UnmarshalText(text []byte) error {
+	return (*Rat)(r).UnmarshalText(text)
+}
+
+// MarshalYAML marshals the value as exact decimal number. If not valid, a
+// NULL-value is returned.
+func (r RatString) MarshalYAML() (any, error) {
+	return r.ToRat().MarshalYAML()
+}
+
+// UnmarshalYAML as number or string holding a decimal or fraction or sets
+// Valid to false if null.
+func (r *RatString) UnmarshalYAML(node *yaml.Node) error {
+	return (*Rat)(r).UnmarshalYAML(node)
+}
+
+// Scan to *big.Rat value or not valid if nil like Rat.Scan.
+func (r *RatString) Scan(src any) error {
+	return (*Rat)(r).Scan(src)
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The
+// value is returned as exact decimal string.
+func (r RatString) Value() (driver.Value, error) {
+	return r.ToRat().Value()
+}
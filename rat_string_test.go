@@ -0,0 +1,114 @@
+package nulls
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"math/big"
+	"testing"
+)
+
+// TestNewRatString tests NewRatString.
+func TestNewRatString(t *testing.T) {
+	v := testRat()
+	r := NewRatString(v)
+	assert.True(t, r.Valid, "should be valid")
+	assert.Same(t, v, r.Rat, "should have set correct value")
+}
+
+// TestParseRatString tests ParseRatString and MustParseRatString.
+func TestParseRatString(t *testing.T) {
+	r, err := ParseRatString(testRatText)
+	assert.NoError(t, err, "should not fail")
+	assert.True(t, r.Equal(NewRatString(testRat())), "should return correct value")
+	_, err = ParseRatString("meow")
+	assert.Error(t, err, "should fail")
+	assert.Panics(t, func() { MustParseRatString("meow") }, "should panic")
+}
+
+// TestRatStringFromRat tests RatStringFromRat and RatString.ToRat.
+func TestRatStringFromRat(t *testing.T) {
+	v := testRat()
+	r := RatStringFromRat(NewRat(v))
+	assert.Equal(t, NewRatString(v), r, "should return correct value")
+	assert.Equal(t, NewRat(v), r.ToRat(), "should return correct value")
+}
+
+// RatStringMarshalJSONSuite tests RatString.MarshalJSON.
+type RatStringMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *RatStringMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(RatString{Rat: testRat()})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *RatStringMarshalJSONSuite) TestNotFinite() {
+	_, err := json.Marshal(NewRatString(big.NewRat(1, 3)))
+	suite.Error(err, "should fail")
+}
+
+func (suite *RatStringMarshalJSONSuite) TestValid() {
+	raw, err := json.Marshal(NewRatString(testRat()))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`"`+testRatText+`"`, string(raw), "should return correct value")
+}
+
+func TestRatString_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(RatStringMarshalJSONSuite))
+}
+
+// RatStringUnmarshalJSONSuite tests RatString.UnmarshalJSON.
+type RatStringUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *RatStringUnmarshalJSONSuite) TestNull() {
+	r := NewRatString(testRat())
+	err := json.Unmarshal(jsonNull, &r)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(r.Valid, "should not be valid")
+}
+
+func (suite *RatStringUnmarshalJSONSuite) TestString() {
+	var r RatString
+	err := json.Unmarshal([]byte(`"`+testRatText+`"`), &r)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(r.Equal(NewRatString(testRat())), "should unmarshal correct value")
+}
+
+func (suite *RatStringUnmarshalJSONSuite) TestNumber() {
+	var r RatString
+	err := json.Unmarshal([]byte(testRatText), &r)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(r.Equal(NewRatString(testRat())), "should unmarshal correct value")
+}
+
+func TestRatString_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(RatStringUnmarshalJSONSuite))
+}
+
+// TestRatString_Format tests RatString.Format and RatString.GoString.
+func TestRatString_Format(t *testing.T) {
+	assert.Equal(t, "12.35", fmt.Sprintf("%.2f", MustParseRatString("12.345")), "should return correct value")
+	assert.Equal(t, `"12.5"`, fmt.Sprintf("%q", MustParseRatString("12.5")), "should return correct value")
+	assert.Equal(t, NullToken, fmt.Sprintf("%v", RatString{}), "should return null token")
+	assert.Equal(t, `nulls.MustParseRatString("12.5")`, fmt.Sprintf("%#v", MustParseRatString("12.5")),
+		"should return correct value")
+	assert.Equal(t, "nulls.RatString{}", fmt.Sprintf("%#v", RatString{}), "should return correct value")
+}
+
+// TestRatString_Scan tests RatString.Scan and RatString.Value.
+func TestRatString_Scan(t *testing.T) {
+	var r RatString
+	assert.NoError(t, r.Scan(testRatText), "should not fail")
+	assert.True(t, r.Equal(NewRatString(testRat())), "should scan correct value")
+	v, err := r.Value()
+	assert.NoError(t, err, "should not fail")
+	assert.Equal(t, testRatText, v, "should return correct value")
+	assert.NoError(t, r.Scan(nil), "should not fail")
+	assert.False(t, r.Valid, "should not be valid")
+}
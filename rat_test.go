@@ -0,0 +1,410 @@
+package nulls
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"math/big"
+	"testing"
+)
+
+// testRatText is an exact decimal exceeding float64 precision.
+const testRatText = "12345678901234567890.123456789"
+
+// testRat returns a new *big.Rat for testRatText.
+func testRat() *big.Rat {
+	v, _ := new(big.Rat).SetString(testRatText)
+	return v
+}
+
+// TestNewRat tests NewRat.
+func TestNewRat(t *testing.T) {
+	v := testRat()
+	r := NewRat(v)
+	assert.True(t, r.Valid, "should be valid")
+	assert.Same(t, v, r.Rat, "should have set correct value")
+}
+
+// ParseRatSuite tests ParseRat and MustParseRat.
+type ParseRatSuite struct {
+	suite.Suite
+}
+
+func (suite *ParseRatSuite) TestFail() {
+	_, err := ParseRat("meow")
+	suite.Error(err, "should fail")
+	suite.Panics(func() { MustParseRat("meow") }, "should panic")
+}
+
+func (suite *ParseRatSuite) TestOK() {
+	r, err := ParseRat(testRatText)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewRat(testRat()), r, "should return correct value")
+	suite.Equal(NewRat(big.NewRat(1, 3)), MustParseRat("1/3"), "should return correct value")
+}
+
+func TestParseRat(t *testing.T) {
+	suite.Run(t, new(ParseRatSuite))
+}
+
+// RatMarshalJSONSuite tests Rat.MarshalJSON.
+type RatMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *RatMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(Rat{Rat: testRat()})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *RatMarshalJSONSuite) TestNil() {
+	raw, err := json.Marshal(Rat{Valid: true})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *RatMarshalJSONSuite) TestNotFinite() {
+	_, err := json.Marshal(NewRat(big.NewRat(1, 3)))
+	suite.Error(err, "should fail")
+}
+
+func (suite *RatMarshalJSONSuite) TestNumber() {
+	raw, err := json.Marshal(NewRat(testRat()))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(testRatText, string(raw), "should return correct value")
+}
+
+func TestRat_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(RatMarshalJSONSuite))
+}
+
+// RatUnmarshalJSONSuite tests Rat.UnmarshalJSON.
+type RatUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *RatUnmarshalJSONSuite) TestNull() {
+	r := NewRat(testRat())
+	err := json.Unmarshal(jsonNull, &r)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(r.Valid, "should not be valid")
+}
+
+func (suite *RatUnmarshalJSONSuite) TestUnmarshalFail() {
+	var r Rat
+	err := json.Unmarshal([]byte(`true`), &r)
+	suite.Error(err, "should fail")
+}
+
+func (suite *RatUnmarshalJSONSuite) TestParseFail() {
+	var r Rat
+	err := json.Unmarshal([]byte(`"meow"`), &r)
+	suite.Error(err, "should fail")
+}
+
+func (suite *RatUnmarshalJSONSuite) TestOK() {
+	for _, data := range []string{testRatText, `"` + testRatText + `"`} {
+		var r Rat
+		err := json.Unmarshal([]byte(data), &r)
+		suite.Require().NoErrorf(err, "should not fail for %s", data)
+		suite.Equalf(NewRat(testRat()), r, "should unmarshal correct value for %s", data)
+	}
+}
+
+func (suite *RatUnmarshalJSONSuite) TestExponent() {
+	var r Rat
+	err := json.Unmarshal([]byte(`1.5e3`), &r)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewRat(big.NewRat(1500, 1)), r, "should unmarshal correct value")
+}
+
+func TestRat_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(RatUnmarshalJSONSuite))
+}
+
+// RatMarshalTextSuite tests Rat.MarshalText.
+type RatMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *RatMarshalTextSuite) TestNotValid() {
+	text, err := Rat{Rat: testRat()}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *RatMarshalTextSuite) TestNotFinite() {
+	_, err := NewRat(big.NewRat(1, 3)).MarshalText()
+	suite.Error(err, "should fail")
+}
+
+func (suite *RatMarshalTextSuite) TestOK() {
+	text, err := NewRat(testRat()).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(testRatText, string(text), "should return correct value")
+}
+
+func TestRat_MarshalText(t *testing.T) {
+	suite.Run(t, new(RatMarshalTextSuite))
+}
+
+// RatUnmarshalTextSuite tests Rat.UnmarshalText.
+type RatUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *RatUnmarshalTextSuite) TestEmpty() {
+	r := NewRat(testRat())
+	err := r.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(r.Valid, "should not be valid")
+}
+
+func (suite *RatUnmarshalTextSuite) TestUnmarshalFail() {
+	var r Rat
+	err := r.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *RatUnmarshalTextSuite) TestOK() {
+	var r Rat
+	err := r.UnmarshalText([]byte(testRatText))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewRat(testRat()), r, "should unmarshal correct value")
+}
+
+func TestRat_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(RatUnmarshalTextSuite))
+}
+
+// RatMarshalYAMLSuite tests Rat.MarshalYAML.
+type RatMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *RatMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Rat{Rat: testRat()})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *RatMarshalYAMLSuite) TestNotFinite() {
+	_, err := yaml.Marshal(NewRat(big.NewRat(1, 3)))
+	suite.Error(err, "should fail")
+}
+
+func (suite *RatMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewRat(testRat()))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(testRatText+"\n", string(raw), "should return correct value")
+}
+
+func TestRat_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(RatMarshalYAMLSuite))
+}
+
+// RatUnmarshalYAMLSuite tests Rat.UnmarshalYAML.
+type RatUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *RatUnmarshalYAMLSuite) TestNull() {
+	r := NewRat(testRat())
+	err := r.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(r.Valid, "should not be valid")
+}
+
+func (suite *RatUnmarshalYAMLSuite) TestUnmarshalFail() {
+	for _, src := range []string{`meow`, `[1]`} {
+		var r Rat
+		err := yaml.Unmarshal([]byte(src), &r)
+		suite.Errorf(err, "should fail for %s", src)
+	}
+}
+
+func (suite *RatUnmarshalYAMLSuite) TestOK() {
+	for _, src := range []string{testRatText, `"` + testRatText + `"`} {
+		var r Rat
+		err := yaml.Unmarshal([]byte(src), &r)
+		suite.Require().NoErrorf(err, "should not fail for %s", src)
+		suite.Equalf(NewRat(testRat()), r, "should unmarshal correct value for %s", src)
+	}
+}
+
+func TestRat_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(RatUnmarshalYAMLSuite))
+}
+
+// RatScanSuite tests Rat.Scan.
+type RatScanSuite struct {
+	suite.Suite
+}
+
+func (suite *RatScanSuite) TestNull() {
+	r := NewRat(testRat())
+	err := r.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(r.Valid, "should not be valid")
+}
+
+func (suite *RatScanSuite) TestUnsupported() {
+	var r Rat
+	err := r.Scan(true)
+	suite.Error(err, "should fail")
+}
+
+func (suite *RatScanSuite) TestParseFail() {
+	var r Rat
+	err := r.Scan("meow")
+	suite.Error(err, "should fail")
+}
+
+func (suite *RatScanSuite) TestOK() {
+	for _, src := range []any{testRatText, []byte(testRatText)} {
+		var r Rat
+		err := r.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %v", src)
+		suite.Equalf(NewRat(testRat()), r, "should scan correct value for %v", src)
+	}
+}
+
+func (suite *RatScanSuite) TestNumbers() {
+	for src, expect := range map[any]*big.Rat{
+		int64(-16): big.NewRat(-16, 1),
+		0.1:        big.NewRat(1, 10),
+	} {
+		var r Rat
+		err := r.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %v", src)
+		suite.Equalf(NewRat(expect), r, "should scan correct value for %v", src)
+	}
+}
+
+func TestRat_Scan(t *testing.T) {
+	suite.Run(t, new(RatScanSuite))
+}
+
+// RatValueSuite tests Rat.Value.
+type RatValueSuite struct {
+	suite.Suite
+}
+
+func (suite *RatValueSuite) TestNull() {
+	raw, err := Rat{Rat: testRat()}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *RatValueSuite) TestNotFinite() {
+	_, err := NewRat(big.NewRat(1, 3)).Value()
+	suite.Error(err, "should fail")
+}
+
+func (suite *RatValueSuite) TestOK() {
+	raw, err := NewRat(testRat()).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(testRatText, raw, "should return correct value")
+}
+
+func TestRat_Value(t *testing.T) {
+	suite.Run(t, new(RatValueSuite))
+}
+
+// TestRat_Get tests Rat.Get.
+func TestRat_Get(t *testing.T) {
+	v, ok := NewRat(testRat()).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, testRat(), v, "should return correct value")
+	_, ok = Rat{Rat: testRat()}.Get()
+	assert.False(t, ok, "should not be valid")
+	_, ok = Rat{Valid: true}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestRat_IsZero tests Rat.IsZero.
+func TestRat_IsZero(t *testing.T) {
+	assert.False(t, NewRat(new(big.Rat)).IsZero(), "should not be zero")
+	assert.True(t, Rat{Rat: testRat()}.IsZero(), "should be zero")
+	assert.True(t, Rat{Valid: true}.IsZero(), "should be zero")
+}
+
+// TestRat_Equal tests Rat.Equal.
+func TestRat_Equal(t *testing.T) {
+	r := NewRat(big.NewRat(1, 2))
+	assert.True(t, r.Equal(NewRat(big.NewRat(2, 4))), "should be equal")
+	assert.False(t, r.Equal(NewRat(big.NewRat(1, 3))), "should not be equal")
+	assert.False(t, r.Equal(Rat{Rat: big.NewRat(1, 2)}), "should not be equal")
+	assert.True(t, Rat{Rat: testRat()}.Equal(Rat{Valid: true}), "should be equal")
+}
+
+// TestRat_String tests Rat.String.
+func TestRat_String(t *testing.T) {
+	assert.Equal(t, testRatText, NewRat(testRat()).String(), "should return correct value")
+	assert.Equal(t, "1/3", NewRat(big.NewRat(1, 3)).String(), "should return fraction")
+	assert.Equal(t, NullToken, Rat{}.String(), "should return null token")
+}
+
+// TestRat_Format tests Rat.Format.
+func TestRat_Format(t *testing.T) {
+	assert.Equal(t, `"12.5"`, fmt.Sprintf("%q", NewRat(big.NewRat(25, 2))), "should return correct value")
+	assert.Equal(t, "<null>", fmt.Sprintf("%v", Rat{}), "should return null token")
+	assert.Equal(t, "<null>", fmt.Sprintf("%.2f", Rat{}), "should return null token")
+}
+
+// TestRat_FormatFloat tests Rat.Format with float verbs.
+func TestRat_FormatFloat(t *testing.T) {
+	tests := []struct {
+		format string
+		value  string
+		expect string
+	}{
+		{format: "%.2f", value: "12.345", expect: "12.35"},
+		{format: "%.2f", value: "-12.345", expect: "-12.35"},
+		{format: "%f", value: "12.5", expect: "12.500000"},
+		{format: "%.0F", value: "12.5", expect: "13"},
+		{format: "%.4f", value: "1/3", expect: "0.3333"},
+		{format: "%+.1f", value: "2", expect: "+2.0"},
+		{format: "% .1f", value: "2", expect: " 2.0"},
+		{format: "%8.2f", value: "-1.5", expect: "   -1.50"},
+		{format: "%-8.2f|", value: "1.5", expect: "1.50    |"},
+		{format: "%08.2f", value: "-1.5", expect: "-0001.50"},
+		{format: "%e", value: "12.345", expect: "1.234500e+01"},
+		{format: "%.2E", value: "-12345", expect: "-1.23E+04"},
+		{format: "%g", value: "12.345", expect: "12.345"},
+		{format: "%g", value: "123456789.123456789", expect: "1.23456789123456789e+08"},
+		{format: "%.3G", value: "0.000012345", expect: "1.23E-05"},
+		{format: "%10.3g", value: "12.345", expect: "      12.3"},
+	}
+	for _, tt := range tests {
+		assert.Equalf(t, tt.expect, fmt.Sprintf(tt.format, MustParseRat(tt.value)),
+			"should return correct value for %s with %s", tt.value, tt.format)
+	}
+}
+
+// TestRat_GoString tests Rat.GoString.
+func TestRat_GoString(t *testing.T) {
+	assert.Equal(t, `nulls.MustParseRat("12.5")`, fmt.Sprintf("%#v", NewRat(big.NewRat(25, 2))),
+		"should return correct value")
+	assert.Equal(t, `nulls.MustParseRat("1/3")`, NewRat(big.NewRat(1, 3)).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Rat{}", Rat{}.GoString(), "should return correct value")
+}
+
+// TestRat_LogValue tests Rat.LogValue.
+func TestRat_LogValue(t *testing.T) {
+	assert.Equal(t, testRatText, NewRat(testRat()).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Rat{Rat: testRat()}.LogValue().Any(), "should return null value")
+}
+
+// TestRatFromPtr tests RatFromPtr and Rat.Ptr.
+func TestRatFromPtr(t *testing.T) {
+	assert.False(t, RatFromPtr(nil).Valid, "should not be valid")
+	assert.Nil(t, Rat{Rat: testRat()}.Ptr(), "should return nil")
+	v := testRat()
+	p := RatFromPtr(v).Ptr()
+	assert.Equal(t, v, p, "should return correct value")
+	assert.NotSame(t, v, p, "should return copy")
+}
@@ -4,6 +4,9 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 )
 
 // String holds a nullable string.
@@ -22,6 +25,112 @@ func NewString(s string) String {
 	}
 }
 
+// StringFromPtr returns a String that is valid if the given pointer is not nil.
+func StringFromPtr(v *string) String {
+	if v == nil {
+		return String{}
+	}
+	return NewString(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (s String) Ptr() *string {
+	if !s.Valid {
+		return nil
+	}
+	v := s.String
+	return &v
+}
+
+// StringFromSQL returns a String from the given sql.NullString.
+func StringFromSQL(v sql.NullString) String {
+	return String{
+		String: v.String,
+		Valid:  v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullString representation.
+func (s String) ToSQL() sql.NullString {
+	return sql.NullString{
+		String: s.String,
+		Valid:  s.Valid,
+	}
+}
+
+// StringFromSQLNull returns a String from the given sql.Null.
+func StringFromSQLNull(v sql.Null[string]) String {
+	return String{
+		String: v.V,
+		Valid:  v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (s String) ToSQLNull() sql.Null[string] {
+	return sql.Null[string]{
+		V:     s.String,
+		Valid: s.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (s String) Get() (string, bool) {
+	return s.String, s.Valid
+}
+
+// IsNull returns true if not valid.
+func (s String) IsNull() bool {
+	return !s.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (s *String) Set(v string) {
+	*s = NewString(v)
+}
+
+// SetNull sets a NULL-value.
+func (s *String) SetNull() {
+	*s = String{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (s String) IsZero() bool {
+	return !s.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (s String) Equal(other String) bool {
+	if !s.Valid || !other.Valid {
+		return s.Valid == other.Valid
+	}
+	return s.String == other.String
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used. As the value
+// field is named String, String does not implement fmt.Stringer.
+func (s String) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, s, s.String, s.Valid)
+}
+
+// GoString returns a Go expression creating the String.
+func (s String) GoString() string {
+	if !s.Valid {
+		return "nulls.String{}"
+	}
+	return fmt.Sprintf("nulls.NewString(%#v)", s.String)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (s String) LogValue() slog.Value {
+	if !s.Valid {
+		return logNullValue()
+	}
+	return slog.StringValue(s.String)
+}
+
 // MarshalJSON marshals the string. If not valid, a NULL-value is returned.
 func (s String) MarshalJSON() ([]byte, error) {
 	if !s.Valid {
@@ -33,13 +142,56 @@ func (s String) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON as string or sets Valid to false if null.
 func (s *String) UnmarshalJSON(data []byte) error {
 	if isNull(data) {
-		s.Valid = false
+		s.SetNull()
 		return nil
 	}
 	s.Valid = true
 	return json.Unmarshal(data, &s.String)
 }
 
+// MarshalText marshals the string as is. If not valid, empty text is returned.
+// Keep in mind, that this means that an empty string cannot be distinguished
+// from a NULL-value.
+func (s String) MarshalText() ([]byte, error) {
+	if !s.Valid {
+		return []byte{}, nil
+	}
+	return []byte(s.String), nil
+}
+
+// UnmarshalText as string or sets Valid to false if empty.
+func (s *String) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		s.SetNull()
+		return nil
+	}
+	s.Valid = true
+	s.String = string(text)
+	return nil
+}
+
+// MarshalYAML marshals the string. If not valid, a NULL-value is returned.
+func (s String) MarshalYAML() (any, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.String, nil
+}
+
+// UnmarshalYAML as string or sets Valid to false if null.
+func (s *String) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		s.SetNull()
+		return nil
+	}
+	err := node.Decode(&s.String)
+	if err != nil {
+		return err
+	}
+	s.Valid = true
+	return nil
+}
+
 // Scan to string value or not valid if nil.
 func (s *String) Scan(src any) error {
 	var sqlString sql.NullString
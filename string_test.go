@@ -1,9 +1,12 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
@@ -61,6 +64,68 @@ func TestString_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(StringUnmarshalJSONSuite))
 }
 
+// StringMarshalYAMLSuite tests String.MarshalYAML.
+type StringMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *StringMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(String{String: "meow"})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *StringMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewString("meow"))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("meow\n", string(raw), "should return correct value")
+}
+
+func TestString_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(StringMarshalYAMLSuite))
+}
+
+// StringUnmarshalYAMLSuite tests String.UnmarshalYAML.
+type StringUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *StringUnmarshalYAMLSuite) TestNull() {
+	v := NewString("meow")
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *StringUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V String `yaml:"v"`
+		W String `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *StringUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v String
+	err := yaml.Unmarshal([]byte(`[1, 2]`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *StringUnmarshalYAMLSuite) TestOK() {
+	var v String
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal("meow", v.String, "should unmarshal correct value")
+}
+
+func TestString_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(StringUnmarshalYAMLSuite))
+}
+
 // StringScanSuite tests String.Scan.
 type StringScanSuite struct {
 	suite.Suite
@@ -107,3 +172,194 @@ func (suite *StringValueSuite) TestOK() {
 func TestString_Value(t *testing.T) {
 	suite.Run(t, new(StringValueSuite))
 }
+
+// TestString_Get tests String.Get.
+func TestString_Get(t *testing.T) {
+	v, ok := NewString("Hello World!").Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, "Hello World!", v, "should return correct value")
+	_, ok = String{String: "Hello World!"}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestString_IsZero tests String.IsZero.
+func TestString_IsZero(t *testing.T) {
+	assert.False(t, NewString("Hello World!").IsZero(), "should not be zero")
+	assert.True(t, String{String: "Hello World!"}.IsZero(), "should be zero")
+}
+
+// TestString_Equal tests String.Equal.
+func TestString_Equal(t *testing.T) {
+	assert.True(t, NewString("meow").Equal(NewString("meow")), "should be equal")
+	assert.False(t, NewString("meow").Equal(NewString("woof")), "should not be equal")
+	assert.False(t, NewString("meow").Equal(String{String: "meow"}), "should not be equal")
+	assert.False(t, String{String: "meow"}.Equal(NewString("meow")), "should not be equal")
+	assert.True(t, String{String: "meow"}.Equal(String{}), "should be equal")
+}
+
+// StringFormatSuite tests String.Format.
+type StringFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *StringFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", String{String: "meow"}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", String{String: "meow"}), "should respect width")
+}
+
+func (suite *StringFormatSuite) TestValue() {
+	suite.Equal("meow", fmt.Sprintf("%v", NewString("meow")), "should return correct value")
+}
+
+func (suite *StringFormatSuite) TestQuoted() {
+	suite.Equal(`"meow"`, fmt.Sprintf("%q", NewString("meow")), "should return correct value")
+}
+
+func (suite *StringFormatSuite) TestLeftAligned() {
+	suite.Equal("meow  |", fmt.Sprintf("%-6s|", NewString("meow")), "should return correct value")
+}
+
+func (suite *StringFormatSuite) TestGoSyntax() {
+	suite.Equal(NewString("meow").GoString(), fmt.Sprintf("%#v", NewString("meow")), "should use GoString")
+}
+
+func TestString_Format(t *testing.T) {
+	suite.Run(t, new(StringFormatSuite))
+}
+
+// TestString_GoString tests String.GoString.
+func TestString_GoString(t *testing.T) {
+	assert.Equal(t, `nulls.NewString("meow")`, NewString("meow").GoString(), "should return correct value")
+	assert.Equal(t, "nulls.String{}", String{String: "meow"}.GoString(), "should return correct value")
+}
+
+// TestString_LogValue tests String.LogValue.
+func TestString_LogValue(t *testing.T) {
+	assert.Equal(t, "meow", NewString("meow").LogValue().Any(), "should return correct value")
+	assert.Nil(t, String{String: "meow"}.LogValue().Any(), "should return null value")
+}
+
+// StringFromPtrSuite tests StringFromPtr.
+type StringFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *StringFromPtrSuite) TestNil() {
+	v := StringFromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *StringFromPtrSuite) TestZero() {
+	x := ""
+	v := StringFromPtr(&x)
+	suite.Equal(NewString(x), v, "should return correct value")
+}
+
+func (suite *StringFromPtrSuite) TestOK() {
+	x := "Hello World!"
+	v := StringFromPtr(&x)
+	suite.Equal(NewString(x), v, "should return correct value")
+}
+
+func TestStringFromPtr(t *testing.T) {
+	suite.Run(t, new(StringFromPtrSuite))
+}
+
+// StringPtrSuite tests String.Ptr.
+type StringPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *StringPtrSuite) TestNotValid() {
+	v := String{String: "Hello World!"}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *StringPtrSuite) TestZero() {
+	p := NewString("").Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal("", *p, "should return correct value")
+}
+
+func (suite *StringPtrSuite) TestOK() {
+	v := NewString("Hello World!")
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal("Hello World!", *p, "should return correct value")
+	*p = ""
+	suite.Equal("Hello World!", v.String, "should return copy")
+}
+
+func TestString_Ptr(t *testing.T) {
+	suite.Run(t, new(StringPtrSuite))
+}
+
+// TestStringFromSQL tests StringFromSQL.
+func TestStringFromSQL(t *testing.T) {
+	assert.Equal(t, String{}, StringFromSQL(sql.NullString{}), "should return correct value")
+	assert.Equal(t, NewString("Hello World!"), StringFromSQL(sql.NullString{String: "Hello World!", Valid: true}), "should return correct value")
+}
+
+// TestString_ToSQL tests String.ToSQL.
+func TestString_ToSQL(t *testing.T) {
+	assert.False(t, String{String: "Hello World!"}.ToSQL().Valid, "should not be valid")
+	assert.Equal(t, sql.NullString{String: "Hello World!", Valid: true}, NewString("Hello World!").ToSQL(), "should return correct value")
+}
+
+// TestStringFromSQLNull tests StringFromSQLNull.
+func TestStringFromSQLNull(t *testing.T) {
+	assert.Equal(t, String{}, StringFromSQLNull(sql.Null[string]{}), "should return correct value")
+	assert.Equal(t, NewString("Hello World!"), StringFromSQLNull(sql.Null[string]{V: "Hello World!", Valid: true}), "should return correct value")
+}
+
+// TestString_ToSQLNull tests String.ToSQLNull.
+func TestString_ToSQLNull(t *testing.T) {
+	assert.False(t, String{String: "Hello World!"}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[string]{V: "Hello World!", Valid: true}, NewString("Hello World!").ToSQLNull(), "should return correct value")
+}
+
+// StringMarshalTextSuite tests String.MarshalText.
+type StringMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *StringMarshalTextSuite) TestNotValid() {
+	v := String{String: "Hello World!"}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *StringMarshalTextSuite) TestOK() {
+	text, err := NewString("Hello World!").MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`Hello World!`, string(text), "should return correct value")
+}
+
+func TestString_MarshalText(t *testing.T) {
+	suite.Run(t, new(StringMarshalTextSuite))
+}
+
+// StringUnmarshalTextSuite tests String.UnmarshalText.
+type StringUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *StringUnmarshalTextSuite) TestEmpty() {
+	v := NewString("Hello World!")
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *StringUnmarshalTextSuite) TestOK() {
+	var v String
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal("meow", v.String, "should unmarshal correct value")
+}
+
+func TestString_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(StringUnmarshalTextSuite))
+}
@@ -4,6 +4,9 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
 	"time"
 )
 
@@ -23,6 +26,117 @@ func NewTime(t time.Time) Time {
 	}
 }
 
+// TimeFromPtr returns a Time that is valid if the given pointer is not nil.
+func TimeFromPtr(v *time.Time) Time {
+	if v == nil {
+		return Time{}
+	}
+	return NewTime(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (t Time) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
+// TimeFromSQL returns a Time from the given sql.NullTime.
+func TimeFromSQL(v sql.NullTime) Time {
+	return Time{
+		Time:  v.Time,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullTime representation.
+func (t Time) ToSQL() sql.NullTime {
+	return sql.NullTime{
+		Time:  t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// TimeFromSQLNull returns a Time from the given sql.Null.
+func TimeFromSQLNull(v sql.Null[time.Time]) Time {
+	return Time{
+		Time:  v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (t Time) ToSQLNull() sql.Null[time.Time] {
+	return sql.Null[time.Time]{
+		V:     t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (t Time) Get() (time.Time, bool) {
+	return t.Time, t.Valid
+}
+
+// IsNull returns true if not valid.
+func (t Time) IsNull() bool {
+	return !t.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (t *Time) Set(v time.Time) {
+	*t = NewTime(v)
+}
+
+// SetNull sets a NULL-value.
+func (t *Time) SetNull() {
+	*t = Time{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (t Time) IsZero() bool {
+	return !t.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+// Times are compared using time.Time.Equal.
+func (t Time) Equal(other Time) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+	return t.Time.Equal(other.Time)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (t Time) String() string {
+	return formatString(t.Time, t.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (t Time) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, t, t.Time, t.Valid)
+}
+
+// GoString returns a Go expression creating the Time.
+func (t Time) GoString() string {
+	if !t.Valid {
+		return "nulls.Time{}"
+	}
+	return fmt.Sprintf("nulls.NewTime(%#v)", t.Time)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (t Time) LogValue() slog.Value {
+	if !t.Valid {
+		return logNullValue()
+	}
+	return slog.TimeValue(t.Time)
+}
+
 // MarshalJSON marshals the time.Time. If not valid, a NULL-value is returned.
 func (t Time) MarshalJSON() ([]byte, error) {
 	if !t.Valid {
@@ -34,13 +148,54 @@ func (t Time) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON as time.Time or sets Valid to false if null.
 func (t *Time) UnmarshalJSON(data []byte) error {
 	if isNull(data) {
-		t.Valid = false
+		t.SetNull()
 		return nil
 	}
 	t.Valid = true
 	return json.Unmarshal(data, &t.Time)
 }
 
+// MarshalText marshals the time.Time in RFC 3339 format. If not valid, empty
+// text is returned.
+func (t Time) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return t.Time.MarshalText()
+}
+
+// UnmarshalText as time.Time in RFC 3339 format or sets Valid to false if empty.
+func (t *Time) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		t.SetNull()
+		return nil
+	}
+	t.Valid = true
+	return t.Time.UnmarshalText(text)
+}
+
+// MarshalYAML marshals the time.Time. If not valid, a NULL-value is returned.
+func (t Time) MarshalYAML() (any, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// UnmarshalYAML as time.Time or sets Valid to false if null.
+func (t *Time) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		t.SetNull()
+		return nil
+	}
+	err := node.Decode(&t.Time)
+	if err != nil {
+		return err
+	}
+	t.Valid = true
+	return nil
+}
+
 // Scan to time.Time value or not valid if nil.
 func (t *Time) Scan(src any) error {
 	var sqlTime sql.NullTime
@@ -0,0 +1,330 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"time"
+)
+
+// timeOfDayLayout is the layout for TimeOfDay. Fractional seconds are optional.
+const timeOfDayLayout = "15:04:05.999999999"
+
+// timeOfDayWrap is the duration of a day after which a TimeOfDay wraps around.
+const timeOfDayWrap = 24 * time.Hour
+
+// TimeOfDay holds a nullable clock time without date and time zone. It can be
+// used for TIME columns and is represented as 15:04:05 with optional fractional
+// seconds in JSON and text.
+type TimeOfDay struct {
+	// Hour is the hour of the day in the range [0, 23] when Valid.
+	Hour int `exhaustruct:"optional"`
+	// Minute is the minute of the hour in the range [0, 59] when Valid.
+	Minute int `exhaustruct:"optional"`
+	// Second is the second of the minute in the range [0, 59] when Valid.
+	Second int `exhaustruct:"optional"`
+	// Nanosecond is the nanosecond of the second when Valid.
+	Nanosecond int `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewTimeOfDay returns a valid TimeOfDay with the given values. They are
+// normalized like in time.Date and wrapped around midnight, so 24:30 becomes
+// 00:30.
+func NewTimeOfDay(hour int, minute int, second int, nanosecond int) TimeOfDay {
+	return TimeOfDayOf(time.Date(0, 1, 1, hour, minute, second, nanosecond, time.UTC))
+}
+
+// TimeOfDayOf returns a valid TimeOfDay with the clock time of the given
+// time.Time in its location.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	hour, minute, second := t.Clock()
+	return TimeOfDay{
+		Hour:       hour,
+		Minute:     minute,
+		Second:     second,
+		Nanosecond: t.Nanosecond(),
+		Valid:      true,
+	}
+}
+
+// Time returns the clock time as time.Time on January 1 of year 0 in UTC like
+// time.Parse does for layouts without date.
+func (t TimeOfDay) Time() time.Time {
+	return time.Date(0, 1, 1, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC)
+}
+
+// TimeOfDayFromPtr returns a TimeOfDay with the clock time of the given
+// time.Time in its location that is valid if the given pointer is not nil.
+func TimeOfDayFromPtr(v *time.Time) TimeOfDay {
+	if v == nil {
+		return TimeOfDay{}
+	}
+	return TimeOfDayOf(*v)
+}
+
+// Ptr returns a pointer to the clock time as returned by Time if valid or nil
+// otherwise.
+func (t TimeOfDay) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time()
+	return &v
+}
+
+// TimeOfDayFromSQL returns a TimeOfDay from the given sql.NullTime.
+func TimeOfDayFromSQL(v sql.NullTime) TimeOfDay {
+	if !v.Valid {
+		return TimeOfDay{}
+	}
+	return TimeOfDayOf(v.Time)
+}
+
+// ToSQL returns the sql.NullTime representation with the clock time as returned
+// by Time.
+func (t TimeOfDay) ToSQL() sql.NullTime {
+	if !t.Valid {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{
+		Time:  t.Time(),
+		Valid: true,
+	}
+}
+
+// TimeOfDayFromSQLNull returns a TimeOfDay from the given sql.Null.
+func TimeOfDayFromSQLNull(v sql.Null[time.Time]) TimeOfDay {
+	if !v.Valid {
+		return TimeOfDay{}
+	}
+	return TimeOfDayOf(v.V)
+}
+
+// ToSQLNull returns the sql.Null representation with the clock time as returned
+// by Time.
+func (t TimeOfDay) ToSQLNull() sql.Null[time.Time] {
+	if !t.Valid {
+		return sql.Null[time.Time]{}
+	}
+	return sql.Null[time.Time]{
+		V:     t.Time(),
+		Valid: true,
+	}
+}
+
+// Duration returns the duration since midnight.
+func (t TimeOfDay) Duration() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
+// On returns the clock time on the given date in the given location. Both need
+// to be valid.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+// Get returns the clock time as returned by Time and whether it is valid.
+func (t TimeOfDay) Get() (time.Time, bool) {
+	return t.Time(), t.Valid
+}
+
+// IsNull returns true if not valid.
+func (t TimeOfDay) IsNull() bool {
+	return !t.Valid
+}
+
+// Set sets the clock time of the given time.Time and makes it valid.
+func (t *TimeOfDay) Set(v time.Time) {
+	*t = TimeOfDayOf(v)
+}
+
+// SetNull sets a NULL-value.
+func (t *TimeOfDay) SetNull() {
+	*t = TimeOfDay{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (t TimeOfDay) IsZero() bool {
+	return !t.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal clock
+// times.
+func (t TimeOfDay) Equal(other TimeOfDay) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+	return t.Duration() == other.Duration()
+}
+
+// Before reports whether both are valid and t is before other.
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t.Valid && other.Valid && t.Duration() < other.Duration()
+}
+
+// After reports whether both are valid and t is after other.
+func (t TimeOfDay) After(other TimeOfDay) bool {
+	return t.Valid && other.Valid && t.Duration() > other.Duration()
+}
+
+// Add returns the clock time with the given duration added. The result wraps
+// around midnight. If not valid, the TimeOfDay is returned unchanged.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	if !t.Valid {
+		return t
+	}
+	sinceMidnight := (t.Duration() + d%timeOfDayWrap + timeOfDayWrap) % timeOfDayWrap
+	return TimeOfDayOf(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(sinceMidnight))
+}
+
+// format returns the clock time formatted as 15:04:05 with optional fractional
+// seconds.
+func (t TimeOfDay) format() string {
+	return t.Time().Format(timeOfDayLayout)
+}
+
+// String returns the clock time formatted as 15:04:05 with optional fractional
+// seconds or NullToken if not valid.
+func (t TimeOfDay) String() string {
+	if !t.Valid {
+		return NullToken
+	}
+	return t.format()
+}
+
+// Format implements fmt.Formatter. The clock time formatted like in String is
+// formatted using the given verb or NullToken is written if not valid. For %#v,
+// GoString is used.
+func (t TimeOfDay) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, t, t.format(), t.Valid)
+}
+
+// GoString returns a Go expression creating the TimeOfDay.
+func (t TimeOfDay) GoString() string {
+	if !t.Valid {
+		return "nulls.TimeOfDay{}"
+	}
+	return fmt.Sprintf("nulls.NewTimeOfDay(%d, %d, %d, %d)", t.Hour, t.Minute, t.Second, t.Nanosecond)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (t TimeOfDay) LogValue() slog.Value {
+	if !t.Valid {
+		return logNullValue()
+	}
+	return slog.StringValue(t.format())
+}
+
+// MarshalJSON marshals the clock time as 15:04:05 with optional fractional
+// seconds. If not valid, a NULL-value is returned.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(t.format())
+}
+
+// UnmarshalJSON as 15:04:05 with optional fractional seconds or sets Valid to
+// false if null.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		t.SetNull()
+		return nil
+	}
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	return t.parse(s)
+}
+
+// MarshalText marshals the clock time as 15:04:05 with optional fractional
+// seconds. If not valid, empty text is returned.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return []byte(t.format()), nil
+}
+
+// UnmarshalText as 15:04:05 with optional fractional seconds or sets Valid to
+// false if empty.
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		t.SetNull()
+		return nil
+	}
+	return t.parse(string(text))
+}
+
+// MarshalYAML marshals the clock time as 15:04:05 with optional fractional
+// seconds. If not valid, a NULL-value is returned.
+func (t TimeOfDay) MarshalYAML() (any, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.format(), nil
+}
+
+// UnmarshalYAML as 15:04:05 with optional fractional seconds or sets Valid to
+// false if null.
+func (t *TimeOfDay) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		t.SetNull()
+		return nil
+	}
+	var s string
+	err := node.Decode(&s)
+	if err != nil {
+		return err
+	}
+	return t.parse(s)
+}
+
+// Scan to clock time value or not valid if nil. For time.Time values, the clock
+// time in their location is used. Strings are parsed as 15:04:05 with optional
+// fractional seconds.
+func (t *TimeOfDay) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		t.SetNull()
+		return nil
+	case time.Time:
+		*t = TimeOfDayOf(src)
+		return nil
+	case string:
+		return t.parse(src)
+	case []byte:
+		return t.parse(string(src))
+	default:
+		return fmt.Errorf("unsupported source value type: %T", src)
+	}
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. As there
+// is no driver type for clock times, it is returned as string like in String.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.format(), nil
+}
+
+// parse parses the given string as 15:04:05 with optional fractional seconds
+// and sets the TimeOfDay valid.
+func (t *TimeOfDay) parse(s string) error {
+	v, err := time.Parse(timeOfDayLayout, s)
+	if err != nil {
+		return err
+	}
+	*t = TimeOfDayOf(v)
+	return nil
+}
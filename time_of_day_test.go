@@ -0,0 +1,371 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+	"time"
+)
+
+// TestNewTimeOfDay tests NewTimeOfDay.
+func TestNewTimeOfDay(t *testing.T) {
+	tod := NewTimeOfDay(10, 30, 15, 500)
+	assert.True(t, tod.Valid, "should be valid")
+	assert.Equal(t, 10, tod.Hour, "should have set correct hour")
+	assert.Equal(t, 30, tod.Minute, "should have set correct minute")
+	assert.Equal(t, 15, tod.Second, "should have set correct second")
+	assert.Equal(t, 500, tod.Nanosecond, "should have set correct nanosecond")
+	assert.Equal(t, NewTimeOfDay(0, 30, 0, 0), NewTimeOfDay(24, 30, 0, 0), "should wrap around midnight")
+	assert.Equal(t, NewTimeOfDay(11, 1, 0, 0), NewTimeOfDay(10, 61, 0, 0), "should normalize")
+}
+
+// TestTimeOfDayOf tests TimeOfDayOf.
+func TestTimeOfDayOf(t *testing.T) {
+	v := time.Date(2022, 7, 1, 10, 30, 15, 500, time.FixedZone("", 2*60*60))
+	assert.Equal(t, NewTimeOfDay(10, 30, 15, 500), TimeOfDayOf(v), "should use clock time in location")
+}
+
+// TestTimeOfDayFromPtr tests TimeOfDayFromPtr and TimeOfDay.Ptr.
+func TestTimeOfDayFromPtr(t *testing.T) {
+	assert.False(t, TimeOfDayFromPtr(nil).Valid, "should not be valid")
+	assert.Nil(t, TimeOfDay{Hour: 10}.Ptr(), "should return nil")
+	v := time.Date(2022, 7, 1, 10, 30, 15, 500, time.UTC)
+	tod := TimeOfDayFromPtr(&v)
+	assert.Equal(t, NewTimeOfDay(10, 30, 15, 500), tod, "should return correct value")
+	expect := time.Date(0, 1, 1, 10, 30, 15, 500, time.UTC)
+	assert.Equal(t, &expect, tod.Ptr(), "should return correct value")
+}
+
+// TestTimeOfDayFromSQL tests TimeOfDayFromSQL and TimeOfDay.ToSQL.
+func TestTimeOfDayFromSQL(t *testing.T) {
+	v := time.Date(0, 1, 1, 10, 30, 0, 0, time.UTC)
+	assert.Equal(t, TimeOfDay{}, TimeOfDayFromSQL(sql.NullTime{Time: v}), "should return correct value")
+	n := sql.NullTime{Time: v, Valid: true}
+	assert.Equal(t, NewTimeOfDay(10, 30, 0, 0), TimeOfDayFromSQL(n), "should return correct value")
+	assert.Equal(t, n, NewTimeOfDay(10, 30, 0, 0).ToSQL(), "should return correct value")
+	assert.Equal(t, sql.NullTime{}, TimeOfDay{Hour: 10}.ToSQL(), "should return correct value")
+}
+
+// TestTimeOfDayFromSQLNull tests TimeOfDayFromSQLNull and TimeOfDay.ToSQLNull.
+func TestTimeOfDayFromSQLNull(t *testing.T) {
+	v := time.Date(0, 1, 1, 10, 30, 0, 0, time.UTC)
+	assert.Equal(t, TimeOfDay{}, TimeOfDayFromSQLNull(sql.Null[time.Time]{V: v}), "should return correct value")
+	n := sql.Null[time.Time]{V: v, Valid: true}
+	assert.Equal(t, NewTimeOfDay(10, 30, 0, 0), TimeOfDayFromSQLNull(n), "should return correct value")
+	assert.Equal(t, n, NewTimeOfDay(10, 30, 0, 0).ToSQLNull(), "should return correct value")
+	assert.Equal(t, sql.Null[time.Time]{}, TimeOfDay{Hour: 10}.ToSQLNull(), "should return correct value")
+}
+
+// TestTimeOfDay_Duration tests TimeOfDay.Duration.
+func TestTimeOfDay_Duration(t *testing.T) {
+	assert.Equal(t, 10*time.Hour+30*time.Minute+15*time.Second+500, NewTimeOfDay(10, 30, 15, 500).Duration(),
+		"should return correct value")
+}
+
+// TestTimeOfDay_On tests TimeOfDay.On.
+func TestTimeOfDay_On(t *testing.T) {
+	loc := time.FixedZone("", 2*60*60)
+	assert.Equal(t, time.Date(2022, 7, 1, 10, 30, 0, 0, loc),
+		NewTimeOfDay(10, 30, 0, 0).On(NewDate(2022, time.July, 1), loc), "should return correct value")
+}
+
+// TestTimeOfDay_Get tests TimeOfDay.Get.
+func TestTimeOfDay_Get(t *testing.T) {
+	v, ok := NewTimeOfDay(10, 30, 0, 0).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, time.Date(0, 1, 1, 10, 30, 0, 0, time.UTC), v, "should return correct value")
+	_, ok = TimeOfDay{}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestTimeOfDay_IsZero tests TimeOfDay.IsZero.
+func TestTimeOfDay_IsZero(t *testing.T) {
+	assert.False(t, NewTimeOfDay(0, 0, 0, 0).IsZero(), "should not be zero")
+	assert.True(t, TimeOfDay{Hour: 10}.IsZero(), "should be zero")
+}
+
+// TestTimeOfDay_Equal tests TimeOfDay.Equal.
+func TestTimeOfDay_Equal(t *testing.T) {
+	tod := NewTimeOfDay(10, 30, 0, 0)
+	assert.True(t, tod.Equal(NewTimeOfDay(10, 30, 0, 0)), "should be equal")
+	assert.False(t, tod.Equal(NewTimeOfDay(10, 30, 0, 1)), "should not be equal")
+	assert.False(t, tod.Equal(TimeOfDay{Hour: 10, Minute: 30}), "should not be equal")
+	assert.True(t, TimeOfDay{Hour: 10}.Equal(TimeOfDay{}), "should be equal")
+}
+
+// TestTimeOfDay_Before tests TimeOfDay.Before and TimeOfDay.After.
+func TestTimeOfDay_Before(t *testing.T) {
+	tod := NewTimeOfDay(10, 30, 0, 0)
+	assert.True(t, tod.Before(NewTimeOfDay(10, 31, 0, 0)), "should be before")
+	assert.False(t, tod.Before(tod), "should not be before")
+	assert.False(t, tod.Before(TimeOfDay{}), "should not be before")
+	assert.True(t, tod.After(NewTimeOfDay(10, 29, 0, 0)), "should be after")
+	assert.False(t, tod.After(tod), "should not be after")
+	assert.False(t, TimeOfDay{}.After(tod), "should not be after")
+}
+
+// TestTimeOfDay_Add tests TimeOfDay.Add.
+func TestTimeOfDay_Add(t *testing.T) {
+	tod := NewTimeOfDay(23, 30, 0, 0)
+	assert.Equal(t, NewTimeOfDay(23, 45, 0, 0), tod.Add(15*time.Minute), "should return correct value")
+	assert.Equal(t, NewTimeOfDay(0, 30, 0, 0), tod.Add(time.Hour), "should wrap around midnight")
+	assert.Equal(t, NewTimeOfDay(22, 30, 0, 0), tod.Add(-49*time.Hour), "should wrap around midnight")
+	assert.Equal(t, TimeOfDay{}, TimeOfDay{}.Add(time.Hour), "should not change invalid time of day")
+}
+
+// TestTimeOfDay_String tests TimeOfDay.String.
+func TestTimeOfDay_String(t *testing.T) {
+	assert.Equal(t, "10:30:15", NewTimeOfDay(10, 30, 15, 0).String(), "should return correct value")
+	assert.Equal(t, "10:30:15.5", NewTimeOfDay(10, 30, 15, 500000000).String(), "should return correct value")
+	assert.Equal(t, NullToken, TimeOfDay{}.String(), "should return null token")
+}
+
+// TestTimeOfDay_Format tests TimeOfDay.Format.
+func TestTimeOfDay_Format(t *testing.T) {
+	assert.Equal(t, `"10:30:15"`, fmt.Sprintf("%q", NewTimeOfDay(10, 30, 15, 0)), "should return correct value")
+	assert.Equal(t, "<null>    ", fmt.Sprintf("%-10v", TimeOfDay{}), "should return null token")
+}
+
+// TestTimeOfDay_GoString tests TimeOfDay.GoString.
+func TestTimeOfDay_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewTimeOfDay(10, 30, 15, 500)", fmt.Sprintf("%#v", NewTimeOfDay(10, 30, 15, 500)),
+		"should return correct value")
+	assert.Equal(t, "nulls.TimeOfDay{}", TimeOfDay{}.GoString(), "should return correct value")
+}
+
+// TestTimeOfDay_LogValue tests TimeOfDay.LogValue.
+func TestTimeOfDay_LogValue(t *testing.T) {
+	assert.Equal(t, "10:30:15", NewTimeOfDay(10, 30, 15, 0).LogValue().Any(), "should return correct value")
+	assert.Nil(t, TimeOfDay{Hour: 10}.LogValue().Any(), "should return null value")
+}
+
+// TimeOfDayMarshalJSONSuite tests TimeOfDay.MarshalJSON.
+type TimeOfDayMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeOfDayMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(TimeOfDay{Hour: 10})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *TimeOfDayMarshalJSONSuite) TestOK() {
+	raw, err := json.Marshal(NewTimeOfDay(10, 30, 15, 0))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`"10:30:15"`, string(raw), "should return correct value")
+}
+
+func TestTimeOfDay_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(TimeOfDayMarshalJSONSuite))
+}
+
+// TimeOfDayUnmarshalJSONSuite tests TimeOfDay.UnmarshalJSON.
+type TimeOfDayUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeOfDayUnmarshalJSONSuite) TestNull() {
+	tod := NewTimeOfDay(10, 30, 15, 0)
+	err := json.Unmarshal(jsonNull, &tod)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tod.Valid, "should not be valid")
+}
+
+func (suite *TimeOfDayUnmarshalJSONSuite) TestUnmarshalFail() {
+	var tod TimeOfDay
+	err := json.Unmarshal([]byte(`103015`), &tod)
+	suite.Error(err, "should fail")
+}
+
+func (suite *TimeOfDayUnmarshalJSONSuite) TestParseFail() {
+	var tod TimeOfDay
+	err := json.Unmarshal([]byte(`"25:00:00"`), &tod)
+	suite.Error(err, "should fail")
+}
+
+func (suite *TimeOfDayUnmarshalJSONSuite) TestOK() {
+	var tod TimeOfDay
+	err := json.Unmarshal([]byte(`"10:30:15"`), &tod)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewTimeOfDay(10, 30, 15, 0), tod, "should unmarshal correct value")
+}
+
+func (suite *TimeOfDayUnmarshalJSONSuite) TestFractionalSeconds() {
+	var tod TimeOfDay
+	err := json.Unmarshal([]byte(`"10:30:15.5"`), &tod)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewTimeOfDay(10, 30, 15, 500000000), tod, "should unmarshal correct value")
+}
+
+func TestTimeOfDay_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(TimeOfDayUnmarshalJSONSuite))
+}
+
+// TimeOfDayMarshalTextSuite tests TimeOfDay.MarshalText.
+type TimeOfDayMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeOfDayMarshalTextSuite) TestNotValid() {
+	text, err := TimeOfDay{Hour: 10}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *TimeOfDayMarshalTextSuite) TestOK() {
+	text, err := NewTimeOfDay(10, 30, 15, 0).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`10:30:15`, string(text), "should return correct value")
+}
+
+func TestTimeOfDay_MarshalText(t *testing.T) {
+	suite.Run(t, new(TimeOfDayMarshalTextSuite))
+}
+
+// TimeOfDayUnmarshalTextSuite tests TimeOfDay.UnmarshalText.
+type TimeOfDayUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeOfDayUnmarshalTextSuite) TestEmpty() {
+	tod := NewTimeOfDay(10, 30, 15, 0)
+	err := tod.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tod.Valid, "should not be valid")
+}
+
+func (suite *TimeOfDayUnmarshalTextSuite) TestUnmarshalFail() {
+	var tod TimeOfDay
+	err := tod.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *TimeOfDayUnmarshalTextSuite) TestOK() {
+	var tod TimeOfDay
+	err := tod.UnmarshalText([]byte(`10:30:15`))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(NewTimeOfDay(10, 30, 15, 0), tod, "should unmarshal correct value")
+}
+
+func TestTimeOfDay_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(TimeOfDayUnmarshalTextSuite))
+}
+
+// TimeOfDayMarshalYAMLSuite tests TimeOfDay.MarshalYAML.
+type TimeOfDayMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeOfDayMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(TimeOfDay{Hour: 10})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *TimeOfDayMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewTimeOfDay(10, 30, 15, 0))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("\"10:30:15\"\n", string(raw), "should return correct value")
+}
+
+func TestTimeOfDay_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(TimeOfDayMarshalYAMLSuite))
+}
+
+// TimeOfDayUnmarshalYAMLSuite tests TimeOfDay.UnmarshalYAML.
+type TimeOfDayUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeOfDayUnmarshalYAMLSuite) TestNull() {
+	tod := NewTimeOfDay(10, 30, 15, 0)
+	err := tod.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tod.Valid, "should not be valid")
+}
+
+func (suite *TimeOfDayUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var tod TimeOfDay
+	err := yaml.Unmarshal([]byte(`meow`), &tod)
+	suite.Error(err, "should fail")
+}
+
+func (suite *TimeOfDayUnmarshalYAMLSuite) TestOK() {
+	for _, src := range []string{`10:30:15`, `"10:30:15"`} {
+		var tod TimeOfDay
+		err := yaml.Unmarshal([]byte(src), &tod)
+		suite.Require().NoErrorf(err, "should not fail for %s", src)
+		suite.Equalf(NewTimeOfDay(10, 30, 15, 0), tod, "should unmarshal correct value for %s", src)
+	}
+}
+
+func TestTimeOfDay_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(TimeOfDayUnmarshalYAMLSuite))
+}
+
+// TimeOfDayScanSuite tests TimeOfDay.Scan.
+type TimeOfDayScanSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeOfDayScanSuite) TestNull() {
+	tod := NewTimeOfDay(10, 30, 15, 0)
+	err := tod.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tod.Valid, "should not be valid")
+}
+
+func (suite *TimeOfDayScanSuite) TestUnsupported() {
+	var tod TimeOfDay
+	err := tod.Scan(16)
+	suite.Error(err, "should fail")
+}
+
+func (suite *TimeOfDayScanSuite) TestParseFail() {
+	var tod TimeOfDay
+	err := tod.Scan("meow")
+	suite.Error(err, "should fail")
+}
+
+func (suite *TimeOfDayScanSuite) TestOK() {
+	for _, src := range []any{
+		"10:30:15",
+		[]byte("10:30:15"),
+		time.Date(2022, 7, 1, 10, 30, 15, 0, time.UTC),
+	} {
+		var tod TimeOfDay
+		err := tod.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %v", src)
+		suite.Equalf(NewTimeOfDay(10, 30, 15, 0), tod, "should scan correct value for %v", src)
+	}
+}
+
+func TestTimeOfDay_Scan(t *testing.T) {
+	suite.Run(t, new(TimeOfDayScanSuite))
+}
+
+// TimeOfDayValueSuite tests TimeOfDay.Value.
+type TimeOfDayValueSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeOfDayValueSuite) TestNull() {
+	raw, err := TimeOfDay{Hour: 10}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *TimeOfDayValueSuite) TestOK() {
+	raw, err := NewTimeOfDay(10, 30, 15, 0).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("10:30:15", raw, "should return correct value")
+}
+
+func TestTimeOfDay_Value(t *testing.T) {
+	suite.Run(t, new(TimeOfDayValueSuite))
+}
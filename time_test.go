@@ -1,9 +1,12 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 	"time"
 )
@@ -65,6 +68,68 @@ func TestTime_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(TimeUnmarshalJSONSuite))
 }
 
+// TimeMarshalYAMLSuite tests Time.MarshalYAML.
+type TimeMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Time{Time: time.Date(2022, 7, 1, 10, 30, 0, 0, time.UTC)})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *TimeMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewTime(time.Date(2022, 7, 1, 10, 30, 0, 0, time.UTC)))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("2022-07-01T10:30:00Z\n", string(raw), "should return correct value")
+}
+
+func TestTime_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(TimeMarshalYAMLSuite))
+}
+
+// TimeUnmarshalYAMLSuite tests Time.UnmarshalYAML.
+type TimeUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeUnmarshalYAMLSuite) TestNull() {
+	v := NewTime(time.Date(2022, 7, 1, 10, 30, 0, 0, time.UTC))
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *TimeUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Time `yaml:"v"`
+		W Time `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *TimeUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Time
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *TimeUnmarshalYAMLSuite) TestOK() {
+	var v Time
+	err := yaml.Unmarshal([]byte(`2022-07-01T12:30:00+02:00`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.True(time.Date(2022, 7, 1, 10, 30, 0, 0, time.UTC).Equal(v.Time), "should unmarshal correct value")
+}
+
+func TestTime_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(TimeUnmarshalYAMLSuite))
+}
+
 // TimeScanSuite tests Time.Scan.
 type TimeScanSuite struct {
 	suite.Suite
@@ -125,3 +190,204 @@ func TestTime_UTC(t *testing.T) {
 		Valid: tt.Valid,
 	}, utc, "should return correct value")
 }
+
+// TestTime_Get tests Time.Get.
+func TestTime_Get(t *testing.T) {
+	v, ok := NewTime(testTime).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, testTime, v, "should return correct value")
+	_, ok = Time{Time: testTime}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestTime_IsZero tests Time.IsZero.
+func TestTime_IsZero(t *testing.T) {
+	assert.False(t, NewTime(testTime).IsZero(), "should not be zero")
+	assert.True(t, Time{Time: testTime}.IsZero(), "should be zero")
+}
+
+// TestTime_Equal tests Time.Equal.
+func TestTime_Equal(t *testing.T) {
+	a := NewTime(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC))
+	b := NewTime(time.Date(2022, 7, 1, 14, 30, 0, 0, time.FixedZone("CEST", 2*60*60)))
+	assert.True(t, a.Equal(b), "should be equal in different locations")
+	assert.False(t, a.Equal(NewTime(a.Time.Add(time.Second))), "should not be equal")
+	assert.False(t, a.Equal(Time{Time: a.Time}), "should not be equal")
+	assert.False(t, Time{Time: a.Time}.Equal(a), "should not be equal")
+	assert.True(t, Time{Time: a.Time}.Equal(Time{}), "should be equal")
+}
+
+// TestTime_String tests Time.String.
+func TestTime_String(t *testing.T) {
+	assert.Equal(t, "2022-07-01 12:30:00 +0000 UTC", NewTime(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)).String(), "should return correct value")
+	assert.Equal(t, NullToken, Time{Time: time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)}.String(), "should return null token")
+}
+
+// TimeFormatSuite tests Time.Format.
+type TimeFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Time{Time: time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Time{Time: time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)}), "should respect width")
+}
+
+func (suite *TimeFormatSuite) TestValue() {
+	suite.Equal("2022-07-01 12:30:00 +0000 UTC", fmt.Sprintf("%v", NewTime(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC))), "should return correct value")
+}
+
+func (suite *TimeFormatSuite) TestVerb() {
+	suite.Equal("2022-07-01 12:30:00 +0000 UTC", fmt.Sprintf("%s", NewTime(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC))), "should return correct value")
+}
+
+func (suite *TimeFormatSuite) TestGoSyntax() {
+	suite.Equal(NewTime(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)).GoString(), fmt.Sprintf("%#v", NewTime(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC))), "should use GoString")
+}
+
+func TestTime_Format(t *testing.T) {
+	suite.Run(t, new(TimeFormatSuite))
+}
+
+// TestTime_GoString tests Time.GoString.
+func TestTime_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewTime(time.Date(2022, time.July, 1, 12, 30, 0, 0, time.UTC))", NewTime(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Time{}", Time{Time: time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)}.GoString(), "should return correct value")
+}
+
+// TestTime_LogValue tests Time.LogValue.
+func TestTime_LogValue(t *testing.T) {
+	assert.Equal(t, time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC), NewTime(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Time{Time: time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)}.LogValue().Any(), "should return null value")
+}
+
+// TimeFromPtrSuite tests TimeFromPtr.
+type TimeFromPtrSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeFromPtrSuite) TestNil() {
+	v := TimeFromPtr(nil)
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *TimeFromPtrSuite) TestZero() {
+	x := time.Time{}
+	v := TimeFromPtr(&x)
+	suite.Equal(NewTime(x), v, "should return correct value")
+}
+
+func (suite *TimeFromPtrSuite) TestOK() {
+	x := testTime
+	v := TimeFromPtr(&x)
+	suite.Equal(NewTime(x), v, "should return correct value")
+}
+
+func TestTimeFromPtr(t *testing.T) {
+	suite.Run(t, new(TimeFromPtrSuite))
+}
+
+// TimePtrSuite tests Time.Ptr.
+type TimePtrSuite struct {
+	suite.Suite
+}
+
+func (suite *TimePtrSuite) TestNotValid() {
+	v := Time{Time: testTime}
+	suite.Nil(v.Ptr(), "should return nil")
+}
+
+func (suite *TimePtrSuite) TestZero() {
+	p := NewTime(time.Time{}).Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(time.Time{}, *p, "should return correct value")
+}
+
+func (suite *TimePtrSuite) TestOK() {
+	v := NewTime(testTime)
+	p := v.Ptr()
+	suite.Require().NotNil(p, "should not return nil")
+	suite.Equal(testTime, *p, "should return correct value")
+	*p = time.Time{}
+	suite.Equal(testTime, v.Time, "should return copy")
+}
+
+func TestTime_Ptr(t *testing.T) {
+	suite.Run(t, new(TimePtrSuite))
+}
+
+// TestTimeFromSQL tests TimeFromSQL.
+func TestTimeFromSQL(t *testing.T) {
+	assert.Equal(t, Time{}, TimeFromSQL(sql.NullTime{}), "should return correct value")
+	assert.Equal(t, NewTime(testTime), TimeFromSQL(sql.NullTime{Time: testTime, Valid: true}), "should return correct value")
+}
+
+// TestTime_ToSQL tests Time.ToSQL.
+func TestTime_ToSQL(t *testing.T) {
+	assert.False(t, Time{Time: testTime}.ToSQL().Valid, "should not be valid")
+	assert.Equal(t, sql.NullTime{Time: testTime, Valid: true}, NewTime(testTime).ToSQL(), "should return correct value")
+}
+
+// TestTimeFromSQLNull tests TimeFromSQLNull.
+func TestTimeFromSQLNull(t *testing.T) {
+	assert.Equal(t, Time{}, TimeFromSQLNull(sql.Null[time.Time]{}), "should return correct value")
+	assert.Equal(t, NewTime(testTime), TimeFromSQLNull(sql.Null[time.Time]{V: testTime, Valid: true}), "should return correct value")
+}
+
+// TestTime_ToSQLNull tests Time.ToSQLNull.
+func TestTime_ToSQLNull(t *testing.T) {
+	assert.False(t, Time{Time: testTime}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[time.Time]{V: testTime, Valid: true}, NewTime(testTime).ToSQLNull(), "should return correct value")
+}
+
+// TimeMarshalTextSuite tests Time.MarshalText.
+type TimeMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeMarshalTextSuite) TestNotValid() {
+	v := Time{Time: time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)}
+	text, err := v.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *TimeMarshalTextSuite) TestOK() {
+	text, err := NewTime(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC)).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`2022-07-01T12:30:00Z`, string(text), "should return correct value")
+}
+
+func TestTime_MarshalText(t *testing.T) {
+	suite.Run(t, new(TimeMarshalTextSuite))
+}
+
+// TimeUnmarshalTextSuite tests Time.UnmarshalText.
+type TimeUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeUnmarshalTextSuite) TestEmpty() {
+	v := NewTime(time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC))
+	err := v.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *TimeUnmarshalTextSuite) TestUnmarshalFail() {
+	var v Time
+	err := v.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *TimeUnmarshalTextSuite) TestOK() {
+	var v Time
+	err := v.UnmarshalText([]byte(`2022-07-01T12:30:00+02:00`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.True(time.Date(2022, 7, 1, 10, 30, 0, 0, time.UTC).Equal(v.Time), "should unmarshal correct value")
+}
+
+func TestTime_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(TimeUnmarshalTextSuite))
+}
@@ -0,0 +1,202 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+)
+
+// Uint holds a nullable uint.
+type Uint struct {
+	// Uint is the actual value when Valid.
+	Uint uint `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewUint returns a valid Uint with the given value.
+func NewUint(i uint) Uint {
+	return Uint{
+		Uint:  i,
+		Valid: true,
+	}
+}
+
+// UintFromPtr returns a Uint that is valid if the given pointer is not nil.
+func UintFromPtr(v *uint) Uint {
+	if v == nil {
+		return Uint{}
+	}
+	return NewUint(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (i Uint) Ptr() *uint {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Uint
+	return &v
+}
+
+// UintFromSQLNull returns a Uint from the given sql.Null.
+func UintFromSQLNull(v sql.Null[uint]) Uint {
+	return Uint{
+		Uint:  v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (i Uint) ToSQLNull() sql.Null[uint] {
+	return sql.Null[uint]{
+		V:     i.Uint,
+		Valid: i.Valid,
+	}
+}
+
+// UintFromNumber returns an Uint from the given Number.
+func UintFromNumber(n Number[uint]) Uint {
+	return Uint{
+		Uint:  n.V,
+		Valid: n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (i Uint) ToNumber() Number[uint] {
+	return Number[uint]{
+		V:     i.Uint,
+		Valid: i.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (i Uint) Get() (uint, bool) {
+	return i.Uint, i.Valid
+}
+
+// IsNull returns true if not valid.
+func (i Uint) IsNull() bool {
+	return !i.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (i *Uint) Set(v uint) {
+	*i = NewUint(v)
+}
+
+// SetNull sets a NULL-value.
+func (i *Uint) SetNull() {
+	*i = Uint{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (i Uint) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (i Uint) Equal(other Uint) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+	return i.Uint == other.Uint
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (i Uint) String() string {
+	return formatString(i.Uint, i.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (i Uint) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, i, i.Uint, i.Valid)
+}
+
+// GoString returns a Go expression creating the Uint.
+func (i Uint) GoString() string {
+	if !i.Valid {
+		return "nulls.Uint{}"
+	}
+	return fmt.Sprintf("nulls.NewUint(%d)", i.Uint)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (i Uint) LogValue() slog.Value {
+	return i.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the uint. If not valid, a NULL-value is returned.
+func (i Uint) MarshalJSON() ([]byte, error) {
+	return i.ToNumber().MarshalJSON()
+}
+
+// UnmarshalJSON as uint or sets Valid to false if null. If the value does not
+// fit into uint, a RangeError or PrecisionError is returned.
+func (i *Uint) UnmarshalJSON(data []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	*i = UintFromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the uint as decimal text. If not valid, empty text is
+// returned.
+func (i Uint) MarshalText() ([]byte, error) {
+	return i.ToNumber().MarshalText()
+}
+
+// UnmarshalText as decimal uint or sets Valid to false if empty. If the value
+// does not fit into uint, a RangeError or PrecisionError is returned.
+func (i *Uint) UnmarshalText(text []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*i = UintFromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the uint. If not valid, a NULL-value is returned.
+func (i Uint) MarshalYAML() (any, error) {
+	return i.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as uint or sets Valid to false if null. If the value does not
+// fit into uint, a RangeError or PrecisionError is returned.
+func (i *Uint) UnmarshalYAML(node *yaml.Node) error {
+	n := i.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*i = UintFromNumber(n)
+	return nil
+}
+
+// Scan to uint value or not valid if nil. If the value does not fit into uint,
+// a RangeError or PrecisionError is returned.
+func (i *Uint) Scan(src any) error {
+	n := i.ToNumber()
+	err := n.Scan(src)
+	if err != nil {
+		return err
+	}
+	*i = UintFromNumber(n)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. As driver
+// values are limited to int64, a RangeError is returned for larger values.
+func (i Uint) Value() (driver.Value, error) {
+	return i.ToNumber().Value()
+}
@@ -0,0 +1,201 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+)
+
+// Uint16 holds a nullable uint16.
+type Uint16 struct {
+	// Uint16 is the actual value when Valid.
+	Uint16 uint16 `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewUint16 returns a valid Uint16 with the given value.
+func NewUint16(i uint16) Uint16 {
+	return Uint16{
+		Uint16: i,
+		Valid:  true,
+	}
+}
+
+// Uint16FromPtr returns a Uint16 that is valid if the given pointer is not nil.
+func Uint16FromPtr(v *uint16) Uint16 {
+	if v == nil {
+		return Uint16{}
+	}
+	return NewUint16(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (i Uint16) Ptr() *uint16 {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Uint16
+	return &v
+}
+
+// Uint16FromSQLNull returns a Uint16 from the given sql.Null.
+func Uint16FromSQLNull(v sql.Null[uint16]) Uint16 {
+	return Uint16{
+		Uint16: v.V,
+		Valid:  v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (i Uint16) ToSQLNull() sql.Null[uint16] {
+	return sql.Null[uint16]{
+		V:     i.Uint16,
+		Valid: i.Valid,
+	}
+}
+
+// Uint16FromNumber returns an Uint16 from the given Number.
+func Uint16FromNumber(n Number[uint16]) Uint16 {
+	return Uint16{
+		Uint16: n.V,
+		Valid:  n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (i Uint16) ToNumber() Number[uint16] {
+	return Number[uint16]{
+		V:     i.Uint16,
+		Valid: i.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (i Uint16) Get() (uint16, bool) {
+	return i.Uint16, i.Valid
+}
+
+// IsNull returns true if not valid.
+func (i Uint16) IsNull() bool {
+	return !i.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (i *Uint16) Set(v uint16) {
+	*i = NewUint16(v)
+}
+
+// SetNull sets a NULL-value.
+func (i *Uint16) SetNull() {
+	*i = Uint16{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (i Uint16) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (i Uint16) Equal(other Uint16) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+	return i.Uint16 == other.Uint16
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (i Uint16) String() string {
+	return formatString(i.Uint16, i.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (i Uint16) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, i, i.Uint16, i.Valid)
+}
+
+// GoString returns a Go expression creating the Uint16.
+func (i Uint16) GoString() string {
+	if !i.Valid {
+		return "nulls.Uint16{}"
+	}
+	return fmt.Sprintf("nulls.NewUint16(%d)", i.Uint16)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (i Uint16) LogValue() slog.Value {
+	return i.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the uint16. If not valid, a NULL-value is returned.
+func (i Uint16) MarshalJSON() ([]byte, error) {
+	return i.ToNumber().MarshalJSON()
+}
+
+// UnmarshalJSON as uint16 or sets Valid to false if null. If the value does not
+// fit into uint16, a RangeError or PrecisionError is returned.
+func (i *Uint16) UnmarshalJSON(data []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	*i = Uint16FromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the uint16 as decimal text. If not valid, empty text is
+// returned.
+func (i Uint16) MarshalText() ([]byte, error) {
+	return i.ToNumber().MarshalText()
+}
+
+// UnmarshalText as decimal uint16 or sets Valid to false if empty. If the value
+// does not fit into uint16, a RangeError or PrecisionError is returned.
+func (i *Uint16) UnmarshalText(text []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*i = Uint16FromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the uint16. If not valid, a NULL-value is returned.
+func (i Uint16) MarshalYAML() (any, error) {
+	return i.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as uint16 or sets Valid to false if null. If the value does not
+// fit into uint16, a RangeError or PrecisionError is returned.
+func (i *Uint16) UnmarshalYAML(node *yaml.Node) error {
+	n := i.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*i = Uint16FromNumber(n)
+	return nil
+}
+
+// Scan to uint16 value or not valid if nil. If the value does not fit into
+// uint16, a RangeError or PrecisionError is returned.
+func (i *Uint16) Scan(src any) error {
+	n := i.ToNumber()
+	err := n.Scan(src)
+	if err != nil {
+		return err
+	}
+	*i = Uint16FromNumber(n)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface.
+func (i Uint16) Value() (driver.Value, error) {
+	return i.ToNumber().Value()
+}
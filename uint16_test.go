@@ -0,0 +1,383 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNewUint16 tests NewUint16.
+func TestNewUint16(t *testing.T) {
+	i := NewUint16(16)
+	assert.True(t, i.Valid, "should be valid")
+	assert.EqualValues(t, 16, i.Uint16, "should contain correct value")
+}
+
+// TestUint16_Get tests Uint16.Get.
+func TestUint16_Get(t *testing.T) {
+	v, ok := NewUint16(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, uint16(16), v, "should return correct value")
+	_, ok = Uint16{Uint16: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestUint16_IsZero tests Uint16.IsZero.
+func TestUint16_IsZero(t *testing.T) {
+	assert.False(t, NewUint16(16).IsZero(), "should not be zero")
+	assert.True(t, Uint16{Uint16: 16}.IsZero(), "should be zero")
+}
+
+// TestUint16_Equal tests Uint16.Equal.
+func TestUint16_Equal(t *testing.T) {
+	assert.True(t, NewUint16(16).Equal(NewUint16(16)), "should be equal")
+	assert.False(t, NewUint16(16).Equal(NewUint16(17)), "should not be equal")
+	assert.False(t, NewUint16(16).Equal(Uint16{Uint16: 16}), "should not be equal")
+	assert.False(t, Uint16{Uint16: 16}.Equal(NewUint16(16)), "should not be equal")
+	assert.True(t, Uint16{Uint16: 16}.Equal(Uint16{}), "should be equal")
+}
+
+// TestUint16_String tests Uint16.String.
+func TestUint16_String(t *testing.T) {
+	assert.Equal(t, "16", NewUint16(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Uint16{Uint16: 16}.String(), "should return null token")
+}
+
+// Uint16FormatSuite tests Uint16.Format.
+type Uint16FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint16FormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Uint16{Uint16: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Uint16{Uint16: 16}), "should respect width")
+}
+
+func (suite *Uint16FormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewUint16(16)), "should return correct value")
+}
+
+func (suite *Uint16FormatSuite) TestPaddedDecimal() {
+	suite.Equal("00016", fmt.Sprintf("%05d", NewUint16(16)), "should return correct value")
+}
+
+func (suite *Uint16FormatSuite) TestHex() {
+	suite.Equal("10", fmt.Sprintf("%x", NewUint16(16)), "should return correct value")
+}
+
+func (suite *Uint16FormatSuite) TestGoSyntax() {
+	suite.Equal(NewUint16(16).GoString(), fmt.Sprintf("%#v", NewUint16(16)), "should use GoString")
+}
+
+func TestUint16_Format(t *testing.T) {
+	suite.Run(t, new(Uint16FormatSuite))
+}
+
+// TestUint16_GoString tests Uint16.GoString.
+func TestUint16_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewUint16(16)", NewUint16(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Uint16{}", Uint16{Uint16: 16}.GoString(), "should return correct value")
+}
+
+// TestUint16_LogValue tests Uint16.LogValue.
+func TestUint16_LogValue(t *testing.T) {
+	assert.Equal(t, uint64(16), NewUint16(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Uint16{Uint16: 16}.LogValue().Any(), "should return null value")
+}
+
+// TestUint16FromPtr tests Uint16FromPtr.
+func TestUint16FromPtr(t *testing.T) {
+	assert.False(t, Uint16FromPtr(nil).Valid, "should not be valid for nil")
+	zero := uint16(0)
+	assert.Equal(t, NewUint16(0), Uint16FromPtr(&zero), "should return correct value for zero")
+	v := uint16(16)
+	assert.Equal(t, NewUint16(16), Uint16FromPtr(&v), "should return correct value")
+}
+
+// TestUint16_Ptr tests Uint16.Ptr.
+func TestUint16_Ptr(t *testing.T) {
+	assert.Nil(t, Uint16{Uint16: 16}.Ptr(), "should return nil if not valid")
+	p := NewUint16(0).Ptr()
+	assert.Equal(t, uint16(0), *p, "should return correct value for zero")
+	p = NewUint16(16).Ptr()
+	assert.Equal(t, uint16(16), *p, "should return correct value")
+}
+
+// TestUint16FromSQLNull tests Uint16FromSQLNull.
+func TestUint16FromSQLNull(t *testing.T) {
+	assert.Equal(t, Uint16{}, Uint16FromSQLNull(sql.Null[uint16]{}), "should return correct value")
+	assert.Equal(t, NewUint16(16), Uint16FromSQLNull(sql.Null[uint16]{V: 16, Valid: true}), "should return correct value")
+}
+
+// TestUint16_ToSQLNull tests Uint16.ToSQLNull.
+func TestUint16_ToSQLNull(t *testing.T) {
+	assert.False(t, Uint16{Uint16: 16}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[uint16]{V: 16, Valid: true}, NewUint16(16).ToSQLNull(), "should return correct value")
+}
+
+// Uint16MarshalJSONSuite tests Uint16.MarshalJSON.
+type Uint16MarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint16MarshalJSONSuite) TestNotValid() {
+	i := Uint16{Uint16: 16}
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *Uint16MarshalJSONSuite) TestOK() {
+	i := NewUint16(16)
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(marshalMust(16), raw, "should return correct value")
+}
+
+func TestUint16_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(Uint16MarshalJSONSuite))
+}
+
+// Uint16UnmarshalJSONSuite tests Uint16.UnmarshalJSON.
+type Uint16UnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint16UnmarshalJSONSuite) TestNull() {
+	var i Uint16
+	err := json.Unmarshal(jsonNull, &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint16UnmarshalJSONSuite) TestNegative() {
+	var i Uint16
+	err := json.Unmarshal([]byte("-1"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint16UnmarshalJSONSuite) TestOutOfRange() {
+	var i Uint16
+	err := json.Unmarshal([]byte("65536"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint16UnmarshalJSONSuite) TestInvalid() {
+	var i Uint16
+	err := json.Unmarshal(marshalMust("meow"), &i)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Uint16UnmarshalJSONSuite) TestOK() {
+	var i Uint16
+	err := json.Unmarshal(marshalMust(16), &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint16, "should unmarshal correct value")
+}
+
+func TestUint16_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(Uint16UnmarshalJSONSuite))
+}
+
+// Uint16MarshalTextSuite tests Uint16.MarshalText.
+type Uint16MarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint16MarshalTextSuite) TestNotValid() {
+	text, err := Uint16{Uint16: 16}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *Uint16MarshalTextSuite) TestOK() {
+	text, err := NewUint16(16).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16", string(text), "should return correct value")
+}
+
+func TestUint16_MarshalText(t *testing.T) {
+	suite.Run(t, new(Uint16MarshalTextSuite))
+}
+
+// Uint16UnmarshalTextSuite tests Uint16.UnmarshalText.
+type Uint16UnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint16UnmarshalTextSuite) TestEmpty() {
+	i := NewUint16(16)
+	err := i.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint16UnmarshalTextSuite) TestNegative() {
+	var i Uint16
+	err := i.UnmarshalText([]byte("-1"))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint16UnmarshalTextSuite) TestOK() {
+	var i Uint16
+	err := i.UnmarshalText([]byte("16"))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint16, "should unmarshal correct value")
+}
+
+func TestUint16_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(Uint16UnmarshalTextSuite))
+}
+
+// Uint16MarshalYAMLSuite tests Uint16.MarshalYAML.
+type Uint16MarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint16MarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Uint16{Uint16: 16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *Uint16MarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewUint16(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16\n", string(raw), "should return correct value")
+}
+
+func TestUint16_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(Uint16MarshalYAMLSuite))
+}
+
+// Uint16UnmarshalYAMLSuite tests Uint16.UnmarshalYAML.
+type Uint16UnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint16UnmarshalYAMLSuite) TestNull() {
+	v := NewUint16(16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Uint16UnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Uint16 `yaml:"v"`
+		W Uint16 `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *Uint16UnmarshalYAMLSuite) TestOutOfRange() {
+	var v Uint16
+	err := yaml.Unmarshal([]byte(`65536`), &v)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint16UnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Uint16
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Uint16UnmarshalYAMLSuite) TestOK() {
+	var v Uint16
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(uint16(42), v.Uint16, "should unmarshal correct value")
+}
+
+func TestUint16_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(Uint16UnmarshalYAMLSuite))
+}
+
+// Uint16ScanSuite tests Uint16.Scan.
+type Uint16ScanSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint16ScanSuite) TestNull() {
+	i := NewUint16(16)
+	err := i.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint16ScanSuite) TestNegative() {
+	var i Uint16
+	err := i.Scan(int64(-1))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint16ScanSuite) TestOutOfRange() {
+	var i Uint16
+	err := i.Scan([]byte("65536"))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint16ScanSuite) TestOK() {
+	var i Uint16
+	err := i.Scan(int64(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint16, "should scan correct value")
+}
+
+func (suite *Uint16ScanSuite) TestString() {
+	var i Uint16
+	err := i.Scan("16")
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint16, "should scan correct value")
+}
+
+func TestUint16_Scan(t *testing.T) {
+	suite.Run(t, new(Uint16ScanSuite))
+}
+
+// Uint16ValueSuite tests Uint16.Value.
+type Uint16ValueSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint16ValueSuite) TestNull() {
+	raw, err := Uint16{Uint16: 16}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *Uint16ValueSuite) TestOK() {
+	raw, err := NewUint16(16).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(16), raw, "should return correct value")
+}
+
+func TestUint16_Value(t *testing.T) {
+	suite.Run(t, new(Uint16ValueSuite))
+}
+
+// TestUint16FromNumber tests Uint16FromNumber and Uint16.ToNumber.
+func TestUint16FromNumber(t *testing.T) {
+	assert.Equal(t, Uint16{}, Uint16FromNumber(Number[uint16]{}), "should return correct value")
+	assert.Equal(t, NewUint16(16), Uint16FromNumber(NewNumber[uint16](16)), "should return correct value")
+	assert.Equal(t, NewNumber[uint16](16), NewUint16(16).ToNumber(), "should return correct value")
+}
@@ -0,0 +1,201 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+)
+
+// Uint32 holds a nullable uint32.
+type Uint32 struct {
+	// Uint32 is the actual value when Valid.
+	Uint32 uint32 `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewUint32 returns a valid Uint32 with the given value.
+func NewUint32(i uint32) Uint32 {
+	return Uint32{
+		Uint32: i,
+		Valid:  true,
+	}
+}
+
+// Uint32FromPtr returns a Uint32 that is valid if the given pointer is not nil.
+func Uint32FromPtr(v *uint32) Uint32 {
+	if v == nil {
+		return Uint32{}
+	}
+	return NewUint32(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (i Uint32) Ptr() *uint32 {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Uint32
+	return &v
+}
+
+// Uint32FromSQLNull returns a Uint32 from the given sql.Null.
+func Uint32FromSQLNull(v sql.Null[uint32]) Uint32 {
+	return Uint32{
+		Uint32: v.V,
+		Valid:  v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (i Uint32) ToSQLNull() sql.Null[uint32] {
+	return sql.Null[uint32]{
+		V:     i.Uint32,
+		Valid: i.Valid,
+	}
+}
+
+// Uint32FromNumber returns an Uint32 from the given Number.
+func Uint32FromNumber(n Number[uint32]) Uint32 {
+	return Uint32{
+		Uint32: n.V,
+		Valid:  n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (i Uint32) ToNumber() Number[uint32] {
+	return Number[uint32]{
+		V:     i.Uint32,
+		Valid: i.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (i Uint32) Get() (uint32, bool) {
+	return i.Uint32, i.Valid
+}
+
+// IsNull returns true if not valid.
+func (i Uint32) IsNull() bool {
+	return !i.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (i *Uint32) Set(v uint32) {
+	*i = NewUint32(v)
+}
+
+// SetNull sets a NULL-value.
+func (i *Uint32) SetNull() {
+	*i = Uint32{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (i Uint32) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (i Uint32) Equal(other Uint32) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+	return i.Uint32 == other.Uint32
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (i Uint32) String() string {
+	return formatString(i.Uint32, i.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (i Uint32) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, i, i.Uint32, i.Valid)
+}
+
+// GoString returns a Go expression creating the Uint32.
+func (i Uint32) GoString() string {
+	if !i.Valid {
+		return "nulls.Uint32{}"
+	}
+	return fmt.Sprintf("nulls.NewUint32(%d)", i.Uint32)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (i Uint32) LogValue() slog.Value {
+	return i.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the uint32. If not valid, a NULL-value is returned.
+func (i Uint32) MarshalJSON() ([]byte, error) {
+	return i.ToNumber().MarshalJSON()
+}
+
+// UnmarshalJSON as uint32 or sets Valid to false if null. If the value does not
+// fit into uint32, a RangeError or PrecisionError is returned.
+func (i *Uint32) UnmarshalJSON(data []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	*i = Uint32FromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the uint32 as decimal text. If not valid, empty text is
+// returned.
+func (i Uint32) MarshalText() ([]byte, error) {
+	return i.ToNumber().MarshalText()
+}
+
+// UnmarshalText as decimal uint32 or sets Valid to false if empty. If the value
+// does not fit into uint32, a RangeError or PrecisionError is returned.
+func (i *Uint32) UnmarshalText(text []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*i = Uint32FromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the uint32. If not valid, a NULL-value is returned.
+func (i Uint32) MarshalYAML() (any, error) {
+	return i.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as uint32 or sets Valid to false if null. If the value does not
+// fit into uint32, a RangeError or PrecisionError is returned.
+func (i *Uint32) UnmarshalYAML(node *yaml.Node) error {
+	n := i.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*i = Uint32FromNumber(n)
+	return nil
+}
+
+// Scan to uint32 value or not valid if nil. If the value does not fit into
+// uint32, a RangeError or PrecisionError is returned.
+func (i *Uint32) Scan(src any) error {
+	n := i.ToNumber()
+	err := n.Scan(src)
+	if err != nil {
+		return err
+	}
+	*i = Uint32FromNumber(n)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface.
+func (i Uint32) Value() (driver.Value, error) {
+	return i.ToNumber().Value()
+}
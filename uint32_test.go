@@ -0,0 +1,383 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNewUint32 tests NewUint32.
+func TestNewUint32(t *testing.T) {
+	i := NewUint32(16)
+	assert.True(t, i.Valid, "should be valid")
+	assert.EqualValues(t, 16, i.Uint32, "should contain correct value")
+}
+
+// TestUint32_Get tests Uint32.Get.
+func TestUint32_Get(t *testing.T) {
+	v, ok := NewUint32(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, uint32(16), v, "should return correct value")
+	_, ok = Uint32{Uint32: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestUint32_IsZero tests Uint32.IsZero.
+func TestUint32_IsZero(t *testing.T) {
+	assert.False(t, NewUint32(16).IsZero(), "should not be zero")
+	assert.True(t, Uint32{Uint32: 16}.IsZero(), "should be zero")
+}
+
+// TestUint32_Equal tests Uint32.Equal.
+func TestUint32_Equal(t *testing.T) {
+	assert.True(t, NewUint32(16).Equal(NewUint32(16)), "should be equal")
+	assert.False(t, NewUint32(16).Equal(NewUint32(17)), "should not be equal")
+	assert.False(t, NewUint32(16).Equal(Uint32{Uint32: 16}), "should not be equal")
+	assert.False(t, Uint32{Uint32: 16}.Equal(NewUint32(16)), "should not be equal")
+	assert.True(t, Uint32{Uint32: 16}.Equal(Uint32{}), "should be equal")
+}
+
+// TestUint32_String tests Uint32.String.
+func TestUint32_String(t *testing.T) {
+	assert.Equal(t, "16", NewUint32(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Uint32{Uint32: 16}.String(), "should return null token")
+}
+
+// Uint32FormatSuite tests Uint32.Format.
+type Uint32FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint32FormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Uint32{Uint32: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Uint32{Uint32: 16}), "should respect width")
+}
+
+func (suite *Uint32FormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewUint32(16)), "should return correct value")
+}
+
+func (suite *Uint32FormatSuite) TestPaddedDecimal() {
+	suite.Equal("00016", fmt.Sprintf("%05d", NewUint32(16)), "should return correct value")
+}
+
+func (suite *Uint32FormatSuite) TestHex() {
+	suite.Equal("10", fmt.Sprintf("%x", NewUint32(16)), "should return correct value")
+}
+
+func (suite *Uint32FormatSuite) TestGoSyntax() {
+	suite.Equal(NewUint32(16).GoString(), fmt.Sprintf("%#v", NewUint32(16)), "should use GoString")
+}
+
+func TestUint32_Format(t *testing.T) {
+	suite.Run(t, new(Uint32FormatSuite))
+}
+
+// TestUint32_GoString tests Uint32.GoString.
+func TestUint32_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewUint32(16)", NewUint32(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Uint32{}", Uint32{Uint32: 16}.GoString(), "should return correct value")
+}
+
+// TestUint32_LogValue tests Uint32.LogValue.
+func TestUint32_LogValue(t *testing.T) {
+	assert.Equal(t, uint64(16), NewUint32(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Uint32{Uint32: 16}.LogValue().Any(), "should return null value")
+}
+
+// TestUint32FromPtr tests Uint32FromPtr.
+func TestUint32FromPtr(t *testing.T) {
+	assert.False(t, Uint32FromPtr(nil).Valid, "should not be valid for nil")
+	zero := uint32(0)
+	assert.Equal(t, NewUint32(0), Uint32FromPtr(&zero), "should return correct value for zero")
+	v := uint32(16)
+	assert.Equal(t, NewUint32(16), Uint32FromPtr(&v), "should return correct value")
+}
+
+// TestUint32_Ptr tests Uint32.Ptr.
+func TestUint32_Ptr(t *testing.T) {
+	assert.Nil(t, Uint32{Uint32: 16}.Ptr(), "should return nil if not valid")
+	p := NewUint32(0).Ptr()
+	assert.Equal(t, uint32(0), *p, "should return correct value for zero")
+	p = NewUint32(16).Ptr()
+	assert.Equal(t, uint32(16), *p, "should return correct value")
+}
+
+// TestUint32FromSQLNull tests Uint32FromSQLNull.
+func TestUint32FromSQLNull(t *testing.T) {
+	assert.Equal(t, Uint32{}, Uint32FromSQLNull(sql.Null[uint32]{}), "should return correct value")
+	assert.Equal(t, NewUint32(16), Uint32FromSQLNull(sql.Null[uint32]{V: 16, Valid: true}), "should return correct value")
+}
+
+// TestUint32_ToSQLNull tests Uint32.ToSQLNull.
+func TestUint32_ToSQLNull(t *testing.T) {
+	assert.False(t, Uint32{Uint32: 16}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[uint32]{V: 16, Valid: true}, NewUint32(16).ToSQLNull(), "should return correct value")
+}
+
+// Uint32MarshalJSONSuite tests Uint32.MarshalJSON.
+type Uint32MarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint32MarshalJSONSuite) TestNotValid() {
+	i := Uint32{Uint32: 16}
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *Uint32MarshalJSONSuite) TestOK() {
+	i := NewUint32(16)
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(marshalMust(16), raw, "should return correct value")
+}
+
+func TestUint32_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(Uint32MarshalJSONSuite))
+}
+
+// Uint32UnmarshalJSONSuite tests Uint32.UnmarshalJSON.
+type Uint32UnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint32UnmarshalJSONSuite) TestNull() {
+	var i Uint32
+	err := json.Unmarshal(jsonNull, &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint32UnmarshalJSONSuite) TestNegative() {
+	var i Uint32
+	err := json.Unmarshal([]byte("-1"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint32UnmarshalJSONSuite) TestOutOfRange() {
+	var i Uint32
+	err := json.Unmarshal([]byte("4294967296"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint32UnmarshalJSONSuite) TestInvalid() {
+	var i Uint32
+	err := json.Unmarshal(marshalMust("meow"), &i)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Uint32UnmarshalJSONSuite) TestOK() {
+	var i Uint32
+	err := json.Unmarshal(marshalMust(16), &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint32, "should unmarshal correct value")
+}
+
+func TestUint32_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(Uint32UnmarshalJSONSuite))
+}
+
+// Uint32MarshalTextSuite tests Uint32.MarshalText.
+type Uint32MarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint32MarshalTextSuite) TestNotValid() {
+	text, err := Uint32{Uint32: 16}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *Uint32MarshalTextSuite) TestOK() {
+	text, err := NewUint32(16).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16", string(text), "should return correct value")
+}
+
+func TestUint32_MarshalText(t *testing.T) {
+	suite.Run(t, new(Uint32MarshalTextSuite))
+}
+
+// Uint32UnmarshalTextSuite tests Uint32.UnmarshalText.
+type Uint32UnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint32UnmarshalTextSuite) TestEmpty() {
+	i := NewUint32(16)
+	err := i.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint32UnmarshalTextSuite) TestNegative() {
+	var i Uint32
+	err := i.UnmarshalText([]byte("-1"))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint32UnmarshalTextSuite) TestOK() {
+	var i Uint32
+	err := i.UnmarshalText([]byte("16"))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint32, "should unmarshal correct value")
+}
+
+func TestUint32_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(Uint32UnmarshalTextSuite))
+}
+
+// Uint32MarshalYAMLSuite tests Uint32.MarshalYAML.
+type Uint32MarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint32MarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Uint32{Uint32: 16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *Uint32MarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewUint32(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16\n", string(raw), "should return correct value")
+}
+
+func TestUint32_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(Uint32MarshalYAMLSuite))
+}
+
+// Uint32UnmarshalYAMLSuite tests Uint32.UnmarshalYAML.
+type Uint32UnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint32UnmarshalYAMLSuite) TestNull() {
+	v := NewUint32(16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Uint32UnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Uint32 `yaml:"v"`
+		W Uint32 `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *Uint32UnmarshalYAMLSuite) TestOutOfRange() {
+	var v Uint32
+	err := yaml.Unmarshal([]byte(`4294967296`), &v)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint32UnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Uint32
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Uint32UnmarshalYAMLSuite) TestOK() {
+	var v Uint32
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(uint32(42), v.Uint32, "should unmarshal correct value")
+}
+
+func TestUint32_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(Uint32UnmarshalYAMLSuite))
+}
+
+// Uint32ScanSuite tests Uint32.Scan.
+type Uint32ScanSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint32ScanSuite) TestNull() {
+	i := NewUint32(16)
+	err := i.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint32ScanSuite) TestNegative() {
+	var i Uint32
+	err := i.Scan(int64(-1))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint32ScanSuite) TestOutOfRange() {
+	var i Uint32
+	err := i.Scan([]byte("4294967296"))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint32ScanSuite) TestOK() {
+	var i Uint32
+	err := i.Scan(int64(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint32, "should scan correct value")
+}
+
+func (suite *Uint32ScanSuite) TestString() {
+	var i Uint32
+	err := i.Scan("16")
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint32, "should scan correct value")
+}
+
+func TestUint32_Scan(t *testing.T) {
+	suite.Run(t, new(Uint32ScanSuite))
+}
+
+// Uint32ValueSuite tests Uint32.Value.
+type Uint32ValueSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint32ValueSuite) TestNull() {
+	raw, err := Uint32{Uint32: 16}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *Uint32ValueSuite) TestOK() {
+	raw, err := NewUint32(16).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(16), raw, "should return correct value")
+}
+
+func TestUint32_Value(t *testing.T) {
+	suite.Run(t, new(Uint32ValueSuite))
+}
+
+// TestUint32FromNumber tests Uint32FromNumber and Uint32.ToNumber.
+func TestUint32FromNumber(t *testing.T) {
+	assert.Equal(t, Uint32{}, Uint32FromNumber(Number[uint32]{}), "should return correct value")
+	assert.Equal(t, NewUint32(16), Uint32FromNumber(NewNumber[uint32](16)), "should return correct value")
+	assert.Equal(t, NewNumber[uint32](16), NewUint32(16).ToNumber(), "should return correct value")
+}
@@ -0,0 +1,202 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+)
+
+// Uint64 holds a nullable uint64.
+type Uint64 struct {
+	// Uint64 is the actual value when Valid.
+	Uint64 uint64 `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewUint64 returns a valid Uint64 with the given value.
+func NewUint64(i uint64) Uint64 {
+	return Uint64{
+		Uint64: i,
+		Valid:  true,
+	}
+}
+
+// Uint64FromPtr returns a Uint64 that is valid if the given pointer is not nil.
+func Uint64FromPtr(v *uint64) Uint64 {
+	if v == nil {
+		return Uint64{}
+	}
+	return NewUint64(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (i Uint64) Ptr() *uint64 {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Uint64
+	return &v
+}
+
+// Uint64FromSQLNull returns a Uint64 from the given sql.Null.
+func Uint64FromSQLNull(v sql.Null[uint64]) Uint64 {
+	return Uint64{
+		Uint64: v.V,
+		Valid:  v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (i Uint64) ToSQLNull() sql.Null[uint64] {
+	return sql.Null[uint64]{
+		V:     i.Uint64,
+		Valid: i.Valid,
+	}
+}
+
+// Uint64FromNumber returns an Uint64 from the given Number.
+func Uint64FromNumber(n Number[uint64]) Uint64 {
+	return Uint64{
+		Uint64: n.V,
+		Valid:  n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (i Uint64) ToNumber() Number[uint64] {
+	return Number[uint64]{
+		V:     i.Uint64,
+		Valid: i.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (i Uint64) Get() (uint64, bool) {
+	return i.Uint64, i.Valid
+}
+
+// IsNull returns true if not valid.
+func (i Uint64) IsNull() bool {
+	return !i.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (i *Uint64) Set(v uint64) {
+	*i = NewUint64(v)
+}
+
+// SetNull sets a NULL-value.
+func (i *Uint64) SetNull() {
+	*i = Uint64{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (i Uint64) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (i Uint64) Equal(other Uint64) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+	return i.Uint64 == other.Uint64
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (i Uint64) String() string {
+	return formatString(i.Uint64, i.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (i Uint64) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, i, i.Uint64, i.Valid)
+}
+
+// GoString returns a Go expression creating the Uint64.
+func (i Uint64) GoString() string {
+	if !i.Valid {
+		return "nulls.Uint64{}"
+	}
+	return fmt.Sprintf("nulls.NewUint64(%d)", i.Uint64)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (i Uint64) LogValue() slog.Value {
+	return i.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the uint64. If not valid, a NULL-value is returned.
+func (i Uint64) MarshalJSON() ([]byte, error) {
+	return i.ToNumber().MarshalJSON()
+}
+
+// UnmarshalJSON as uint64 or sets Valid to false if null. If the value does not
+// fit into uint64, a RangeError or PrecisionError is returned.
+func (i *Uint64) UnmarshalJSON(data []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	*i = Uint64FromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the uint64 as decimal text. If not valid, empty text is
+// returned.
+func (i Uint64) MarshalText() ([]byte, error) {
+	return i.ToNumber().MarshalText()
+}
+
+// UnmarshalText as decimal uint64 or sets Valid to false if empty. If the value
+// does not fit into uint64, a RangeError or PrecisionError is returned.
+func (i *Uint64) UnmarshalText(text []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*i = Uint64FromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the uint64. If not valid, a NULL-value is returned.
+func (i Uint64) MarshalYAML() (any, error) {
+	return i.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as uint64 or sets Valid to false if null. If the value does not
+// fit into uint64, a RangeError or PrecisionError is returned.
+func (i *Uint64) UnmarshalYAML(node *yaml.Node) error {
+	n := i.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*i = Uint64FromNumber(n)
+	return nil
+}
+
+// Scan to uint64 value or not valid if nil. If the value does not fit into
+// uint64, a RangeError or PrecisionError is returned.
+func (i *Uint64) Scan(src any) error {
+	n := i.ToNumber()
+	err := n.Scan(src)
+	if err != nil {
+		return err
+	}
+	*i = Uint64FromNumber(n)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. As driver
+// values are limited to int64, a RangeError is returned for larger values.
+func (i Uint64) Value() (driver.Value, error) {
+	return i.ToNumber().Value()
+}
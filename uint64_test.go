@@ -0,0 +1,389 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNewUint64 tests NewUint64.
+func TestNewUint64(t *testing.T) {
+	i := NewUint64(16)
+	assert.True(t, i.Valid, "should be valid")
+	assert.EqualValues(t, 16, i.Uint64, "should contain correct value")
+}
+
+// TestUint64_Get tests Uint64.Get.
+func TestUint64_Get(t *testing.T) {
+	v, ok := NewUint64(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, uint64(16), v, "should return correct value")
+	_, ok = Uint64{Uint64: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestUint64_IsZero tests Uint64.IsZero.
+func TestUint64_IsZero(t *testing.T) {
+	assert.False(t, NewUint64(16).IsZero(), "should not be zero")
+	assert.True(t, Uint64{Uint64: 16}.IsZero(), "should be zero")
+}
+
+// TestUint64_Equal tests Uint64.Equal.
+func TestUint64_Equal(t *testing.T) {
+	assert.True(t, NewUint64(16).Equal(NewUint64(16)), "should be equal")
+	assert.False(t, NewUint64(16).Equal(NewUint64(17)), "should not be equal")
+	assert.False(t, NewUint64(16).Equal(Uint64{Uint64: 16}), "should not be equal")
+	assert.False(t, Uint64{Uint64: 16}.Equal(NewUint64(16)), "should not be equal")
+	assert.True(t, Uint64{Uint64: 16}.Equal(Uint64{}), "should be equal")
+}
+
+// TestUint64_String tests Uint64.String.
+func TestUint64_String(t *testing.T) {
+	assert.Equal(t, "16", NewUint64(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Uint64{Uint64: 16}.String(), "should return null token")
+}
+
+// Uint64FormatSuite tests Uint64.Format.
+type Uint64FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint64FormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Uint64{Uint64: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Uint64{Uint64: 16}), "should respect width")
+}
+
+func (suite *Uint64FormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewUint64(16)), "should return correct value")
+}
+
+func (suite *Uint64FormatSuite) TestPaddedDecimal() {
+	suite.Equal("00016", fmt.Sprintf("%05d", NewUint64(16)), "should return correct value")
+}
+
+func (suite *Uint64FormatSuite) TestHex() {
+	suite.Equal("10", fmt.Sprintf("%x", NewUint64(16)), "should return correct value")
+}
+
+func (suite *Uint64FormatSuite) TestGoSyntax() {
+	suite.Equal(NewUint64(16).GoString(), fmt.Sprintf("%#v", NewUint64(16)), "should use GoString")
+}
+
+func TestUint64_Format(t *testing.T) {
+	suite.Run(t, new(Uint64FormatSuite))
+}
+
+// TestUint64_GoString tests Uint64.GoString.
+func TestUint64_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewUint64(16)", NewUint64(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Uint64{}", Uint64{Uint64: 16}.GoString(), "should return correct value")
+}
+
+// TestUint64_LogValue tests Uint64.LogValue.
+func TestUint64_LogValue(t *testing.T) {
+	assert.Equal(t, uint64(16), NewUint64(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Uint64{Uint64: 16}.LogValue().Any(), "should return null value")
+}
+
+// TestUint64FromPtr tests Uint64FromPtr.
+func TestUint64FromPtr(t *testing.T) {
+	assert.False(t, Uint64FromPtr(nil).Valid, "should not be valid for nil")
+	zero := uint64(0)
+	assert.Equal(t, NewUint64(0), Uint64FromPtr(&zero), "should return correct value for zero")
+	v := uint64(16)
+	assert.Equal(t, NewUint64(16), Uint64FromPtr(&v), "should return correct value")
+}
+
+// TestUint64_Ptr tests Uint64.Ptr.
+func TestUint64_Ptr(t *testing.T) {
+	assert.Nil(t, Uint64{Uint64: 16}.Ptr(), "should return nil if not valid")
+	p := NewUint64(0).Ptr()
+	assert.Equal(t, uint64(0), *p, "should return correct value for zero")
+	p = NewUint64(16).Ptr()
+	assert.Equal(t, uint64(16), *p, "should return correct value")
+}
+
+// TestUint64FromSQLNull tests Uint64FromSQLNull.
+func TestUint64FromSQLNull(t *testing.T) {
+	assert.Equal(t, Uint64{}, Uint64FromSQLNull(sql.Null[uint64]{}), "should return correct value")
+	assert.Equal(t, NewUint64(16), Uint64FromSQLNull(sql.Null[uint64]{V: 16, Valid: true}), "should return correct value")
+}
+
+// TestUint64_ToSQLNull tests Uint64.ToSQLNull.
+func TestUint64_ToSQLNull(t *testing.T) {
+	assert.False(t, Uint64{Uint64: 16}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[uint64]{V: 16, Valid: true}, NewUint64(16).ToSQLNull(), "should return correct value")
+}
+
+// Uint64MarshalJSONSuite tests Uint64.MarshalJSON.
+type Uint64MarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint64MarshalJSONSuite) TestNotValid() {
+	i := Uint64{Uint64: 16}
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *Uint64MarshalJSONSuite) TestOK() {
+	i := NewUint64(16)
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(marshalMust(16), raw, "should return correct value")
+}
+
+func TestUint64_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(Uint64MarshalJSONSuite))
+}
+
+// Uint64UnmarshalJSONSuite tests Uint64.UnmarshalJSON.
+type Uint64UnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint64UnmarshalJSONSuite) TestNull() {
+	var i Uint64
+	err := json.Unmarshal(jsonNull, &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint64UnmarshalJSONSuite) TestNegative() {
+	var i Uint64
+	err := json.Unmarshal([]byte("-1"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint64UnmarshalJSONSuite) TestOutOfRange() {
+	var i Uint64
+	err := json.Unmarshal([]byte("18446744073709551616"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint64UnmarshalJSONSuite) TestInvalid() {
+	var i Uint64
+	err := json.Unmarshal(marshalMust("meow"), &i)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Uint64UnmarshalJSONSuite) TestOK() {
+	var i Uint64
+	err := json.Unmarshal(marshalMust(16), &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint64, "should unmarshal correct value")
+}
+
+func TestUint64_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(Uint64UnmarshalJSONSuite))
+}
+
+// Uint64MarshalTextSuite tests Uint64.MarshalText.
+type Uint64MarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint64MarshalTextSuite) TestNotValid() {
+	text, err := Uint64{Uint64: 16}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *Uint64MarshalTextSuite) TestOK() {
+	text, err := NewUint64(16).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16", string(text), "should return correct value")
+}
+
+func TestUint64_MarshalText(t *testing.T) {
+	suite.Run(t, new(Uint64MarshalTextSuite))
+}
+
+// Uint64UnmarshalTextSuite tests Uint64.UnmarshalText.
+type Uint64UnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint64UnmarshalTextSuite) TestEmpty() {
+	i := NewUint64(16)
+	err := i.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint64UnmarshalTextSuite) TestNegative() {
+	var i Uint64
+	err := i.UnmarshalText([]byte("-1"))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint64UnmarshalTextSuite) TestOK() {
+	var i Uint64
+	err := i.UnmarshalText([]byte("16"))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint64, "should unmarshal correct value")
+}
+
+func TestUint64_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(Uint64UnmarshalTextSuite))
+}
+
+// Uint64MarshalYAMLSuite tests Uint64.MarshalYAML.
+type Uint64MarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint64MarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Uint64{Uint64: 16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *Uint64MarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewUint64(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16\n", string(raw), "should return correct value")
+}
+
+func TestUint64_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(Uint64MarshalYAMLSuite))
+}
+
+// Uint64UnmarshalYAMLSuite tests Uint64.UnmarshalYAML.
+type Uint64UnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint64UnmarshalYAMLSuite) TestNull() {
+	v := NewUint64(16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Uint64UnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Uint64 `yaml:"v"`
+		W Uint64 `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *Uint64UnmarshalYAMLSuite) TestOutOfRange() {
+	var v Uint64
+	err := yaml.Unmarshal([]byte(`-1`), &v)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint64UnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Uint64
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Uint64UnmarshalYAMLSuite) TestOK() {
+	var v Uint64
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(uint64(42), v.Uint64, "should unmarshal correct value")
+}
+
+func TestUint64_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(Uint64UnmarshalYAMLSuite))
+}
+
+// Uint64ScanSuite tests Uint64.Scan.
+type Uint64ScanSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint64ScanSuite) TestNull() {
+	i := NewUint64(16)
+	err := i.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint64ScanSuite) TestNegative() {
+	var i Uint64
+	err := i.Scan(int64(-1))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint64ScanSuite) TestOutOfRange() {
+	var i Uint64
+	err := i.Scan([]byte("18446744073709551616"))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint64ScanSuite) TestOK() {
+	var i Uint64
+	err := i.Scan(int64(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint64, "should scan correct value")
+}
+
+func (suite *Uint64ScanSuite) TestString() {
+	var i Uint64
+	err := i.Scan("16")
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint64, "should scan correct value")
+}
+
+func TestUint64_Scan(t *testing.T) {
+	suite.Run(t, new(Uint64ScanSuite))
+}
+
+// Uint64ValueSuite tests Uint64.Value.
+type Uint64ValueSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint64ValueSuite) TestNull() {
+	raw, err := Uint64{Uint64: 16}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *Uint64ValueSuite) TestOutOfRange() {
+	_, err := NewUint64(1 << 63).Value()
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint64ValueSuite) TestOK() {
+	raw, err := NewUint64(16).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(16), raw, "should return correct value")
+}
+
+func TestUint64_Value(t *testing.T) {
+	suite.Run(t, new(Uint64ValueSuite))
+}
+
+// TestUint64FromNumber tests Uint64FromNumber and Uint64.ToNumber.
+func TestUint64FromNumber(t *testing.T) {
+	assert.Equal(t, Uint64{}, Uint64FromNumber(Number[uint64]{}), "should return correct value")
+	assert.Equal(t, NewUint64(16), Uint64FromNumber(NewNumber[uint64](16)), "should return correct value")
+	assert.Equal(t, NewNumber[uint64](16), NewUint64(16).ToNumber(), "should return correct value")
+}
@@ -0,0 +1,217 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+)
+
+// Uint8 holds a nullable uint8.
+type Uint8 struct {
+	// Uint8 is the actual value when Valid.
+	Uint8 uint8 `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewUint8 returns a valid Uint8 with the given value.
+func NewUint8(i uint8) Uint8 {
+	return Uint8{
+		Uint8: i,
+		Valid: true,
+	}
+}
+
+// Uint8FromPtr returns a Uint8 that is valid if the given pointer is not nil.
+func Uint8FromPtr(v *uint8) Uint8 {
+	if v == nil {
+		return Uint8{}
+	}
+	return NewUint8(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (i Uint8) Ptr() *uint8 {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Uint8
+	return &v
+}
+
+// Uint8FromSQL returns a Uint8 from the given sql.NullByte.
+func Uint8FromSQL(v sql.NullByte) Uint8 {
+	return Uint8{
+		Uint8: v.Byte,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullByte representation.
+func (i Uint8) ToSQL() sql.NullByte {
+	return sql.NullByte{
+		Byte:  i.Uint8,
+		Valid: i.Valid,
+	}
+}
+
+// Uint8FromSQLNull returns a Uint8 from the given sql.Null.
+func Uint8FromSQLNull(v sql.Null[uint8]) Uint8 {
+	return Uint8{
+		Uint8: v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (i Uint8) ToSQLNull() sql.Null[uint8] {
+	return sql.Null[uint8]{
+		V:     i.Uint8,
+		Valid: i.Valid,
+	}
+}
+
+// Uint8FromNumber returns an Uint8 from the given Number.
+func Uint8FromNumber(n Number[uint8]) Uint8 {
+	return Uint8{
+		Uint8: n.V,
+		Valid: n.Valid,
+	}
+}
+
+// ToNumber returns the Number representation.
+func (i Uint8) ToNumber() Number[uint8] {
+	return Number[uint8]{
+		V:     i.Uint8,
+		Valid: i.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (i Uint8) Get() (uint8, bool) {
+	return i.Uint8, i.Valid
+}
+
+// IsNull returns true if not valid.
+func (i Uint8) IsNull() bool {
+	return !i.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (i *Uint8) Set(v uint8) {
+	*i = NewUint8(v)
+}
+
+// SetNull sets a NULL-value.
+func (i *Uint8) SetNull() {
+	*i = Uint8{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (i Uint8) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (i Uint8) Equal(other Uint8) bool {
+	if !i.Valid || !other.Valid {
+		return i.Valid == other.Valid
+	}
+	return i.Uint8 == other.Uint8
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (i Uint8) String() string {
+	return formatString(i.Uint8, i.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (i Uint8) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, i, i.Uint8, i.Valid)
+}
+
+// GoString returns a Go expression creating the Uint8.
+func (i Uint8) GoString() string {
+	if !i.Valid {
+		return "nulls.Uint8{}"
+	}
+	return fmt.Sprintf("nulls.NewUint8(%d)", i.Uint8)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (i Uint8) LogValue() slog.Value {
+	return i.ToNumber().LogValue()
+}
+
+// MarshalJSON marshals the uint8. If not valid, a NULL-value is returned.
+func (i Uint8) MarshalJSON() ([]byte, error) {
+	return i.ToNumber().MarshalJSON()
+}
+
+// UnmarshalJSON as uint8 or sets Valid to false if null. If the value does not
+// fit into uint8, a RangeError or PrecisionError is returned.
+func (i *Uint8) UnmarshalJSON(data []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	*i = Uint8FromNumber(n)
+	return nil
+}
+
+// MarshalText marshals the uint8 as decimal text. If not valid, empty text is
+// returned.
+func (i Uint8) MarshalText() ([]byte, error) {
+	return i.ToNumber().MarshalText()
+}
+
+// UnmarshalText as decimal uint8 or sets Valid to false if empty. If the value
+// does not fit into uint8, a RangeError or PrecisionError is returned.
+func (i *Uint8) UnmarshalText(text []byte) error {
+	n := i.ToNumber()
+	err := n.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	*i = Uint8FromNumber(n)
+	return nil
+}
+
+// MarshalYAML marshals the uint8. If not valid, a NULL-value is returned.
+func (i Uint8) MarshalYAML() (any, error) {
+	return i.ToNumber().MarshalYAML()
+}
+
+// UnmarshalYAML as uint8 or sets Valid to false if null. If the value does not
+// fit into uint8, a RangeError or PrecisionError is returned.
+func (i *Uint8) UnmarshalYAML(node *yaml.Node) error {
+	n := i.ToNumber()
+	err := n.UnmarshalYAML(node)
+	if err != nil {
+		return err
+	}
+	*i = Uint8FromNumber(n)
+	return nil
+}
+
+// Scan to uint8 value or not valid if nil. If the value does not fit into
+// uint8, a RangeError or PrecisionError is returned.
+func (i *Uint8) Scan(src any) error {
+	n := i.ToNumber()
+	err := n.Scan(src)
+	if err != nil {
+		return err
+	}
+	*i = Uint8FromNumber(n)
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface.
+func (i Uint8) Value() (driver.Value, error) {
+	return i.ToNumber().Value()
+}
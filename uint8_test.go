@@ -0,0 +1,395 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+// TestNewUint8 tests NewUint8.
+func TestNewUint8(t *testing.T) {
+	i := NewUint8(16)
+	assert.True(t, i.Valid, "should be valid")
+	assert.EqualValues(t, 16, i.Uint8, "should contain correct value")
+}
+
+// TestUint8_Get tests Uint8.Get.
+func TestUint8_Get(t *testing.T) {
+	v, ok := NewUint8(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, uint8(16), v, "should return correct value")
+	_, ok = Uint8{Uint8: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestUint8_IsZero tests Uint8.IsZero.
+func TestUint8_IsZero(t *testing.T) {
+	assert.False(t, NewUint8(16).IsZero(), "should not be zero")
+	assert.True(t, Uint8{Uint8: 16}.IsZero(), "should be zero")
+}
+
+// TestUint8_Equal tests Uint8.Equal.
+func TestUint8_Equal(t *testing.T) {
+	assert.True(t, NewUint8(16).Equal(NewUint8(16)), "should be equal")
+	assert.False(t, NewUint8(16).Equal(NewUint8(17)), "should not be equal")
+	assert.False(t, NewUint8(16).Equal(Uint8{Uint8: 16}), "should not be equal")
+	assert.False(t, Uint8{Uint8: 16}.Equal(NewUint8(16)), "should not be equal")
+	assert.True(t, Uint8{Uint8: 16}.Equal(Uint8{}), "should be equal")
+}
+
+// TestUint8_String tests Uint8.String.
+func TestUint8_String(t *testing.T) {
+	assert.Equal(t, "16", NewUint8(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Uint8{Uint8: 16}.String(), "should return null token")
+}
+
+// Uint8FormatSuite tests Uint8.Format.
+type Uint8FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint8FormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Uint8{Uint8: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Uint8{Uint8: 16}), "should respect width")
+}
+
+func (suite *Uint8FormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewUint8(16)), "should return correct value")
+}
+
+func (suite *Uint8FormatSuite) TestPaddedDecimal() {
+	suite.Equal("00016", fmt.Sprintf("%05d", NewUint8(16)), "should return correct value")
+}
+
+func (suite *Uint8FormatSuite) TestHex() {
+	suite.Equal("10", fmt.Sprintf("%x", NewUint8(16)), "should return correct value")
+}
+
+func (suite *Uint8FormatSuite) TestGoSyntax() {
+	suite.Equal(NewUint8(16).GoString(), fmt.Sprintf("%#v", NewUint8(16)), "should use GoString")
+}
+
+func TestUint8_Format(t *testing.T) {
+	suite.Run(t, new(Uint8FormatSuite))
+}
+
+// TestUint8_GoString tests Uint8.GoString.
+func TestUint8_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewUint8(16)", NewUint8(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Uint8{}", Uint8{Uint8: 16}.GoString(), "should return correct value")
+}
+
+// TestUint8_LogValue tests Uint8.LogValue.
+func TestUint8_LogValue(t *testing.T) {
+	assert.Equal(t, uint64(16), NewUint8(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Uint8{Uint8: 16}.LogValue().Any(), "should return null value")
+}
+
+// TestUint8FromPtr tests Uint8FromPtr.
+func TestUint8FromPtr(t *testing.T) {
+	assert.False(t, Uint8FromPtr(nil).Valid, "should not be valid for nil")
+	zero := uint8(0)
+	assert.Equal(t, NewUint8(0), Uint8FromPtr(&zero), "should return correct value for zero")
+	v := uint8(16)
+	assert.Equal(t, NewUint8(16), Uint8FromPtr(&v), "should return correct value")
+}
+
+// TestUint8_Ptr tests Uint8.Ptr.
+func TestUint8_Ptr(t *testing.T) {
+	assert.Nil(t, Uint8{Uint8: 16}.Ptr(), "should return nil if not valid")
+	p := NewUint8(0).Ptr()
+	assert.Equal(t, uint8(0), *p, "should return correct value for zero")
+	p = NewUint8(16).Ptr()
+	assert.Equal(t, uint8(16), *p, "should return correct value")
+}
+
+// TestUint8FromSQL tests Uint8FromSQL.
+func TestUint8FromSQL(t *testing.T) {
+	assert.Equal(t, Uint8{}, Uint8FromSQL(sql.NullByte{}), "should return correct value")
+	assert.Equal(t, NewUint8(16), Uint8FromSQL(sql.NullByte{Byte: 16, Valid: true}), "should return correct value")
+}
+
+// TestUint8_ToSQL tests Uint8.ToSQL.
+func TestUint8_ToSQL(t *testing.T) {
+	assert.False(t, Uint8{Uint8: 16}.ToSQL().Valid, "should not be valid")
+	assert.Equal(t, sql.NullByte{Byte: 16, Valid: true}, NewUint8(16).ToSQL(), "should return correct value")
+}
+
+// TestUint8FromSQLNull tests Uint8FromSQLNull.
+func TestUint8FromSQLNull(t *testing.T) {
+	assert.Equal(t, Uint8{}, Uint8FromSQLNull(sql.Null[uint8]{}), "should return correct value")
+	assert.Equal(t, NewUint8(16), Uint8FromSQLNull(sql.Null[uint8]{V: 16, Valid: true}), "should return correct value")
+}
+
+// TestUint8_ToSQLNull tests Uint8.ToSQLNull.
+func TestUint8_ToSQLNull(t *testing.T) {
+	assert.False(t, Uint8{Uint8: 16}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[uint8]{V: 16, Valid: true}, NewUint8(16).ToSQLNull(), "should return correct value")
+}
+
+// Uint8MarshalJSONSuite tests Uint8.MarshalJSON.
+type Uint8MarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint8MarshalJSONSuite) TestNotValid() {
+	i := Uint8{Uint8: 16}
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *Uint8MarshalJSONSuite) TestOK() {
+	i := NewUint8(16)
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(marshalMust(16), raw, "should return correct value")
+}
+
+func TestUint8_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(Uint8MarshalJSONSuite))
+}
+
+// Uint8UnmarshalJSONSuite tests Uint8.UnmarshalJSON.
+type Uint8UnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint8UnmarshalJSONSuite) TestNull() {
+	var i Uint8
+	err := json.Unmarshal(jsonNull, &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint8UnmarshalJSONSuite) TestNegative() {
+	var i Uint8
+	err := json.Unmarshal([]byte("-1"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint8UnmarshalJSONSuite) TestOutOfRange() {
+	var i Uint8
+	err := json.Unmarshal([]byte("256"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint8UnmarshalJSONSuite) TestInvalid() {
+	var i Uint8
+	err := json.Unmarshal(marshalMust("meow"), &i)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Uint8UnmarshalJSONSuite) TestOK() {
+	var i Uint8
+	err := json.Unmarshal(marshalMust(16), &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint8, "should unmarshal correct value")
+}
+
+func TestUint8_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(Uint8UnmarshalJSONSuite))
+}
+
+// Uint8MarshalTextSuite tests Uint8.MarshalText.
+type Uint8MarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint8MarshalTextSuite) TestNotValid() {
+	text, err := Uint8{Uint8: 16}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *Uint8MarshalTextSuite) TestOK() {
+	text, err := NewUint8(16).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16", string(text), "should return correct value")
+}
+
+func TestUint8_MarshalText(t *testing.T) {
+	suite.Run(t, new(Uint8MarshalTextSuite))
+}
+
+// Uint8UnmarshalTextSuite tests Uint8.UnmarshalText.
+type Uint8UnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint8UnmarshalTextSuite) TestEmpty() {
+	i := NewUint8(16)
+	err := i.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint8UnmarshalTextSuite) TestNegative() {
+	var i Uint8
+	err := i.UnmarshalText([]byte("-1"))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint8UnmarshalTextSuite) TestOK() {
+	var i Uint8
+	err := i.UnmarshalText([]byte("16"))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint8, "should unmarshal correct value")
+}
+
+func TestUint8_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(Uint8UnmarshalTextSuite))
+}
+
+// Uint8MarshalYAMLSuite tests Uint8.MarshalYAML.
+type Uint8MarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint8MarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Uint8{Uint8: 16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *Uint8MarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewUint8(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16\n", string(raw), "should return correct value")
+}
+
+func TestUint8_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(Uint8MarshalYAMLSuite))
+}
+
+// Uint8UnmarshalYAMLSuite tests Uint8.UnmarshalYAML.
+type Uint8UnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint8UnmarshalYAMLSuite) TestNull() {
+	v := NewUint8(16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *Uint8UnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Uint8 `yaml:"v"`
+		W Uint8 `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *Uint8UnmarshalYAMLSuite) TestOutOfRange() {
+	var v Uint8
+	err := yaml.Unmarshal([]byte(`256`), &v)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint8UnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Uint8
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *Uint8UnmarshalYAMLSuite) TestOK() {
+	var v Uint8
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(uint8(42), v.Uint8, "should unmarshal correct value")
+}
+
+func TestUint8_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(Uint8UnmarshalYAMLSuite))
+}
+
+// Uint8ScanSuite tests Uint8.Scan.
+type Uint8ScanSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint8ScanSuite) TestNull() {
+	i := NewUint8(16)
+	err := i.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *Uint8ScanSuite) TestNegative() {
+	var i Uint8
+	err := i.Scan(int64(-1))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint8ScanSuite) TestOutOfRange() {
+	var i Uint8
+	err := i.Scan([]byte("256"))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *Uint8ScanSuite) TestOK() {
+	var i Uint8
+	err := i.Scan(int64(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint8, "should scan correct value")
+}
+
+func (suite *Uint8ScanSuite) TestString() {
+	var i Uint8
+	err := i.Scan("16")
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint8, "should scan correct value")
+}
+
+func TestUint8_Scan(t *testing.T) {
+	suite.Run(t, new(Uint8ScanSuite))
+}
+
+// Uint8ValueSuite tests Uint8.Value.
+type Uint8ValueSuite struct {
+	suite.Suite
+}
+
+func (suite *Uint8ValueSuite) TestNull() {
+	raw, err := Uint8{Uint8: 16}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *Uint8ValueSuite) TestOK() {
+	raw, err := NewUint8(16).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(16), raw, "should return correct value")
+}
+
+func TestUint8_Value(t *testing.T) {
+	suite.Run(t, new(Uint8ValueSuite))
+}
+
+// TestUint8FromNumber tests Uint8FromNumber and Uint8.ToNumber.
+func TestUint8FromNumber(t *testing.T) {
+	assert.Equal(t, Uint8{}, Uint8FromNumber(Number[uint8]{}), "should return correct value")
+	assert.Equal(t, NewUint8(16), Uint8FromNumber(NewNumber[uint8](16)), "should return correct value")
+	assert.Equal(t, NewNumber[uint8](16), NewUint8(16).ToNumber(), "should return correct value")
+}
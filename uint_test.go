@@ -0,0 +1,393 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"strconv"
+	"testing"
+)
+
+// TestNewUint tests NewUint.
+func TestNewUint(t *testing.T) {
+	i := NewUint(16)
+	assert.True(t, i.Valid, "should be valid")
+	assert.EqualValues(t, 16, i.Uint, "should contain correct value")
+}
+
+// TestUint_Get tests Uint.Get.
+func TestUint_Get(t *testing.T) {
+	v, ok := NewUint(16).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, uint(16), v, "should return correct value")
+	_, ok = Uint{Uint: 16}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestUint_IsZero tests Uint.IsZero.
+func TestUint_IsZero(t *testing.T) {
+	assert.False(t, NewUint(16).IsZero(), "should not be zero")
+	assert.True(t, Uint{Uint: 16}.IsZero(), "should be zero")
+}
+
+// TestUint_Equal tests Uint.Equal.
+func TestUint_Equal(t *testing.T) {
+	assert.True(t, NewUint(16).Equal(NewUint(16)), "should be equal")
+	assert.False(t, NewUint(16).Equal(NewUint(17)), "should not be equal")
+	assert.False(t, NewUint(16).Equal(Uint{Uint: 16}), "should not be equal")
+	assert.False(t, Uint{Uint: 16}.Equal(NewUint(16)), "should not be equal")
+	assert.True(t, Uint{Uint: 16}.Equal(Uint{}), "should be equal")
+}
+
+// TestUint_String tests Uint.String.
+func TestUint_String(t *testing.T) {
+	assert.Equal(t, "16", NewUint(16).String(), "should return correct value")
+	assert.Equal(t, NullToken, Uint{Uint: 16}.String(), "should return null token")
+}
+
+// UintFormatSuite tests Uint.Format.
+type UintFormatSuite struct {
+	suite.Suite
+}
+
+func (suite *UintFormatSuite) TestNotValid() {
+	suite.Equal(NullToken, fmt.Sprintf("%v", Uint{Uint: 16}), "should return null token")
+	suite.Equal("  "+NullToken, fmt.Sprintf("%8v", Uint{Uint: 16}), "should respect width")
+}
+
+func (suite *UintFormatSuite) TestValue() {
+	suite.Equal("16", fmt.Sprintf("%v", NewUint(16)), "should return correct value")
+}
+
+func (suite *UintFormatSuite) TestPaddedDecimal() {
+	suite.Equal("00016", fmt.Sprintf("%05d", NewUint(16)), "should return correct value")
+}
+
+func (suite *UintFormatSuite) TestHex() {
+	suite.Equal("10", fmt.Sprintf("%x", NewUint(16)), "should return correct value")
+}
+
+func (suite *UintFormatSuite) TestGoSyntax() {
+	suite.Equal(NewUint(16).GoString(), fmt.Sprintf("%#v", NewUint(16)), "should use GoString")
+}
+
+func TestUint_Format(t *testing.T) {
+	suite.Run(t, new(UintFormatSuite))
+}
+
+// TestUint_GoString tests Uint.GoString.
+func TestUint_GoString(t *testing.T) {
+	assert.Equal(t, "nulls.NewUint(16)", NewUint(16).GoString(), "should return correct value")
+	assert.Equal(t, "nulls.Uint{}", Uint{Uint: 16}.GoString(), "should return correct value")
+}
+
+// TestUint_LogValue tests Uint.LogValue.
+func TestUint_LogValue(t *testing.T) {
+	assert.Equal(t, uint64(16), NewUint(16).LogValue().Any(), "should return correct value")
+	assert.Nil(t, Uint{Uint: 16}.LogValue().Any(), "should return null value")
+}
+
+// TestUintFromPtr tests UintFromPtr.
+func TestUintFromPtr(t *testing.T) {
+	assert.False(t, UintFromPtr(nil).Valid, "should not be valid for nil")
+	zero := uint(0)
+	assert.Equal(t, NewUint(0), UintFromPtr(&zero), "should return correct value for zero")
+	v := uint(16)
+	assert.Equal(t, NewUint(16), UintFromPtr(&v), "should return correct value")
+}
+
+// TestUint_Ptr tests Uint.Ptr.
+func TestUint_Ptr(t *testing.T) {
+	assert.Nil(t, Uint{Uint: 16}.Ptr(), "should return nil if not valid")
+	p := NewUint(0).Ptr()
+	assert.Equal(t, uint(0), *p, "should return correct value for zero")
+	p = NewUint(16).Ptr()
+	assert.Equal(t, uint(16), *p, "should return correct value")
+}
+
+// TestUintFromSQLNull tests UintFromSQLNull.
+func TestUintFromSQLNull(t *testing.T) {
+	assert.Equal(t, Uint{}, UintFromSQLNull(sql.Null[uint]{}), "should return correct value")
+	assert.Equal(t, NewUint(16), UintFromSQLNull(sql.Null[uint]{V: 16, Valid: true}), "should return correct value")
+}
+
+// TestUint_ToSQLNull tests Uint.ToSQLNull.
+func TestUint_ToSQLNull(t *testing.T) {
+	assert.False(t, Uint{Uint: 16}.ToSQLNull().Valid, "should not be valid")
+	assert.Equal(t, sql.Null[uint]{V: 16, Valid: true}, NewUint(16).ToSQLNull(), "should return correct value")
+}
+
+// UintMarshalJSONSuite tests Uint.MarshalJSON.
+type UintMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *UintMarshalJSONSuite) TestNotValid() {
+	i := Uint{Uint: 16}
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *UintMarshalJSONSuite) TestOK() {
+	i := NewUint(16)
+	raw, err := json.Marshal(i)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(marshalMust(16), raw, "should return correct value")
+}
+
+func TestUint_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(UintMarshalJSONSuite))
+}
+
+// UintUnmarshalJSONSuite tests Uint.UnmarshalJSON.
+type UintUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *UintUnmarshalJSONSuite) TestNull() {
+	var i Uint
+	err := json.Unmarshal(jsonNull, &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *UintUnmarshalJSONSuite) TestNegative() {
+	var i Uint
+	err := json.Unmarshal([]byte("-1"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *UintUnmarshalJSONSuite) TestOutOfRange() {
+	var i Uint
+	err := json.Unmarshal([]byte("18446744073709551616"), &i)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *UintUnmarshalJSONSuite) TestInvalid() {
+	var i Uint
+	err := json.Unmarshal(marshalMust("meow"), &i)
+	suite.Error(err, "should fail")
+}
+
+func (suite *UintUnmarshalJSONSuite) TestOK() {
+	var i Uint
+	err := json.Unmarshal(marshalMust(16), &i)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint, "should unmarshal correct value")
+}
+
+func TestUint_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(UintUnmarshalJSONSuite))
+}
+
+// UintMarshalTextSuite tests Uint.MarshalText.
+type UintMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *UintMarshalTextSuite) TestNotValid() {
+	text, err := Uint{Uint: 16}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *UintMarshalTextSuite) TestOK() {
+	text, err := NewUint(16).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16", string(text), "should return correct value")
+}
+
+func TestUint_MarshalText(t *testing.T) {
+	suite.Run(t, new(UintMarshalTextSuite))
+}
+
+// UintUnmarshalTextSuite tests Uint.UnmarshalText.
+type UintUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *UintUnmarshalTextSuite) TestEmpty() {
+	i := NewUint(16)
+	err := i.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *UintUnmarshalTextSuite) TestNegative() {
+	var i Uint
+	err := i.UnmarshalText([]byte("-1"))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *UintUnmarshalTextSuite) TestOK() {
+	var i Uint
+	err := i.UnmarshalText([]byte("16"))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint, "should unmarshal correct value")
+}
+
+func TestUint_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(UintUnmarshalTextSuite))
+}
+
+// UintMarshalYAMLSuite tests Uint.MarshalYAML.
+type UintMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *UintMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(Uint{Uint: 16})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *UintMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewUint(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("16\n", string(raw), "should return correct value")
+}
+
+func TestUint_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(UintMarshalYAMLSuite))
+}
+
+// UintUnmarshalYAMLSuite tests Uint.UnmarshalYAML.
+type UintUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *UintUnmarshalYAMLSuite) TestNull() {
+	v := NewUint(16)
+	err := v.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(v.Valid, "should not be valid")
+}
+
+func (suite *UintUnmarshalYAMLSuite) TestAbsent() {
+	var s struct {
+		V Uint `yaml:"v"`
+		W Uint `yaml:"w"`
+	}
+	err := yaml.Unmarshal([]byte("v: ~\n"), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.V.Valid, "should not be valid")
+	suite.False(s.W.Valid, "should not be valid")
+}
+
+func (suite *UintUnmarshalYAMLSuite) TestOutOfRange() {
+	var v Uint
+	err := yaml.Unmarshal([]byte(`-1`), &v)
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *UintUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var v Uint
+	err := yaml.Unmarshal([]byte(`meow`), &v)
+	suite.Error(err, "should fail")
+}
+
+func (suite *UintUnmarshalYAMLSuite) TestOK() {
+	var v Uint
+	err := yaml.Unmarshal([]byte(`42`), &v)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(v.Valid, "should be valid")
+	suite.Equal(uint(42), v.Uint, "should unmarshal correct value")
+}
+
+func TestUint_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(UintUnmarshalYAMLSuite))
+}
+
+// UintScanSuite tests Uint.Scan.
+type UintScanSuite struct {
+	suite.Suite
+}
+
+func (suite *UintScanSuite) TestNull() {
+	i := NewUint(16)
+	err := i.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(i.Valid, "should not be valid")
+}
+
+func (suite *UintScanSuite) TestNegative() {
+	var i Uint
+	err := i.Scan(int64(-1))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *UintScanSuite) TestOutOfRange() {
+	var i Uint
+	err := i.Scan([]byte("18446744073709551616"))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *UintScanSuite) TestOK() {
+	var i Uint
+	err := i.Scan(int64(16))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint, "should scan correct value")
+}
+
+func (suite *UintScanSuite) TestString() {
+	var i Uint
+	err := i.Scan("16")
+	suite.Require().NoError(err, "should not fail")
+	suite.True(i.Valid, "should be valid")
+	suite.EqualValues(16, i.Uint, "should scan correct value")
+}
+
+func TestUint_Scan(t *testing.T) {
+	suite.Run(t, new(UintScanSuite))
+}
+
+// UintValueSuite tests Uint.Value.
+type UintValueSuite struct {
+	suite.Suite
+}
+
+func (suite *UintValueSuite) TestNull() {
+	raw, err := Uint{Uint: 16}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *UintValueSuite) TestOutOfRange() {
+	if strconv.IntSize < 64 {
+		suite.T().Skip("uint always fits into int64")
+	}
+	_, err := NewUint(^uint(0)).Value()
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *UintValueSuite) TestOK() {
+	raw, err := NewUint(16).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(16), raw, "should return correct value")
+}
+
+func TestUint_Value(t *testing.T) {
+	suite.Run(t, new(UintValueSuite))
+}
+
+// TestUintFromNumber tests UintFromNumber and Uint.ToNumber.
+func TestUintFromNumber(t *testing.T) {
+	assert.Equal(t, Uint{}, UintFromNumber(Number[uint]{}), "should return correct value")
+	assert.Equal(t, NewUint(16), UintFromNumber(NewNumber[uint](16)), "should return correct value")
+	assert.Equal(t, NewNumber[uint](16), NewUint(16).ToNumber(), "should return correct value")
+}
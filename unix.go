@@ -0,0 +1,51 @@
+package nulls
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// unmarshalJSONUnix unmarshals the given JSON number or string holding a
+// decimal integer as used for unix timestamps.
+func unmarshalJSONUnix(data []byte) (int64, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return 0, err
+		}
+		return parseNumber[int64](s)
+	}
+	return unmarshalJSONNumber[int64](data)
+}
+
+// scanUnix scans the given source as unix timestamp using fromUnix for
+// converting integers. Integers are accepted as numbers or decimal strings and
+// time.Time values are returned as is. The returned boolean is false if the
+// source is nil.
+func scanUnix(src any, fromUnix func(int64) time.Time) (time.Time, bool, error) {
+	if t, ok := src.(time.Time); ok {
+		return t, true, nil
+	}
+	v, valid, err := scanNumber[int64](src)
+	if err != nil || !valid {
+		return time.Time{}, valid, err
+	}
+	return fromUnix(v), true, nil
+}
+
+// unixSeconds returns the UTC time for the given unix seconds.
+func unixSeconds(v int64) time.Time {
+	return time.Unix(v, 0).UTC()
+}
+
+// unixMilliseconds returns the UTC time for the given unix milliseconds.
+func unixMilliseconds(v int64) time.Time {
+	return time.UnixMilli(v).UTC()
+}
+
+// formatUnix formats the given unix timestamp as decimal text.
+func formatUnix(v int64) []byte {
+	return []byte(strconv.FormatInt(v, 10))
+}
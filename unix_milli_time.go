@@ -0,0 +1,243 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"time"
+)
+
+// UnixMilliTime holds a nullable time.Time that is represented as unix
+// timestamp in milliseconds. Sub-millisecond precision is lost when
+// marshalling. Unmarshalled times are in UTC.
+type UnixMilliTime struct {
+	// Time is the actual value when Valid.
+	Time time.Time `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewUnixMilliTime returns a valid UnixMilliTime with the given value.
+func NewUnixMilliTime(t time.Time) UnixMilliTime {
+	return UnixMilliTime{
+		Time:  t,
+		Valid: true,
+	}
+}
+
+// UnixMilliTimeFromPtr returns a UnixMilliTime that is valid if the given
+// pointer is not nil.
+func UnixMilliTimeFromPtr(v *time.Time) UnixMilliTime {
+	if v == nil {
+		return UnixMilliTime{}
+	}
+	return NewUnixMilliTime(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (t UnixMilliTime) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
+// UnixMilliTimeFromSQL returns a UnixMilliTime from the given sql.NullTime.
+func UnixMilliTimeFromSQL(v sql.NullTime) UnixMilliTime {
+	return UnixMilliTime{
+		Time:  v.Time,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullTime representation.
+func (t UnixMilliTime) ToSQL() sql.NullTime {
+	return sql.NullTime{
+		Time:  t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// UnixMilliTimeFromSQLNull returns a UnixMilliTime from the given sql.Null.
+func UnixMilliTimeFromSQLNull(v sql.Null[time.Time]) UnixMilliTime {
+	return UnixMilliTime{
+		Time:  v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (t UnixMilliTime) ToSQLNull() sql.Null[time.Time] {
+	return sql.Null[time.Time]{
+		V:     t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (t UnixMilliTime) Get() (time.Time, bool) {
+	return t.Time, t.Valid
+}
+
+// IsNull returns true if not valid.
+func (t UnixMilliTime) IsNull() bool {
+	return !t.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (t *UnixMilliTime) Set(v time.Time) {
+	*t = NewUnixMilliTime(v)
+}
+
+// SetNull sets a NULL-value.
+func (t *UnixMilliTime) SetNull() {
+	*t = UnixMilliTime{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (t UnixMilliTime) IsZero() bool {
+	return !t.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+// Times are compared using time.Time.Equal.
+func (t UnixMilliTime) Equal(other UnixMilliTime) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+	return t.Time.Equal(other.Time)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (t UnixMilliTime) String() string {
+	return formatString(t.Time, t.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (t UnixMilliTime) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, t, t.Time, t.Valid)
+}
+
+// GoString returns a Go expression creating the UnixMilliTime.
+func (t UnixMilliTime) GoString() string {
+	if !t.Valid {
+		return "nulls.UnixMilliTime{}"
+	}
+	return fmt.Sprintf("nulls.NewUnixMilliTime(%#v)", t.Time)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (t UnixMilliTime) LogValue() slog.Value {
+	if !t.Valid {
+		return logNullValue()
+	}
+	return slog.TimeValue(t.Time)
+}
+
+// MarshalJSON marshals the time as unix milliseconds. If not valid, a
+// NULL-value is returned.
+func (t UnixMilliTime) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(t.Time.UnixMilli())
+}
+
+// UnmarshalJSON as unix milliseconds or sets Valid to false if null. The
+// milliseconds may be given as number or string.
+func (t *UnixMilliTime) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		t.SetNull()
+		return nil
+	}
+	v, err := unmarshalJSONUnix(data)
+	if err != nil {
+		return err
+	}
+	t.Valid = true
+	t.Time = unixMilliseconds(v)
+	return nil
+}
+
+// MarshalText marshals the time as decimal unix milliseconds. If not valid,
+// empty text is returned.
+func (t UnixMilliTime) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return formatUnix(t.Time.UnixMilli()), nil
+}
+
+// UnmarshalText as decimal unix milliseconds or sets Valid to false if empty.
+func (t *UnixMilliTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		t.SetNull()
+		return nil
+	}
+	v, err := parseNumber[int64](string(text))
+	if err != nil {
+		return err
+	}
+	t.Valid = true
+	t.Time = unixMilliseconds(v)
+	return nil
+}
+
+// MarshalYAML marshals the time as unix milliseconds. If not valid, a
+// NULL-value is returned.
+func (t UnixMilliTime) MarshalYAML() (any, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.UnixMilli(), nil
+}
+
+// UnmarshalYAML as unix milliseconds or sets Valid to false if null.
+func (t *UnixMilliTime) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		t.SetNull()
+		return nil
+	}
+	v, err := unmarshalYAMLNumber[int64](node)
+	if err != nil {
+		return err
+	}
+	t.Valid = true
+	t.Time = unixMilliseconds(v)
+	return nil
+}
+
+// Scan to time.Time value or not valid if nil. Integers and decimal strings are
+// scanned as unix milliseconds, and time.Time values are accepted as well.
+func (t *UnixMilliTime) Scan(src any) error {
+	v, valid, err := scanUnix(src, unixMilliseconds)
+	if err != nil {
+		return err
+	}
+	t.Valid = valid
+	t.Time = v
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The time
+// is returned as unix milliseconds.
+func (t UnixMilliTime) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.UnixMilli(), nil
+}
+
+// UTC returns the UTC time.
+func (t UnixMilliTime) UTC() UnixMilliTime {
+	return UnixMilliTime{
+		Time:  t.Time.UTC(),
+		Valid: t.Valid,
+	}
+}
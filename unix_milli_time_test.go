@@ -0,0 +1,317 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+	"time"
+)
+
+// testUnixMilliTime is 2022-07-01T10:30:00.123Z with 1656671400123 unix
+// milliseconds.
+var testUnixMilliTime = time.Date(2022, 7, 1, 10, 30, 0, 123000000, time.UTC)
+
+// TestNewUnixMilliTime tests NewUnixMilliTime.
+func TestNewUnixMilliTime(t *testing.T) {
+	tt := NewUnixMilliTime(testUnixMilliTime)
+	assert.True(t, tt.Valid, "should be valid")
+	assert.Equal(t, testUnixMilliTime, tt.Time, "should have set correct value")
+}
+
+// UnixMilliTimeMarshalJSONSuite tests UnixMilliTime.MarshalJSON.
+type UnixMilliTimeMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixMilliTimeMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(UnixMilliTime{Time: testUnixMilliTime})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *UnixMilliTimeMarshalJSONSuite) TestOK() {
+	raw, err := json.Marshal(NewUnixMilliTime(testUnixMilliTime.Add(500 * time.Microsecond)))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`1656671400123`, string(raw), "should return correct value")
+}
+
+func TestUnixMilliTime_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(UnixMilliTimeMarshalJSONSuite))
+}
+
+// UnixMilliTimeUnmarshalJSONSuite tests UnixMilliTime.UnmarshalJSON.
+type UnixMilliTimeUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixMilliTimeUnmarshalJSONSuite) TestNull() {
+	tt := NewUnixMilliTime(testUnixMilliTime)
+	err := json.Unmarshal(jsonNull, &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *UnixMilliTimeUnmarshalJSONSuite) TestUnmarshalFail() {
+	var tt UnixMilliTime
+	err := json.Unmarshal(marshalMust("meow"), &tt)
+	suite.Error(err, "should fail")
+	err = json.Unmarshal([]byte(`1.5`), &tt)
+	suite.Error(err, "should fail")
+}
+
+func (suite *UnixMilliTimeUnmarshalJSONSuite) TestNumber() {
+	var tt UnixMilliTime
+	err := json.Unmarshal([]byte(`1656671400123`), &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testUnixMilliTime, tt.Time, "should unmarshal correct value")
+}
+
+func (suite *UnixMilliTimeUnmarshalJSONSuite) TestString() {
+	var tt UnixMilliTime
+	err := json.Unmarshal([]byte(`"1656671400123"`), &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testUnixMilliTime, tt.Time, "should unmarshal correct value")
+}
+
+func TestUnixMilliTime_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(UnixMilliTimeUnmarshalJSONSuite))
+}
+
+// UnixMilliTimeMarshalTextSuite tests UnixMilliTime.MarshalText.
+type UnixMilliTimeMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixMilliTimeMarshalTextSuite) TestNotValid() {
+	text, err := UnixMilliTime{Time: testUnixMilliTime}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *UnixMilliTimeMarshalTextSuite) TestOK() {
+	text, err := NewUnixMilliTime(testUnixMilliTime).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`1656671400123`, string(text), "should return correct value")
+}
+
+func TestUnixMilliTime_MarshalText(t *testing.T) {
+	suite.Run(t, new(UnixMilliTimeMarshalTextSuite))
+}
+
+// UnixMilliTimeUnmarshalTextSuite tests UnixMilliTime.UnmarshalText.
+type UnixMilliTimeUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixMilliTimeUnmarshalTextSuite) TestEmpty() {
+	tt := NewUnixMilliTime(testUnixMilliTime)
+	err := tt.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *UnixMilliTimeUnmarshalTextSuite) TestUnmarshalFail() {
+	var tt UnixMilliTime
+	err := tt.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *UnixMilliTimeUnmarshalTextSuite) TestOK() {
+	var tt UnixMilliTime
+	err := tt.UnmarshalText([]byte(`1656671400123`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testUnixMilliTime, tt.Time, "should unmarshal correct value")
+}
+
+func TestUnixMilliTime_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(UnixMilliTimeUnmarshalTextSuite))
+}
+
+// UnixMilliTimeMarshalYAMLSuite tests UnixMilliTime.MarshalYAML.
+type UnixMilliTimeMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixMilliTimeMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(UnixMilliTime{Time: testUnixMilliTime})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *UnixMilliTimeMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewUnixMilliTime(testUnixMilliTime))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("1656671400123\n", string(raw), "should return correct value")
+}
+
+func TestUnixMilliTime_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(UnixMilliTimeMarshalYAMLSuite))
+}
+
+// UnixMilliTimeUnmarshalYAMLSuite tests UnixMilliTime.UnmarshalYAML.
+type UnixMilliTimeUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixMilliTimeUnmarshalYAMLSuite) TestNull() {
+	tt := NewUnixMilliTime(testUnixMilliTime)
+	err := tt.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *UnixMilliTimeUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var tt UnixMilliTime
+	err := yaml.Unmarshal([]byte(`meow`), &tt)
+	suite.Error(err, "should fail")
+}
+
+func (suite *UnixMilliTimeUnmarshalYAMLSuite) TestOK() {
+	var tt UnixMilliTime
+	err := yaml.Unmarshal([]byte(`1656671400123`), &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testUnixMilliTime, tt.Time, "should unmarshal correct value")
+}
+
+func TestUnixMilliTime_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(UnixMilliTimeUnmarshalYAMLSuite))
+}
+
+// UnixMilliTimeScanSuite tests UnixMilliTime.Scan.
+type UnixMilliTimeScanSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixMilliTimeScanSuite) TestNull() {
+	tt := NewUnixMilliTime(testUnixMilliTime)
+	err := tt.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *UnixMilliTimeScanSuite) TestUnsupported() {
+	var tt UnixMilliTime
+	err := tt.Scan(true)
+	suite.Error(err, "should fail")
+}
+
+func (suite *UnixMilliTimeScanSuite) TestInvalidString() {
+	var tt UnixMilliTime
+	err := tt.Scan("meow")
+	suite.Error(err, "should fail")
+}
+
+func (suite *UnixMilliTimeScanSuite) TestOutOfRange() {
+	var tt UnixMilliTime
+	err := tt.Scan(uint64(1 << 63))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *UnixMilliTimeScanSuite) TestOK() {
+	for _, src := range []any{int64(1656671400123), "1656671400123", []byte("1656671400123"), testUnixMilliTime} {
+		var tt UnixMilliTime
+		err := tt.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %T", src)
+		suite.Truef(tt.Valid, "should be valid for %T", src)
+		suite.Equalf(testUnixMilliTime, tt.Time, "should scan correct value for %T", src)
+	}
+}
+
+func TestUnixMilliTime_Scan(t *testing.T) {
+	suite.Run(t, new(UnixMilliTimeScanSuite))
+}
+
+// UnixMilliTimeValueSuite tests UnixMilliTime.Value.
+type UnixMilliTimeValueSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixMilliTimeValueSuite) TestNull() {
+	raw, err := UnixMilliTime{Time: testUnixMilliTime}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *UnixMilliTimeValueSuite) TestOK() {
+	raw, err := NewUnixMilliTime(testUnixMilliTime).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(1656671400123), raw, "should return correct value")
+}
+
+func TestUnixMilliTime_Value(t *testing.T) {
+	suite.Run(t, new(UnixMilliTimeValueSuite))
+}
+
+// TestUnixMilliTime_UTC tests UnixMilliTime.UTC.
+func TestUnixMilliTime_UTC(t *testing.T) {
+	tt := NewUnixMilliTime(testUnixMilliTime.In(time.FixedZone("CEST", 2*60*60)))
+	assert.Equal(t, NewUnixMilliTime(testUnixMilliTime), tt.UTC(), "should return correct value")
+}
+
+// TestUnixMilliTime_Get tests UnixMilliTime.Get.
+func TestUnixMilliTime_Get(t *testing.T) {
+	v, ok := NewUnixMilliTime(testUnixMilliTime).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, testUnixMilliTime, v, "should return correct value")
+	_, ok = UnixMilliTime{Time: testUnixMilliTime}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestUnixMilliTime_IsZero tests UnixMilliTime.IsZero.
+func TestUnixMilliTime_IsZero(t *testing.T) {
+	assert.False(t, NewUnixMilliTime(testUnixMilliTime).IsZero(), "should not be zero")
+	assert.True(t, UnixMilliTime{Time: testUnixMilliTime}.IsZero(), "should be zero")
+}
+
+// TestUnixMilliTime_Equal tests UnixMilliTime.Equal.
+func TestUnixMilliTime_Equal(t *testing.T) {
+	a := NewUnixMilliTime(testUnixMilliTime)
+	assert.True(t, a.Equal(NewUnixMilliTime(testUnixMilliTime.In(time.FixedZone("CEST", 2*60*60)))), "should be equal")
+	assert.False(t, a.Equal(NewUnixMilliTime(testUnixMilliTime.Add(time.Millisecond))), "should not be equal")
+	assert.False(t, a.Equal(UnixMilliTime{Time: testUnixMilliTime}), "should not be equal")
+	assert.True(t, UnixMilliTime{Time: testUnixMilliTime}.Equal(UnixMilliTime{}), "should be equal")
+}
+
+// TestUnixMilliTime_Format tests UnixMilliTime.Format, String and GoString.
+func TestUnixMilliTime_Format(t *testing.T) {
+	assert.Equal(t, "2022-07-01 10:30:00.123 +0000 UTC", NewUnixMilliTime(testUnixMilliTime).String(), "should return correct value")
+	assert.Equal(t, NullToken, fmt.Sprintf("%v", UnixMilliTime{}), "should return null token")
+	assert.Equal(t, "nulls.NewUnixMilliTime(time.Date(2022, time.July, 1, 10, 30, 0, 123000000, time.UTC))",
+		fmt.Sprintf("%#v", NewUnixMilliTime(testUnixMilliTime)), "should return correct value")
+	assert.Equal(t, "nulls.UnixMilliTime{}", UnixMilliTime{}.GoString(), "should return correct value")
+}
+
+// TestUnixMilliTime_LogValue tests UnixMilliTime.LogValue.
+func TestUnixMilliTime_LogValue(t *testing.T) {
+	assert.Equal(t, testUnixMilliTime, NewUnixMilliTime(testUnixMilliTime).LogValue().Any(), "should return correct value")
+	assert.Nil(t, UnixMilliTime{Time: testUnixMilliTime}.LogValue().Any(), "should return null value")
+}
+
+// TestUnixMilliTimeFromPtr tests UnixMilliTimeFromPtr and UnixMilliTime.Ptr.
+func TestUnixMilliTimeFromPtr(t *testing.T) {
+	assert.False(t, UnixMilliTimeFromPtr(nil).Valid, "should not be valid")
+	assert.Nil(t, UnixMilliTime{Time: testUnixMilliTime}.Ptr(), "should return nil")
+	v := testUnixMilliTime
+	p := UnixMilliTimeFromPtr(&v).Ptr()
+	assert.Equal(t, &v, p, "should return correct value")
+}
+
+// TestUnixMilliTimeFromSQL tests UnixMilliTimeFromSQL and UnixMilliTime.ToSQL.
+func TestUnixMilliTimeFromSQL(t *testing.T) {
+	v := sql.NullTime{Time: testUnixMilliTime, Valid: true}
+	assert.Equal(t, NewUnixMilliTime(testUnixMilliTime), UnixMilliTimeFromSQL(v), "should return correct value")
+	assert.Equal(t, v, NewUnixMilliTime(testUnixMilliTime).ToSQL(), "should return correct value")
+	n := sql.Null[time.Time]{V: testUnixMilliTime, Valid: true}
+	assert.Equal(t, NewUnixMilliTime(testUnixMilliTime), UnixMilliTimeFromSQLNull(n), "should return correct value")
+	assert.Equal(t, n, NewUnixMilliTime(testUnixMilliTime).ToSQLNull(), "should return correct value")
+}
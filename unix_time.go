@@ -0,0 +1,243 @@
+package nulls
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+	"time"
+)
+
+// UnixTime holds a nullable time.Time that is represented as unix timestamp in
+// seconds. Sub-second precision is lost when marshalling. Unmarshalled times
+// are in UTC.
+type UnixTime struct {
+	// Time is the actual value when Valid.
+	Time time.Time `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewUnixTime returns a valid UnixTime with the given value.
+func NewUnixTime(t time.Time) UnixTime {
+	return UnixTime{
+		Time:  t,
+		Valid: true,
+	}
+}
+
+// UnixTimeFromPtr returns a UnixTime that is valid if the given pointer is not
+// nil.
+func UnixTimeFromPtr(v *time.Time) UnixTime {
+	if v == nil {
+		return UnixTime{}
+	}
+	return NewUnixTime(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (t UnixTime) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
+// UnixTimeFromSQL returns a UnixTime from the given sql.NullTime.
+func UnixTimeFromSQL(v sql.NullTime) UnixTime {
+	return UnixTime{
+		Time:  v.Time,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQL returns the sql.NullTime representation.
+func (t UnixTime) ToSQL() sql.NullTime {
+	return sql.NullTime{
+		Time:  t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// UnixTimeFromSQLNull returns a UnixTime from the given sql.Null.
+func UnixTimeFromSQLNull(v sql.Null[time.Time]) UnixTime {
+	return UnixTime{
+		Time:  v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (t UnixTime) ToSQLNull() sql.Null[time.Time] {
+	return sql.Null[time.Time]{
+		V:     t.Time,
+		Valid: t.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (t UnixTime) Get() (time.Time, bool) {
+	return t.Time, t.Valid
+}
+
+// IsNull returns true if not valid.
+func (t UnixTime) IsNull() bool {
+	return !t.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (t *UnixTime) Set(v time.Time) {
+	*t = NewUnixTime(v)
+}
+
+// SetNull sets a NULL-value.
+func (t *UnixTime) SetNull() {
+	*t = UnixTime{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (t UnixTime) IsZero() bool {
+	return !t.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+// Times are compared using time.Time.Equal.
+func (t UnixTime) Equal(other UnixTime) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+	return t.Time.Equal(other.Time)
+}
+
+// String returns the value formatted with fmt.Sprint or NullToken if not valid.
+func (t UnixTime) String() string {
+	return formatString(t.Time, t.Valid)
+}
+
+// Format implements fmt.Formatter. The value is formatted using the given verb
+// or NullToken is written if not valid. For %#v, GoString is used.
+func (t UnixTime) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, t, t.Time, t.Valid)
+}
+
+// GoString returns a Go expression creating the UnixTime.
+func (t UnixTime) GoString() string {
+	if !t.Valid {
+		return "nulls.UnixTime{}"
+	}
+	return fmt.Sprintf("nulls.NewUnixTime(%#v)", t.Time)
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (t UnixTime) LogValue() slog.Value {
+	if !t.Valid {
+		return logNullValue()
+	}
+	return slog.TimeValue(t.Time)
+}
+
+// MarshalJSON marshals the time as unix seconds. If not valid, a NULL-value is
+// returned.
+func (t UnixTime) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(t.Time.Unix())
+}
+
+// UnmarshalJSON as unix seconds or sets Valid to false if null. The seconds may
+// be given as number or string.
+func (t *UnixTime) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		t.SetNull()
+		return nil
+	}
+	v, err := unmarshalJSONUnix(data)
+	if err != nil {
+		return err
+	}
+	t.Valid = true
+	t.Time = unixSeconds(v)
+	return nil
+}
+
+// MarshalText marshals the time as decimal unix seconds. If not valid, empty
+// text is returned.
+func (t UnixTime) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return formatUnix(t.Time.Unix()), nil
+}
+
+// UnmarshalText as decimal unix seconds or sets Valid to false if empty.
+func (t *UnixTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		t.SetNull()
+		return nil
+	}
+	v, err := parseNumber[int64](string(text))
+	if err != nil {
+		return err
+	}
+	t.Valid = true
+	t.Time = unixSeconds(v)
+	return nil
+}
+
+// MarshalYAML marshals the time as unix seconds. If not valid, a NULL-value is
+// returned.
+func (t UnixTime) MarshalYAML() (any, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.Unix(), nil
+}
+
+// UnmarshalYAML as unix seconds or sets Valid to false if null.
+func (t *UnixTime) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		t.SetNull()
+		return nil
+	}
+	v, err := unmarshalYAMLNumber[int64](node)
+	if err != nil {
+		return err
+	}
+	t.Valid = true
+	t.Time = unixSeconds(v)
+	return nil
+}
+
+// Scan to time.Time value or not valid if nil. Integers and decimal strings are
+// scanned as unix seconds, and time.Time values are accepted as well.
+func (t *UnixTime) Scan(src any) error {
+	v, valid, err := scanUnix(src, unixSeconds)
+	if err != nil {
+		return err
+	}
+	t.Valid = valid
+	t.Time = v
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The time
+// is returned as unix seconds.
+func (t UnixTime) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.Unix(), nil
+}
+
+// UTC returns the UTC time.
+func (t UnixTime) UTC() UnixTime {
+	return UnixTime{
+		Time:  t.Time.UTC(),
+		Valid: t.Valid,
+	}
+}
@@ -0,0 +1,316 @@
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+	"time"
+)
+
+// testUnixTime is 2022-07-01T10:30:00Z with 1656671400 unix seconds.
+var testUnixTime = time.Date(2022, 7, 1, 10, 30, 0, 0, time.UTC)
+
+// TestNewUnixTime tests NewUnixTime.
+func TestNewUnixTime(t *testing.T) {
+	tt := NewUnixTime(testUnixTime)
+	assert.True(t, tt.Valid, "should be valid")
+	assert.Equal(t, testUnixTime, tt.Time, "should have set correct value")
+}
+
+// UnixTimeMarshalJSONSuite tests UnixTime.MarshalJSON.
+type UnixTimeMarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixTimeMarshalJSONSuite) TestNotValid() {
+	raw, err := json.Marshal(UnixTime{Time: testUnixTime})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(jsonNull, raw, "should return correct value")
+}
+
+func (suite *UnixTimeMarshalJSONSuite) TestOK() {
+	raw, err := json.Marshal(NewUnixTime(testUnixTime.Add(500 * time.Millisecond)))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`1656671400`, string(raw), "should return correct value")
+}
+
+func TestUnixTime_MarshalJSON(t *testing.T) {
+	suite.Run(t, new(UnixTimeMarshalJSONSuite))
+}
+
+// UnixTimeUnmarshalJSONSuite tests UnixTime.UnmarshalJSON.
+type UnixTimeUnmarshalJSONSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixTimeUnmarshalJSONSuite) TestNull() {
+	tt := NewUnixTime(testUnixTime)
+	err := json.Unmarshal(jsonNull, &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *UnixTimeUnmarshalJSONSuite) TestUnmarshalFail() {
+	var tt UnixTime
+	err := json.Unmarshal(marshalMust("meow"), &tt)
+	suite.Error(err, "should fail")
+	err = json.Unmarshal([]byte(`1.5`), &tt)
+	suite.Error(err, "should fail")
+}
+
+func (suite *UnixTimeUnmarshalJSONSuite) TestNumber() {
+	var tt UnixTime
+	err := json.Unmarshal([]byte(`1656671400`), &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testUnixTime, tt.Time, "should unmarshal correct value")
+}
+
+func (suite *UnixTimeUnmarshalJSONSuite) TestString() {
+	var tt UnixTime
+	err := json.Unmarshal([]byte(`"1656671400"`), &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testUnixTime, tt.Time, "should unmarshal correct value")
+}
+
+func TestUnixTime_UnmarshalJSON(t *testing.T) {
+	suite.Run(t, new(UnixTimeUnmarshalJSONSuite))
+}
+
+// UnixTimeMarshalTextSuite tests UnixTime.MarshalText.
+type UnixTimeMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixTimeMarshalTextSuite) TestNotValid() {
+	text, err := UnixTime{Time: testUnixTime}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *UnixTimeMarshalTextSuite) TestOK() {
+	text, err := NewUnixTime(testUnixTime).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(`1656671400`, string(text), "should return correct value")
+}
+
+func TestUnixTime_MarshalText(t *testing.T) {
+	suite.Run(t, new(UnixTimeMarshalTextSuite))
+}
+
+// UnixTimeUnmarshalTextSuite tests UnixTime.UnmarshalText.
+type UnixTimeUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixTimeUnmarshalTextSuite) TestEmpty() {
+	tt := NewUnixTime(testUnixTime)
+	err := tt.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *UnixTimeUnmarshalTextSuite) TestUnmarshalFail() {
+	var tt UnixTime
+	err := tt.UnmarshalText([]byte(`meow`))
+	suite.Error(err, "should fail")
+}
+
+func (suite *UnixTimeUnmarshalTextSuite) TestOK() {
+	var tt UnixTime
+	err := tt.UnmarshalText([]byte(`1656671400`))
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testUnixTime, tt.Time, "should unmarshal correct value")
+}
+
+func TestUnixTime_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(UnixTimeUnmarshalTextSuite))
+}
+
+// UnixTimeMarshalYAMLSuite tests UnixTime.MarshalYAML.
+type UnixTimeMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixTimeMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(UnixTime{Time: testUnixTime})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *UnixTimeMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(NewUnixTime(testUnixTime))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("1656671400\n", string(raw), "should return correct value")
+}
+
+func TestUnixTime_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(UnixTimeMarshalYAMLSuite))
+}
+
+// UnixTimeUnmarshalYAMLSuite tests UnixTime.UnmarshalYAML.
+type UnixTimeUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixTimeUnmarshalYAMLSuite) TestNull() {
+	tt := NewUnixTime(testUnixTime)
+	err := tt.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *UnixTimeUnmarshalYAMLSuite) TestUnmarshalFail() {
+	var tt UnixTime
+	err := yaml.Unmarshal([]byte(`meow`), &tt)
+	suite.Error(err, "should fail")
+}
+
+func (suite *UnixTimeUnmarshalYAMLSuite) TestOK() {
+	var tt UnixTime
+	err := yaml.Unmarshal([]byte(`1656671400`), &tt)
+	suite.Require().NoError(err, "should not fail")
+	suite.True(tt.Valid, "should be valid")
+	suite.Equal(testUnixTime, tt.Time, "should unmarshal correct value")
+}
+
+func TestUnixTime_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(UnixTimeUnmarshalYAMLSuite))
+}
+
+// UnixTimeScanSuite tests UnixTime.Scan.
+type UnixTimeScanSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixTimeScanSuite) TestNull() {
+	tt := NewUnixTime(testUnixTime)
+	err := tt.Scan(nil)
+	suite.Require().NoError(err, "should not fail")
+	suite.False(tt.Valid, "should not be valid")
+}
+
+func (suite *UnixTimeScanSuite) TestUnsupported() {
+	var tt UnixTime
+	err := tt.Scan(true)
+	suite.Error(err, "should fail")
+}
+
+func (suite *UnixTimeScanSuite) TestInvalidString() {
+	var tt UnixTime
+	err := tt.Scan("meow")
+	suite.Error(err, "should fail")
+}
+
+func (suite *UnixTimeScanSuite) TestOutOfRange() {
+	var tt UnixTime
+	err := tt.Scan(uint64(1 << 63))
+	var rangeErr *RangeError
+	suite.True(errors.As(err, &rangeErr), "should fail with range error")
+}
+
+func (suite *UnixTimeScanSuite) TestOK() {
+	for _, src := range []any{int64(1656671400), "1656671400", []byte("1656671400"), testUnixTime} {
+		var tt UnixTime
+		err := tt.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %T", src)
+		suite.Truef(tt.Valid, "should be valid for %T", src)
+		suite.Equalf(testUnixTime, tt.Time, "should scan correct value for %T", src)
+	}
+}
+
+func TestUnixTime_Scan(t *testing.T) {
+	suite.Run(t, new(UnixTimeScanSuite))
+}
+
+// UnixTimeValueSuite tests UnixTime.Value.
+type UnixTimeValueSuite struct {
+	suite.Suite
+}
+
+func (suite *UnixTimeValueSuite) TestNull() {
+	raw, err := UnixTime{Time: testUnixTime}.Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Nil(raw, "should return correct value")
+}
+
+func (suite *UnixTimeValueSuite) TestOK() {
+	raw, err := NewUnixTime(testUnixTime).Value()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(int64(1656671400), raw, "should return correct value")
+}
+
+func TestUnixTime_Value(t *testing.T) {
+	suite.Run(t, new(UnixTimeValueSuite))
+}
+
+// TestUnixTime_UTC tests UnixTime.UTC.
+func TestUnixTime_UTC(t *testing.T) {
+	tt := NewUnixTime(testUnixTime.In(time.FixedZone("CEST", 2*60*60)))
+	assert.Equal(t, NewUnixTime(testUnixTime), tt.UTC(), "should return correct value")
+}
+
+// TestUnixTime_Get tests UnixTime.Get.
+func TestUnixTime_Get(t *testing.T) {
+	v, ok := NewUnixTime(testUnixTime).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, testUnixTime, v, "should return correct value")
+	_, ok = UnixTime{Time: testUnixTime}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestUnixTime_IsZero tests UnixTime.IsZero.
+func TestUnixTime_IsZero(t *testing.T) {
+	assert.False(t, NewUnixTime(testUnixTime).IsZero(), "should not be zero")
+	assert.True(t, UnixTime{Time: testUnixTime}.IsZero(), "should be zero")
+}
+
+// TestUnixTime_Equal tests UnixTime.Equal.
+func TestUnixTime_Equal(t *testing.T) {
+	a := NewUnixTime(testUnixTime)
+	assert.True(t, a.Equal(NewUnixTime(testUnixTime.In(time.FixedZone("CEST", 2*60*60)))), "should be equal")
+	assert.False(t, a.Equal(NewUnixTime(testUnixTime.Add(time.Second))), "should not be equal")
+	assert.False(t, a.Equal(UnixTime{Time: testUnixTime}), "should not be equal")
+	assert.True(t, UnixTime{Time: testUnixTime}.Equal(UnixTime{}), "should be equal")
+}
+
+// TestUnixTime_Format tests UnixTime.Format, String and GoString.
+func TestUnixTime_Format(t *testing.T) {
+	assert.Equal(t, "2022-07-01 10:30:00 +0000 UTC", NewUnixTime(testUnixTime).String(), "should return correct value")
+	assert.Equal(t, NullToken, fmt.Sprintf("%v", UnixTime{}), "should return null token")
+	assert.Equal(t, "nulls.NewUnixTime(time.Date(2022, time.July, 1, 10, 30, 0, 0, time.UTC))",
+		fmt.Sprintf("%#v", NewUnixTime(testUnixTime)), "should return correct value")
+	assert.Equal(t, "nulls.UnixTime{}", UnixTime{}.GoString(), "should return correct value")
+}
+
+// TestUnixTime_LogValue tests UnixTime.LogValue.
+func TestUnixTime_LogValue(t *testing.T) {
+	assert.Equal(t, testUnixTime, NewUnixTime(testUnixTime).LogValue().Any(), "should return correct value")
+	assert.Nil(t, UnixTime{Time: testUnixTime}.LogValue().Any(), "should return null value")
+}
+
+// TestUnixTimeFromPtr tests UnixTimeFromPtr and UnixTime.Ptr.
+func TestUnixTimeFromPtr(t *testing.T) {
+	assert.False(t, UnixTimeFromPtr(nil).Valid, "should not be valid")
+	assert.Nil(t, UnixTime{Time: testUnixTime}.Ptr(), "should return nil")
+	v := testUnixTime
+	p := UnixTimeFromPtr(&v).Ptr()
+	assert.Equal(t, &v, p, "should return correct value")
+}
+
+// TestUnixTimeFromSQL tests UnixTimeFromSQL and UnixTime.ToSQL.
+func TestUnixTimeFromSQL(t *testing.T) {
+	v := sql.NullTime{Time: testUnixTime, Valid: true}
+	assert.Equal(t, NewUnixTime(testUnixTime), UnixTimeFromSQL(v), "should return correct value")
+	assert.Equal(t, v, NewUnixTime(testUnixTime).ToSQL(), "should return correct value")
+	n := sql.Null[time.Time]{V: testUnixTime, Valid: true}
+	assert.Equal(t, NewUnixTime(testUnixTime), UnixTimeFromSQLNull(n), "should return correct value")
+	assert.Equal(t, n, NewUnixTime(testUnixTime).ToSQLNull(), "should return correct value")
+}
@@ -1,11 +1,268 @@
 package nulls
 
-import "github.com/gofrs/uuid"
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/gofrs/uuid"
+	googleuuid "github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+	"log/slog"
+)
 
-// NewUUID creates a new valid uuid.NullUUID.
+// UUID holds a nullable uuid.UUID of github.com/gofrs/uuid. Conversions to and
+// from the NullUUID types of github.com/gofrs/uuid and github.com/google/uuid
+// are provided.
+type UUID struct {
+	// UUID is the actual value when Valid.
+	UUID uuid.UUID `exhaustruct:"optional"`
+	// Valid when no NULL-value is represented.
+	Valid bool
+}
+
+// NewUUID creates a new valid uuid.NullUUID. It is kept for compatibility. Use
+// UUIDOf for creating a UUID.
 func NewUUID(id uuid.UUID) uuid.NullUUID {
 	return uuid.NullUUID{
 		UUID:  id,
 		Valid: true,
 	}
 }
+
+// UUIDOf returns a valid UUID with the given value.
+func UUIDOf(id uuid.UUID) UUID {
+	return UUID{
+		UUID:  id,
+		Valid: true,
+	}
+}
+
+// UUIDFromPtr returns a UUID that is valid if the given pointer is not nil.
+func UUIDFromPtr(v *uuid.UUID) UUID {
+	if v == nil {
+		return UUID{}
+	}
+	return UUIDOf(*v)
+}
+
+// Ptr returns a pointer to a copy of the value if valid or nil otherwise.
+func (u UUID) Ptr() *uuid.UUID {
+	if !u.Valid {
+		return nil
+	}
+	v := u.UUID
+	return &v
+}
+
+// UUIDFromSQLNull returns a UUID from the given sql.Null.
+func UUIDFromSQLNull(v sql.Null[uuid.UUID]) UUID {
+	return UUID{
+		UUID:  v.V,
+		Valid: v.Valid,
+	}
+}
+
+// ToSQLNull returns the sql.Null representation.
+func (u UUID) ToSQLNull() sql.Null[uuid.UUID] {
+	return sql.Null[uuid.UUID]{
+		V:     u.UUID,
+		Valid: u.Valid,
+	}
+}
+
+// UUIDFromGofrs returns a UUID from the given uuid.NullUUID of
+// github.com/gofrs/uuid.
+func UUIDFromGofrs(v uuid.NullUUID) UUID {
+	return UUID{
+		UUID:  v.UUID,
+		Valid: v.Valid,
+	}
+}
+
+// ToGofrs returns the uuid.NullUUID representation of github.com/gofrs/uuid.
+func (u UUID) ToGofrs() uuid.NullUUID {
+	return uuid.NullUUID{
+		UUID:  u.UUID,
+		Valid: u.Valid,
+	}
+}
+
+// UUIDFromGoogle returns a UUID from the given uuid.NullUUID of
+// github.com/google/uuid.
+func UUIDFromGoogle(v googleuuid.NullUUID) UUID {
+	return UUID{
+		UUID:  uuid.UUID(v.UUID),
+		Valid: v.Valid,
+	}
+}
+
+// ToGoogle returns the uuid.NullUUID representation of github.com/google/uuid.
+func (u UUID) ToGoogle() googleuuid.NullUUID {
+	return googleuuid.NullUUID{
+		UUID:  googleuuid.UUID(u.UUID),
+		Valid: u.Valid,
+	}
+}
+
+// Get returns the value and whether it is valid.
+func (u UUID) Get() (uuid.UUID, bool) {
+	return u.UUID, u.Valid
+}
+
+// IsNull returns true if not valid.
+func (u UUID) IsNull() bool {
+	return !u.Valid
+}
+
+// Set sets the given value and makes it valid.
+func (u *UUID) Set(v uuid.UUID) {
+	*u = UUIDOf(v)
+}
+
+// SetNull sets a NULL-value.
+func (u *UUID) SetNull() {
+	*u = UUID{}
+}
+
+// IsZero returns true if not valid. This allows omitting NULL-values using the
+// omitzero option of encoding/json.
+func (u UUID) IsZero() bool {
+	return !u.Valid
+}
+
+// Equal returns true if both are not valid or both are valid with equal values.
+func (u UUID) Equal(other UUID) bool {
+	if !u.Valid || !other.Valid {
+		return u.Valid == other.Valid
+	}
+	return u.UUID == other.UUID
+}
+
+// String returns the value in canonical form or NullToken if not valid.
+func (u UUID) String() string {
+	return formatString(u.UUID.String(), u.Valid)
+}
+
+// Format implements fmt.Formatter. The value in canonical form is formatted
+// using the given verb or NullToken is written if not valid. For %#v, GoString
+// is used.
+func (u UUID) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, u, u.UUID.String(), u.Valid)
+}
+
+// GoString returns a Go expression creating the UUID.
+func (u UUID) GoString() string {
+	if !u.Valid {
+		return "nulls.UUID{}"
+	}
+	return fmt.Sprintf("nulls.UUIDOf(uuid.Must(uuid.FromString(%q)))", u.UUID.String())
+}
+
+// LogValue implements slog.LogValuer. If not valid, a NULL-value is logged.
+func (u UUID) LogValue() slog.Value {
+	if !u.Valid {
+		return logNullValue()
+	}
+	return slog.StringValue(u.UUID.String())
+}
+
+// MarshalJSON marshals the uuid.UUID in canonical form. If not valid, a
+// NULL-value is returned.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(u.UUID)
+}
+
+// UnmarshalJSON as uuid.UUID or sets Valid to false if null.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		u.SetNull()
+		return nil
+	}
+	err := json.Unmarshal(data, &u.UUID)
+	if err != nil {
+		return err
+	}
+	u.Valid = true
+	return nil
+}
+
+// MarshalText marshals the uuid.UUID in canonical form. If not valid, empty
+// text is returned.
+func (u UUID) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return []byte{}, nil
+	}
+	return u.UUID.MarshalText()
+}
+
+// UnmarshalText as uuid.UUID or sets Valid to false if empty.
+func (u *UUID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		u.SetNull()
+		return nil
+	}
+	err := u.UUID.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+	u.Valid = true
+	return nil
+}
+
+// MarshalYAML marshals the uuid.UUID in canonical form. If not valid, a
+// NULL-value is returned.
+func (u UUID) MarshalYAML() (any, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.UUID.String(), nil
+}
+
+// UnmarshalYAML as uuid.UUID or sets Valid to false if null.
+func (u *UUID) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		u.SetNull()
+		return nil
+	}
+	var s string
+	err := node.Decode(&s)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
+
+// Scan to uuid.UUID value or not valid if nil. 16-byte slices are scanned as
+// binary UUID while strings and other byte slices are parsed as text. Values
+// of uuid.UUID of both github.com/gofrs/uuid and github.com/google/uuid are
+// accepted as well.
+func (u *UUID) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		u.SetNull()
+		return nil
+	case googleuuid.UUID:
+		u.Valid = true
+		u.UUID = uuid.UUID(src)
+		return nil
+	}
+	err := u.UUID.Scan(src)
+	if err != nil {
+		return err
+	}
+	u.Valid = true
+	return nil
+}
+
+// Value returns the value for satisfying the driver.Valuer interface. The
+// uuid.UUID is returned in canonical form.
+func (u UUID) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.UUID.String(), nil
+}
@@ -1,13 +1,20 @@
 package nulls
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"github.com/gofrs/uuid"
+	googleuuid "github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"testing"
 )
 
+// testUUID for usage in tests.
+var testUUID = uuid.Must(uuid.FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+
 func newUUIDV4() uuid.UUID {
 	id, err := uuid.NewV4()
 	if err != nil {
@@ -24,13 +31,20 @@ func TestNewUUID(t *testing.T) {
 	assert.Equal(t, id, s.UUID, "should contain correct value")
 }
 
+// TestUUIDOf tests UUIDOf.
+func TestUUIDOf(t *testing.T) {
+	u := UUIDOf(testUUID)
+	assert.True(t, u.Valid, "should be valid")
+	assert.Equal(t, testUUID, u.UUID, "should contain correct value")
+}
+
 // UUIDMarshalJSONSuite tests UUID.MarshalJSON.
 type UUIDMarshalJSONSuite struct {
 	suite.Suite
 }
 
 func (suite *UUIDMarshalJSONSuite) TestNotValid() {
-	s := uuid.NullUUID{UUID: newUUIDV4()}
+	s := UUID{UUID: newUUIDV4()}
 	raw, err := json.Marshal(s)
 	suite.Require().NoError(err, "should not fail")
 	suite.Equal(jsonNull, raw, "should return correct value")
@@ -38,7 +52,7 @@ func (suite *UUIDMarshalJSONSuite) TestNotValid() {
 
 func (suite *UUIDMarshalJSONSuite) TestOK() {
 	id := newUUIDV4()
-	s := NewUUID(id)
+	s := UUIDOf(id)
 	raw, err := json.Marshal(s)
 	suite.Require().NoError(err, "should not fail")
 	suite.Equal(marshalMust(id), raw, "should return correct value")
@@ -54,15 +68,21 @@ type UUIDUnmarshalJSONSuite struct {
 }
 
 func (suite *UUIDUnmarshalJSONSuite) TestNull() {
-	var s uuid.NullUUID
+	s := UUIDOf(newUUIDV4())
 	err := json.Unmarshal(jsonNull, &s)
 	suite.Require().NoError(err, "should not fail")
 	suite.False(s.Valid, "should not be valid")
 }
 
+func (suite *UUIDUnmarshalJSONSuite) TestUnmarshalFail() {
+	var s UUID
+	err := json.Unmarshal([]byte(`"meow"`), &s)
+	suite.Error(err, "should fail")
+}
+
 func (suite *UUIDUnmarshalJSONSuite) TestOK() {
 	id := newUUIDV4()
-	var s uuid.NullUUID
+	var s UUID
 	err := json.Unmarshal(marshalMust(id), &s)
 	suite.Require().NoError(err, "should not fail")
 	suite.True(s.Valid, "should be valid")
@@ -73,25 +93,147 @@ func TestUUID_UnmarshalJSON(t *testing.T) {
 	suite.Run(t, new(UUIDUnmarshalJSONSuite))
 }
 
+// UUIDMarshalTextSuite tests UUID.MarshalText.
+type UUIDMarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *UUIDMarshalTextSuite) TestNotValid() {
+	text, err := UUID{UUID: testUUID}.MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Empty(text, "should return correct value")
+}
+
+func (suite *UUIDMarshalTextSuite) TestOK() {
+	text, err := UUIDOf(testUUID).MarshalText()
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("6ba7b810-9dad-11d1-80b4-00c04fd430c8", string(text), "should return correct value")
+}
+
+func TestUUID_MarshalText(t *testing.T) {
+	suite.Run(t, new(UUIDMarshalTextSuite))
+}
+
+// UUIDUnmarshalTextSuite tests UUID.UnmarshalText.
+type UUIDUnmarshalTextSuite struct {
+	suite.Suite
+}
+
+func (suite *UUIDUnmarshalTextSuite) TestEmpty() {
+	s := UUIDOf(testUUID)
+	err := s.UnmarshalText([]byte{})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.Valid, "should not be valid")
+}
+
+func (suite *UUIDUnmarshalTextSuite) TestUnmarshalFail() {
+	var s UUID
+	err := s.UnmarshalText([]byte("meow"))
+	suite.Error(err, "should fail")
+}
+
+func (suite *UUIDUnmarshalTextSuite) TestOK() {
+	var s UUID
+	err := s.UnmarshalText([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(UUIDOf(testUUID), s, "should unmarshal correct value")
+}
+
+func TestUUID_UnmarshalText(t *testing.T) {
+	suite.Run(t, new(UUIDUnmarshalTextSuite))
+}
+
+// UUIDMarshalYAMLSuite tests UUID.MarshalYAML.
+type UUIDMarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *UUIDMarshalYAMLSuite) TestNotValid() {
+	raw, err := yaml.Marshal(UUID{UUID: testUUID})
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("null\n", string(raw), "should return correct value")
+}
+
+func (suite *UUIDMarshalYAMLSuite) TestOK() {
+	raw, err := yaml.Marshal(UUIDOf(testUUID))
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal("6ba7b810-9dad-11d1-80b4-00c04fd430c8\n", string(raw), "should return correct value")
+}
+
+func TestUUID_MarshalYAML(t *testing.T) {
+	suite.Run(t, new(UUIDMarshalYAMLSuite))
+}
+
+// UUIDUnmarshalYAMLSuite tests UUID.UnmarshalYAML.
+type UUIDUnmarshalYAMLSuite struct {
+	suite.Suite
+}
+
+func (suite *UUIDUnmarshalYAMLSuite) TestNull() {
+	s := UUIDOf(testUUID)
+	err := s.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "~"})
+	suite.Require().NoError(err, "should not fail")
+	suite.False(s.Valid, "should not be valid")
+}
+
+func (suite *UUIDUnmarshalYAMLSuite) TestUnmarshalFail() {
+	for _, src := range []string{`meow`, `[1]`} {
+		var s UUID
+		err := yaml.Unmarshal([]byte(src), &s)
+		suite.Errorf(err, "should fail for %s", src)
+	}
+}
+
+func (suite *UUIDUnmarshalYAMLSuite) TestOK() {
+	var s UUID
+	err := yaml.Unmarshal([]byte(`6ba7b810-9dad-11d1-80b4-00c04fd430c8`), &s)
+	suite.Require().NoError(err, "should not fail")
+	suite.Equal(UUIDOf(testUUID), s, "should unmarshal correct value")
+}
+
+func TestUUID_UnmarshalYAML(t *testing.T) {
+	suite.Run(t, new(UUIDUnmarshalYAMLSuite))
+}
+
 // UUIDScanSuite tests UUID.Scan.
 type UUIDScanSuite struct {
 	suite.Suite
 }
 
 func (suite *UUIDScanSuite) TestNull() {
-	var s uuid.NullUUID
+	s := UUIDOf(testUUID)
 	err := s.Scan(nil)
 	suite.Require().NoError(err, "should not fail")
 	suite.False(s.Valid, "should not be valid")
 }
 
+func (suite *UUIDScanSuite) TestUnsupported() {
+	var s UUID
+	err := s.Scan(16)
+	suite.Error(err, "should fail")
+}
+
+func (suite *UUIDScanSuite) TestParseFail() {
+	var s UUID
+	err := s.Scan("meow")
+	suite.Error(err, "should fail")
+}
+
 func (suite *UUIDScanSuite) TestOK() {
-	id := newUUIDV4()
-	var s uuid.NullUUID
-	err := s.Scan(id)
-	suite.Require().NoError(err, "should not fail")
-	suite.True(s.Valid, "should be valid")
-	suite.Equal(id, s.UUID, "should scan correct value")
+	for _, src := range []any{
+		testUUID,
+		googleuuid.UUID(testUUID),
+		testUUID.Bytes(),
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		[]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+	} {
+		var s UUID
+		err := s.Scan(src)
+		suite.Require().NoErrorf(err, "should not fail for %v", src)
+		suite.Truef(s.Valid, "should be valid for %v", src)
+		suite.Equalf(testUUID, s.UUID, "should scan correct value for %v", src)
+	}
 }
 
 func TestUUID_Scan(t *testing.T) {
@@ -104,7 +246,7 @@ type UUIDValueSuite struct {
 }
 
 func (suite *UUIDValueSuite) TestNull() {
-	s := uuid.NullUUID{UUID: newUUIDV4()}
+	s := UUID{UUID: newUUIDV4()}
 	raw, err := s.Value()
 	suite.Require().NoError(err, "should not fail")
 	suite.Nil(raw, "should return correct value")
@@ -112,7 +254,7 @@ func (suite *UUIDValueSuite) TestNull() {
 
 func (suite *UUIDValueSuite) TestOK() {
 	id := newUUIDV4()
-	s := NewUUID(id)
+	s := UUIDOf(id)
 	raw, err := s.Value()
 	suite.Require().NoError(err, "should not fail")
 	suite.EqualValues(id.String(), raw, "should return correct value")
@@ -121,3 +263,100 @@ func (suite *UUIDValueSuite) TestOK() {
 func TestUUID_Value(t *testing.T) {
 	suite.Run(t, new(UUIDValueSuite))
 }
+
+// TestUUID_Get tests UUID.Get.
+func TestUUID_Get(t *testing.T) {
+	v, ok := UUIDOf(testUUID).Get()
+	assert.True(t, ok, "should be valid")
+	assert.Equal(t, testUUID, v, "should return correct value")
+	_, ok = UUID{UUID: testUUID}.Get()
+	assert.False(t, ok, "should not be valid")
+}
+
+// TestUUID_IsZero tests UUID.IsZero.
+func TestUUID_IsZero(t *testing.T) {
+	assert.False(t, UUIDOf(uuid.Nil).IsZero(), "should not be zero")
+	assert.True(t, UUID{UUID: testUUID}.IsZero(), "should be zero")
+}
+
+// TestUUID_Equal tests UUID.Equal.
+func TestUUID_Equal(t *testing.T) {
+	s := UUIDOf(testUUID)
+	assert.True(t, s.Equal(UUIDOf(testUUID)), "should be equal")
+	assert.False(t, s.Equal(UUIDOf(newUUIDV4())), "should not be equal")
+	assert.False(t, s.Equal(UUID{UUID: testUUID}), "should not be equal")
+	assert.True(t, UUID{UUID: testUUID}.Equal(UUID{}), "should be equal")
+}
+
+// TestUUID_String tests UUID.String.
+func TestUUID_String(t *testing.T) {
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", UUIDOf(testUUID).String(), "should return correct value")
+	assert.Equal(t, NullToken, UUID{}.String(), "should return null token")
+}
+
+// TestUUID_Format tests UUID.Format.
+func TestUUID_Format(t *testing.T) {
+	assert.Equal(t, `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`, fmt.Sprintf("%q", UUIDOf(testUUID)),
+		"should return correct value")
+	assert.Equal(t, "<null>", fmt.Sprintf("%v", UUID{}), "should return null token")
+}
+
+// TestUUID_GoString tests UUID.GoString.
+func TestUUID_GoString(t *testing.T) {
+	assert.Equal(t, `nulls.UUIDOf(uuid.Must(uuid.FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8")))`,
+		fmt.Sprintf("%#v", UUIDOf(testUUID)), "should return correct value")
+	assert.Equal(t, "nulls.UUID{}", UUID{}.GoString(), "should return correct value")
+}
+
+// TestUUID_LogValue tests UUID.LogValue.
+func TestUUID_LogValue(t *testing.T) {
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", UUIDOf(testUUID).LogValue().Any(),
+		"should return correct value")
+	assert.Nil(t, UUID{UUID: testUUID}.LogValue().Any(), "should return null value")
+}
+
+// TestUUIDFromPtr tests UUIDFromPtr and UUID.Ptr.
+func TestUUIDFromPtr(t *testing.T) {
+	assert.False(t, UUIDFromPtr(nil).Valid, "should not be valid")
+	assert.Nil(t, UUID{UUID: testUUID}.Ptr(), "should return nil")
+	v := testUUID
+	p := UUIDFromPtr(&v).Ptr()
+	assert.Equal(t, &v, p, "should return correct value")
+}
+
+// TestUUIDFromSQLNull tests UUIDFromSQLNull and UUID.ToSQLNull.
+func TestUUIDFromSQLNull(t *testing.T) {
+	n := sql.Null[uuid.UUID]{V: testUUID, Valid: true}
+	assert.Equal(t, UUIDOf(testUUID), UUIDFromSQLNull(n), "should return correct value")
+	assert.Equal(t, n, UUIDOf(testUUID).ToSQLNull(), "should return correct value")
+}
+
+// TestUUIDFromGofrs tests UUIDFromGofrs.
+func TestUUIDFromGofrs(t *testing.T) {
+	assert.Equal(t, UUID{}, UUIDFromGofrs(uuid.NullUUID{}), "should return correct value")
+	assert.Equal(t, UUIDOf(testUUID), UUIDFromGofrs(uuid.NullUUID{UUID: testUUID, Valid: true}),
+		"should return correct value")
+}
+
+// TestUUID_ToGofrs tests UUID.ToGofrs.
+func TestUUID_ToGofrs(t *testing.T) {
+	assert.False(t, UUID{UUID: testUUID}.ToGofrs().Valid, "should not be valid")
+	assert.Equal(t, uuid.NullUUID{UUID: testUUID, Valid: true}, UUIDOf(testUUID).ToGofrs(),
+		"should return correct value")
+}
+
+// TestUUIDFromGoogle tests UUIDFromGoogle.
+func TestUUIDFromGoogle(t *testing.T) {
+	id := googleuuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	assert.Equal(t, UUID{}, UUIDFromGoogle(googleuuid.NullUUID{}), "should return correct value")
+	assert.Equal(t, UUIDOf(testUUID), UUIDFromGoogle(googleuuid.NullUUID{UUID: id, Valid: true}),
+		"should return correct value")
+}
+
+// TestUUID_ToGoogle tests UUID.ToGoogle.
+func TestUUID_ToGoogle(t *testing.T) {
+	id := googleuuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	assert.False(t, UUID{UUID: testUUID}.ToGoogle().Valid, "should not be valid")
+	assert.Equal(t, googleuuid.NullUUID{UUID: id, Valid: true}, UUIDOf(testUUID).ToGoogle(),
+		"should return correct value")
+}